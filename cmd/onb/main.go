@@ -7,6 +7,7 @@ import (
 	"github.com/denkhaus/open-notebook-cli/pkg/commands"
 	"github.com/denkhaus/open-notebook-cli/pkg/di"
 	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/samber/do/v2"
 	"github.com/urfave/cli/v2"
 )
 
@@ -14,9 +15,19 @@ import (
 var (
 	version   = "dev"
 	buildTime = "unknown"
+	commit    = "unknown"
 )
 
 func main() {
+	// Root context captured in Before so errors can honour --output
+	var rootCtx *cli.Context
+
+	buildInfo := commands.BuildInfo{
+		Version:   version,
+		BuildTime: buildTime,
+		Commit:    commit,
+	}
+
 	app := &cli.App{
 		Name:    "onb",
 		Usage:   "OpenNotebook CLI - Manage your knowledge bases from the command line",
@@ -52,28 +63,188 @@ func main() {
 				EnvVars: []string{"OPEN_NOTEBOOK_RETRY_COUNT"},
 				Value:   3,
 			},
+			&cli.StringFlag{
+				Name:    "retry-status",
+				Usage:   "Comma-separated HTTP status codes that are retried, e.g. 408,429,503 (default: 408,429,500,502,503,504)",
+				EnvVars: []string{"OPEN_NOTEBOOK_RETRY_STATUS"},
+			},
+			&cli.BoolFlag{
+				Name:    "no-retry-5xx",
+				Usage:   "Do not retry 500, 502 and 504 responses",
+				EnvVars: []string{"OPEN_NOTEBOOK_NO_RETRY_5XX"},
+				Value:   false,
+			},
 			&cli.BoolFlag{
 				Name:    "verbose",
-				Usage:   "Enable verbose output",
+				Usage:   "Enable verbose output and debug logging",
 				EnvVars: []string{"OPEN_NOTEBOOK_VERBOSE"},
 				Value:   false,
 			},
+			&cli.StringFlag{
+				Name:    "log-level",
+				Usage:   "Minimum log level (debug, info, warn, error); --verbose is a shortcut for debug",
+				EnvVars: []string{"OPEN_NOTEBOOK_LOG_LEVEL"},
+			},
+			&cli.StringFlag{
+				Name:    "log-format",
+				Usage:   "Log format (text, json); json emits one object per line with timestamp, level, msg and fields",
+				EnvVars: []string{"OPEN_NOTEBOOK_LOG_FORMAT"},
+				Value:   "text",
+			},
 			&cli.StringFlag{
 				Name:    "output",
 				Aliases: []string{"o"},
-				Usage:   "Output format (json, table, yaml)",
+				Usage:   "Output format (json, jsonl, table, wide, yaml, csv, template); wide adds columns to list tables",
 				EnvVars: []string{"OPEN_NOTEBOOK_OUTPUT"},
 				Value:   "table",
 			},
+			&cli.StringFlag{
+				Name:  "template",
+				Usage: "Go text/template applied to each item with --output template, e.g. '{{.ID}} {{.Title | truncate 40}}'",
+			},
+			&cli.StringFlag{
+				Name:      "template-file",
+				Usage:     "Read the --output template from a file",
+				TakesFile: true,
+			},
+			&cli.BoolFlag{
+				Name:    "relative-time",
+				Usage:   "Show recent timestamps in tables as their age, e.g. 2h ago (older ones stay absolute)",
+				EnvVars: []string{"OPEN_NOTEBOOK_RELATIVE_TIME"},
+				Value:   false,
+			},
 			&cli.StringFlag{
 				Name:    "config-dir",
 				Aliases: []string{"c"},
 				Usage:   "Configuration directory",
 				EnvVars: []string{"OPEN_NOTEBOOK_CONFIG_DIR"},
 			},
+			&cli.BoolFlag{
+				Name:    "quiet",
+				Aliases: []string{"q"},
+				Usage:   "Suppress informational output, print only data and errors",
+				EnvVars: []string{"OPEN_NOTEBOOK_QUIET"},
+				Value:   false,
+			},
+			&cli.BoolFlag{
+				Name:    "no-color",
+				Usage:   "Disable emoji and color, use plain ASCII status prefixes (also NO_COLOR)",
+				EnvVars: []string{"OPEN_NOTEBOOK_NO_COLOR"},
+				Value:   false,
+			},
+			&cli.BoolFlag{
+				Name:    "no-pager",
+				Usage:   "Do not page output taller than the terminal through $PAGER (default: less -FRX)",
+				EnvVars: []string{"OPEN_NOTEBOOK_NO_PAGER"},
+				Value:   false,
+			},
+			&cli.BoolFlag{
+				Name:    "insecure",
+				Usage:   "Skip TLS certificate verification (prefer --ca-cert for self-signed certificates)",
+				EnvVars: []string{"OPEN_NOTEBOOK_INSECURE"},
+				Value:   false,
+			},
+			&cli.StringFlag{
+				Name:      "ca-cert",
+				Usage:     "PEM file with a CA certificate to trust in addition to the system pool",
+				EnvVars:   []string{"OPEN_NOTEBOOK_CA_CERT"},
+				TakesFile: true,
+			},
+			&cli.StringFlag{
+				Name:      "client-cert",
+				Usage:     "PEM client certificate for servers that require mutual TLS (with --client-key)",
+				EnvVars:   []string{"OPEN_NOTEBOOK_CLIENT_CERT"},
+				TakesFile: true,
+			},
+			&cli.StringFlag{
+				Name:      "client-key",
+				Usage:     "PEM private key of the --client-cert certificate",
+				EnvVars:   []string{"OPEN_NOTEBOOK_CLIENT_KEY"},
+				TakesFile: true,
+			},
+			&cli.StringFlag{
+				Name:    "proxy",
+				Usage:   "Proxy URL for all requests, e.g. http://proxy:3128 (default: HTTP_PROXY, HTTPS_PROXY and NO_PROXY)",
+				EnvVars: []string{"OPEN_NOTEBOOK_PROXY"},
+			},
+			&cli.IntFlag{
+				Name:    "circuit-threshold",
+				Usage:   "Consecutive failed requests after which commands fail fast while the server is down (0 disables)",
+				EnvVars: []string{"OPEN_NOTEBOOK_CIRCUIT_THRESHOLD"},
+				Value:   5,
+			},
+			&cli.IntFlag{
+				Name:    "circuit-window",
+				Usage:   "Seconds within which failures count as consecutive",
+				EnvVars: []string{"OPEN_NOTEBOOK_CIRCUIT_WINDOW"},
+				Value:   60,
+			},
+			&cli.IntFlag{
+				Name:    "circuit-cooldown",
+				Usage:   "Seconds an open circuit fails fast before the server is tried again",
+				EnvVars: []string{"OPEN_NOTEBOOK_CIRCUIT_COOLDOWN"},
+				Value:   30,
+			},
+			&cli.BoolFlag{
+				Name:    "circuit-persist",
+				Usage:   "Keep the circuit breaker state between commands, so later commands fail fast too",
+				EnvVars: []string{"OPEN_NOTEBOOK_CIRCUIT_PERSIST"},
+				Value:   false,
+			},
+			&cli.Float64Flag{
+				Name:    "rate-limit",
+				Usage:   "Maximum requests per second sent to the API, e.g. 5 or 0.5 (0 means unlimited)",
+				EnvVars: []string{"OPEN_NOTEBOOK_RATE_LIMIT"},
+			},
+			&cli.BoolFlag{
+				Name:    "force-auth",
+				Usage:   "Authenticate even when the server reports that authentication is disabled",
+				EnvVars: []string{"OPEN_NOTEBOOK_FORCE_AUTH"},
+				Value:   false,
+			},
+			&cli.BoolFlag{
+				Name:    "no-token-cache",
+				Usage:   "Do not persist or reuse auth tokens between invocations",
+				EnvVars: []string{"OPEN_NOTEBOOK_NO_TOKEN_CACHE"},
+				Value:   false,
+			},
+			&cli.BoolFlag{
+				Name:    "idempotent",
+				Usage:   "Send an Idempotency-Key header with POST/PUT requests, reused across retries so a retried create is not applied twice (requires server support)",
+				EnvVars: []string{"OPEN_NOTEBOOK_IDEMPOTENT"},
+				Value:   false,
+			},
+			&cli.StringFlag{
+				Name:    "api-prefix",
+				Usage:   "Path the API is mounted under, for instances served from a subpath behind a reverse proxy (use / for none)",
+				EnvVars: []string{"OPEN_NOTEBOOK_API_PREFIX"},
+				Value:   "/api",
+			},
+			&cli.BoolFlag{
+				Name:    "compress",
+				Usage:   "Gzip large request bodies when the server advertises support for it",
+				EnvVars: []string{"OPEN_NOTEBOOK_COMPRESS"},
+				Value:   false,
+			},
+			&cli.BoolFlag{
+				Name:    "cache",
+				Usage:   "Cache GET responses on disk and revalidate them with ETags to save bandwidth",
+				EnvVars: []string{"OPEN_NOTEBOOK_CACHE"},
+				Value:   false,
+			},
+			&cli.StringFlag{
+				Name:    "request-id",
+				Usage:   "X-Request-ID sent with every request, for correlating with server logs (default: random UUID per run)",
+				EnvVars: []string{"OPEN_NOTEBOOK_REQUEST_ID"},
+			},
 		},
-		Commands: commands.RegisterCommands(),
+		Commands: append(commands.RegisterCommands(),
+			commands.VersionCommand(buildInfo),
+			commands.DiagnoseCommand(buildInfo),
+		),
 		Before: func(ctx *cli.Context) error {
+			rootCtx = ctx
+
 			// Initialize dependency injection container with all services
 			injector := di.Bootstrap(ctx)
 
@@ -82,12 +253,32 @@ func main() {
 				"injector": injector,
 			}
 
+			// Apply --quiet, --no-color, --relative-time, --no-pager and the request ID to handler output
+			if err := di.ConfigureOutput(injector); err != nil {
+				return err
+			}
+
+			// Build the HTTP transport early so TLS setup errors are reported clearly
+			if err := di.ConfigureTransport(injector); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		After: func(ctx *cli.Context) error {
+			// Flush paged output before errors and statistics are printed
+			di.ClosePager()
+
+			// Print request statistics under --verbose
+			if injector, ok := ctx.App.Metadata["injector"].(do.Injector); ok {
+				di.PrintMetricsSummary(injector)
+			}
 			return nil
 		},
 	}
 
 	if err := app.Run(os.Args); err != nil {
 		// Handle errors with comprehensive user guidance
-		errors.HandleCLIError(err, nil)
+		errors.HandleCLIError(err, rootCtx)
 	}
 }
\ No newline at end of file
@@ -1,21 +1,29 @@
 package commands
 
 import (
+	"bufio"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/denkhaus/open-notebook-cli/pkg/config"
 	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
 	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
 	"github.com/samber/do/v2"
 	"github.com/urfave/cli/v2"
+	"golang.org/x/term"
 )
 
 // Services holds all the services needed for auth commands
 type AuthServices struct {
-	Auth   shared.Auth
-	Config config.Service
-	Logger shared.Logger
+	Auth       shared.Auth
+	TokenStore shared.TokenStore
+	Config     config.Service
+	Logger     shared.Logger
 }
 
 // getAuthServices retrieves all required services via dependency injection
@@ -27,9 +35,10 @@ func getAuthServices(ctx *cli.Context) (*AuthServices, error) {
 	}
 
 	return &AuthServices{
-		Auth:   do.MustInvoke[shared.Auth](injector),
-		Config: do.MustInvoke[config.Service](injector),
-		Logger: do.MustInvoke[shared.Logger](injector),
+		Auth:       do.MustInvoke[shared.Auth](injector),
+		TokenStore: do.MustInvoke[shared.TokenStore](injector),
+		Config:     do.MustInvoke[config.Service](injector),
+		Logger:     do.MustInvoke[shared.Logger](injector),
 	}, nil
 }
 
@@ -46,7 +55,7 @@ func handleAuthCheck(ctx *cli.Context) error {
 	isAuth := services.Auth.IsAuthenticated(ctx.Context)
 
 	if isAuth {
-		fmt.Println("✅ Authenticated successfully")
+		fmt.Println(style.OK("Authenticated successfully"))
 
 		// Try to get token to confirm it's valid
 		token, err := services.Auth.GetToken(ctx.Context)
@@ -54,14 +63,14 @@ func handleAuthCheck(ctx *cli.Context) error {
 			return errors.AuthError("Failed to get auth token", err.Error())
 		}
 
-		fmt.Printf("🔑 Token: %s...\n", token[:utils.Min(20, len(token))])
+		fmt.Printf(style.Icon("🔑", "Token: %s...\n"), token[:utils.Min(20, len(token))])
 		services.Logger.Info("Authentication check completed successfully")
 	} else {
-		fmt.Println("❌ Not authenticated")
+		fmt.Println(style.Error("Not authenticated"))
 
 		// Get password from config if available
 		if services.Config.IsAuthenticated() {
-			fmt.Println("💾 Using configured password for authentication")
+			utils.Status(style.Icon("💾", "Using configured password for authentication"))
 		}
 
 		// Try to authenticate with configured password
@@ -70,7 +79,7 @@ func handleAuthCheck(ctx *cli.Context) error {
 				"Please check your password or API connection")
 		}
 
-		fmt.Println("✅ Authentication completed successfully")
+		fmt.Println(style.OK("Authentication completed successfully"))
 		services.Logger.Info("Authentication successful")
 	}
 
@@ -84,18 +93,44 @@ func handleAuthLogin(ctx *cli.Context) error {
 		return err
 	}
 
+	// Open instances need no login; cache that so later commands skip the check
+	if status, err := services.Auth.Status(ctx.Context); err == nil && !status.AuthEnabled && !services.Config.ForceAuth() {
+		utils.Status(style.Icon("🔓", "Server has authentication disabled, no login needed"))
+		if !services.Config.UseTokenCache() {
+			return nil
+		}
+
+		stored := &models.StoredToken{
+			ExpiresAt:    time.Now().Add(models.TokenLifetime),
+			APIURL:       services.Config.GetAPIURL(),
+			AuthDisabled: true,
+		}
+		if err := services.TokenStore.Save(stored); err != nil {
+			return errors.APIError("Failed to store auth status", err.Error())
+		}
+		services.Logger.Info("Server has authentication disabled, login skipped")
+		return nil
+	}
+
 	password := ctx.String("password")
 	if password != "" {
 		// Set password if provided
 		services.Auth.SetPassword(password)
 		services.Logger.Info("Password set from command line")
-	} else {
+	} else if services.Config.IsAuthenticated() {
 		// Use password from config
-		if !services.Config.IsAuthenticated() {
+		services.Logger.Info("Using configured password")
+	} else {
+		// Fall back to an interactive prompt
+		password, err := promptPassword()
+		if err != nil {
+			return errors.UsageError("Failed to read password", err.Error())
+		}
+		if password == "" {
 			return errors.AuthError("No password provided",
 				"Use --password flag or set OPEN_NOTEBOOK_PASSWORD environment variable")
 		}
-		services.Logger.Info("Using configured password")
+		services.Auth.SetPassword(password)
 	}
 
 	// Attempt authentication
@@ -105,12 +140,116 @@ func handleAuthLogin(ctx *cli.Context) error {
 			"Check your password and API connection")
 	}
 
-	fmt.Println("✅ Login successful!")
+	utils.Status(style.OK("Login successful!"))
+	if !services.Config.UseTokenCache() {
+		utils.Status(style.Warn("Token cache disabled, the token will not be reused by later commands"))
+		services.Logger.Info("Authentication completed successfully")
+		return nil
+	}
+
+	// Authenticate stored the token
+	utils.Statusf(style.Icon("💾", "Token stored in %s\n"), services.TokenStore.Path())
 	services.Logger.Info("Authentication completed successfully")
 
 	return nil
 }
 
+// handleAuthLogout handles the auth logout command
+func handleAuthLogout(ctx *cli.Context) error {
+	services, err := getAuthServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Also removes the stored token
+	if err := services.Auth.InvalidateToken(ctx.Context); err != nil {
+		return errors.AuthError("Failed to invalidate token", err.Error())
+	}
+
+	utils.Status(style.Icon("👋", "Logged out"))
+	services.Logger.Info("Logout completed successfully")
+
+	return nil
+}
+
+// handleAuthStatus handles the auth status command
+func handleAuthStatus(ctx *cli.Context) error {
+	services, err := getAuthServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored, err := services.TokenStore.Load()
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to read stored token", err.Error())
+	}
+
+	expiresAt := services.Auth.TokenExpiry(ctx.Context)
+	isAuth := services.Auth.IsAuthenticated(ctx.Context)
+	if !isAuth && stored != nil && stored.Token != "" && stored.APIURL == services.Config.GetAPIURL() && !stored.IsExpired() {
+		isAuth = true
+		expiresAt = stored.ExpiresAt
+	}
+
+	status, statusErr := services.Auth.Status(ctx.Context)
+	authDisabled := statusErr == nil && !status.AuthEnabled && !services.Config.ForceAuth()
+
+	fmt.Printf(style.Icon("🌐", "API URL: %s\n"), services.Config.GetAPIURL())
+	switch {
+	case isAuth:
+		fmt.Println(style.OK("Authenticated"))
+	case authDisabled:
+		fmt.Println(style.OK("No authentication needed"))
+	default:
+		fmt.Println(style.Error("Not authenticated"))
+	}
+
+	if !expiresAt.IsZero() {
+		if time.Now().Before(expiresAt) {
+			fmt.Printf(style.Icon("⏰", "Token expires: %s (in %s)\n"),
+				expiresAt.Local().Format("2006-01-02 15:04:05"), time.Until(expiresAt).Round(time.Second))
+		} else {
+			fmt.Printf(style.Icon("⏰", "Token expired: %s\n"), expiresAt.Local().Format("2006-01-02 15:04:05"))
+		}
+	}
+
+	if statusErr != nil {
+		services.Logger.Debug("Failed to get server auth status", "error", statusErr)
+		fmt.Println(style.Warn("Server auth mode: unknown"))
+	} else if status.AuthEnabled {
+		fmt.Println(style.Icon("🔒", "Server auth: enabled (password required)"))
+	} else {
+		fmt.Println(style.Icon("🔓", "Server auth: disabled (no password needed)"))
+	}
+
+	if stored != nil {
+		fmt.Printf(style.Icon("💾", "Stored token: %s\n"), services.TokenStore.Path())
+	} else {
+		fmt.Println(style.Icon("💾", "No stored token"))
+	}
+
+	return nil
+}
+
+// promptPassword reads a password from stdin, without echoing it when stdin
+// is a terminal. The prompt goes to stderr so it stays out of redirected output.
+func promptPassword() (string, error) {
+	stopPager()
+	fmt.Fprint(os.Stderr, style.Icon("🔑", "Password: "))
+	if fd := int(os.Stdin.Fd()); term.IsTerminal(fd) {
+		password, err := term.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		return string(password), err
+	}
+
+	reader := bufio.NewReader(os.Stdin)
+	line, err := reader.ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
 // AuthCommand returns the auth command and its subcommands
 func AuthCommand() *cli.Command {
 	return &cli.Command{
@@ -119,13 +258,16 @@ func AuthCommand() *cli.Command {
 		Description: "Manage OpenNotebook authentication and access control.\n\n" +
 			"Authentication secures your OpenNotebook instance:\n" +
 			"• Check current authentication status\n" +
-			"• Login with password or token\n" +
+			"• Login with password and store the token locally\n" +
+			"• Logout and remove the stored token\n" +
 			"• Validate API access permissions\n" +
 			"• Manage authentication sessions\n\n" +
 			"Examples:\n" +
 			"  onb auth check                           # Check if authenticated\n" +
 			"  onb auth login --password mypassword     # Login with password\n" +
-			"  onb auth login                           # Login with configured password",
+			"  onb auth login                           # Login with configured password or prompt\n" +
+			"  onb auth status                          # Show authentication status and token expiry\n" +
+			"  onb auth logout                          # Remove the stored token",
 		Subcommands: []*cli.Command{
 			{
 				Name:   "check",
@@ -145,6 +287,16 @@ func AuthCommand() *cli.Command {
 				},
 				Action: handleAuthLogin,
 			},
+			{
+				Name:   "logout",
+				Usage:  "Invalidate and remove the stored token",
+				Action: handleAuthLogout,
+			},
+			{
+				Name:   "status",
+				Usage:  "Show authentication status and token expiry",
+				Action: handleAuthStatus,
+			},
 		},
 	}
 }
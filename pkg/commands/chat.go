@@ -21,11 +21,15 @@ func ChatCommand() *cli.Command {
 			"  onb chat sessions create --title 'Q&A'  # Create new chat session\n" +
 			"  onb chat start 'What is X?'              # Start new chat with default session\n" +
 			"  onb chat start --session abc123 'How?'   # Continue existing session\n" +
+			"  onb chat continue 'And then?'            # Continue the last session\n" +
+			"  onb chat export abc123 --output chat.md  # Export a session transcript\n" +
 			"  onb chat sessions delete abc123          # Delete a chat session",
 		Subcommands: []*cli.Command{
 			chatSessionsCommand(),
 			chatStartCommand(),
+			chatContinueCommand(),
 			chatHistoryCommand(),
+			chatExportCommand(),
 		},
 	}
 }
@@ -42,7 +46,8 @@ func chatSessionsCommand() *cli.Command {
 			"• Delete unwanted sessions\n" +
 			"• View session details and settings\n\n" +
 			"Examples:\n" +
-			"  onb chat sessions list                     # List all sessions\n" +
+			"  onb chat sessions list --notebook <id>     # List sessions of a notebook\n" +
+			"  onb chat sessions list --all-notebooks     # List sessions across all notebooks\n" +
 			"  onb chat sessions create --title 'Research' # Create new session\n" +
 			"  onb chat sessions delete abc123             # Delete session",
 		Subcommands: []*cli.Command{
@@ -78,10 +83,14 @@ func chatSessionsListCommand() *cli.Command {
 				Value: false,
 			},
 			&cli.StringFlag{
-				Name:     "notebook",
-				Aliases:  []string{"n"},
-				Usage:    "Notebook ID to list chat sessions for (required)",
-				Required: true,
+				Name:    "notebook",
+				Aliases: []string{"n"},
+				Usage:   "Notebook ID or name to list chat sessions for (required unless --all-notebooks)",
+			},
+			notebookIDFlag(),
+			&cli.BoolFlag{
+				Name:  "all-notebooks",
+				Usage: "List chat sessions of every notebook",
 			},
 		},
 		Action: handleChatSessionsList,
@@ -144,40 +153,65 @@ func chatStartCommand() *cli.Command {
 		Name:  "start",
 		Usage: "Start a new chat or continue existing session",
 		Args:  true,
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			&cli.StringFlag{
 				Name:    "session",
 				Aliases: []string{"s"},
 				Usage:   "Session ID to continue (creates new if not provided)",
 			},
-			&cli.StringFlag{
-				Name:    "model",
-				Aliases: []string{"m"},
-				Usage:   "Model ID to use for this conversation (optional, uses session default)",
-			},
-			&cli.StringFlag{
-				Name:    "notebook",
-				Aliases: []string{"n"},
-				Usage:   "Notebook ID to use as context (optional)",
-			},
-			&cli.StringSliceFlag{
-				Name:    "source",
-				Aliases: []string{"sources"},
-				Usage:   "Source IDs to include as context (can be specified multiple times)",
-			},
-			&cli.IntFlag{
-				Name:    "max-tokens",
-				Aliases: []string{"mt"},
-				Usage:   "Maximum context tokens (optional)",
-			},
+		}, chatExchangeFlags()...),
+		Action: handleChatStart,
+	}
+}
+
+// chatContinueCommand resumes the last chat session of a notebook
+func chatContinueCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "continue",
+		Usage:     "Continue the last chat session used for a notebook",
+		ArgsUsage: "\"<message>\"",
+		Args:      true,
+		Flags: append([]cli.Flag{
 			&cli.BoolFlag{
-				Name:    "stream",
-				Aliases: []string{"r"},
-				Usage:   "Stream response in real-time (default: true)",
-				Value:   true,
+				Name:  "new",
+				Usage: "Start a fresh session instead of resuming the last one",
 			},
+		}, chatExchangeFlags()...),
+		Action: handleChatContinue,
+	}
+}
+
+// chatExchangeFlags are the model and context flags shared by chat start and continue
+func chatExchangeFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    "model",
+			Aliases: []string{"m"},
+			Usage:   "Model ID to use for this conversation (optional, uses session default)",
+		},
+		&cli.StringFlag{
+			Name:    "notebook",
+			Aliases: []string{"n"},
+			Usage:   "Notebook ID or name to use as context (optional)",
+		},
+		notebookIDFlag(),
+		&cli.StringSliceFlag{
+			Name:    "source",
+			Aliases: []string{"sources"},
+			Usage:   "Source IDs to include as context (can be specified multiple times)",
+		},
+		contextConfigFileFlag(),
+		&cli.IntFlag{
+			Name:    "max-tokens",
+			Aliases: []string{"mt"},
+			Usage:   "Maximum context tokens (optional)",
+		},
+		&cli.BoolFlag{
+			Name:    "stream",
+			Aliases: []string{"r"},
+			Usage:   "Stream response in real-time (default: true)",
+			Value:   true,
 		},
-		Action: handleChatStart,
 	}
 }
 
@@ -203,4 +237,28 @@ func chatHistoryCommand() *cli.Command {
 		},
 		Action: handleChatHistory,
 	}
-}
\ No newline at end of file
+}
+
+// chatExportCommand writes a session transcript to a file
+func chatExportCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "export",
+		Usage:     "Export a chat session transcript as markdown or JSON",
+		ArgsUsage: "<session-id>",
+		Args:      true,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "format",
+				Aliases: []string{"f"},
+				Usage:   "Transcript format: markdown or json",
+				Value:   "markdown",
+			},
+			&cli.StringFlag{
+				Name:    "output",
+				Aliases: []string{"o"},
+				Usage:   "Output file path (default: stdout)",
+			},
+		},
+		Action: handleChatExport,
+	}
+}
@@ -1,24 +1,31 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/denkhaus/open-notebook-cli/pkg/config"
 	"github.com/denkhaus/open-notebook-cli/pkg/errors"
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
 	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
 	"github.com/samber/do/v2"
 	"github.com/urfave/cli/v2"
 )
 
 // ChatServices holds all the services needed for chat commands
 type ChatServices struct {
-	ChatService shared.ChatRepository
-	Config      config.Service
-	Logger      shared.Logger
+	ChatService     shared.ChatRepository
+	NotebookService shared.NotebookService
+	ContextRepo     shared.ContextRepository
+	Sessions        shared.SessionStore
+	Config          config.Service
+	Logger          shared.Logger
 }
 
 // getChatServices retrieves all required services via dependency injection
@@ -30,9 +37,12 @@ func getChatServices(ctx *cli.Context) (*ChatServices, error) {
 	}
 
 	return &ChatServices{
-		ChatService: do.MustInvoke[shared.ChatRepository](injector),
-		Config:      do.MustInvoke[config.Service](injector),
-		Logger:      do.MustInvoke[shared.Logger](injector),
+		ChatService:     do.MustInvoke[shared.ChatRepository](injector),
+		NotebookService: do.MustInvoke[shared.NotebookService](injector),
+		ContextRepo:     do.MustInvoke[shared.ContextRepository](injector),
+		Sessions:        do.MustInvoke[shared.SessionStore](injector),
+		Config:          do.MustInvoke[config.Service](injector),
+		Logger:          do.MustInvoke[shared.Logger](injector),
 	}, nil
 }
 
@@ -58,9 +68,9 @@ func validateChatArgs(ctx *cli.Context, requireSessionID bool) (string, error) {
 
 // printChatSession prints formatted chat session information
 func printChatSession(session *models.ChatSession) {
-	status := "🔴 Inactive"
+	status := style.Icon("🔴", "Inactive")
 	if session.IsActive {
-		status = "🟢 Active"
+		status = style.Icon("🟢", "Active")
 	}
 
 	fmt.Printf("  ID:           %s\n", session.ID)
@@ -79,30 +89,48 @@ func handleChatSessionsList(ctx *cli.Context) error {
 		return err
 	}
 
-	notebookID := ctx.String("notebook")
-	services.Logger.Info("Listing chat sessions...", "notebook_id", notebookID)
+	notebookID, err := resolveNotebookFlag(ctx, services.NotebookService)
+	if err != nil {
+		return err
+	}
+	allNotebooks := ctx.Bool("all-notebooks")
+	services.Logger.Info("Listing chat sessions...", "notebook_id", notebookID, "all_notebooks", allNotebooks)
 
 	// API requires notebook_id parameter - fail loud if missing
-	if notebookID == "" {
+	if notebookID == "" && !allNotebooks {
 		return errors.RequiredField("Notebook ID", ctx.Command.Name)
 	}
+	if notebookID != "" && allNotebooks {
+		return errors.UsageError("--notebook and --all-notebooks cannot be combined")
+	}
 
-	response, err := services.ChatService.ListSessionsForNotebook(ctx.Context, notebookID)
-	if err != nil {
-		return errors.APIError("Failed to list chat sessions",
-			"Check API connection and permissions")
+	var sessions []notebookChatSession
+	if allNotebooks {
+		sessions, err = listAllNotebookSessions(ctx, services)
+		if err != nil {
+			return err
+		}
+	} else {
+		response, err := services.ChatService.ListSessionsForNotebook(ctx.Context, notebookID)
+		if err != nil {
+			return errors.WrapAPIError(err, "Failed to list chat sessions",
+				"Check API connection and permissions")
+		}
+		for _, session := range *response {
+			sessions = append(sessions, notebookChatSession{NotebookID: notebookID, ChatSession: session})
+		}
 	}
 
-	if len(*response) == 0 {
-		fmt.Println("No chat sessions found.")
+	if len(sessions) == 0 {
+		utils.Status("No chat sessions found.")
 		return nil
 	}
 
 	// Filter sessions
-	filteredSessions := []models.ChatSession{}
+	filteredSessions := []notebookChatSession{}
 	activeOnly := ctx.Bool("active-only")
 
-	for _, session := range *response {
+	for _, session := range sessions {
 		if activeOnly && !session.IsActive {
 			continue
 		}
@@ -110,7 +138,7 @@ func handleChatSessionsList(ctx *cli.Context) error {
 	}
 
 	if len(filteredSessions) == 0 {
-		fmt.Println("No sessions found matching criteria.")
+		utils.Status("No sessions found matching criteria.")
 		return nil
 	}
 
@@ -131,14 +159,20 @@ func handleChatSessionsList(ctx *cli.Context) error {
 
 	// Display sessions in a table
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if allNotebooks {
+		fmt.Fprint(w, "NOTEBOOK\t")
+	}
 	fmt.Fprintln(w, "ID\tTITLE\tMODEL\tMESSAGES\tSTATUS\tCREATED")
 
 	for _, session := range displaySessions {
-		status := "🔴"
+		status := style.Symbol("🔴", "inactive")
 		if session.IsActive {
-			status = "🟢"
+			status = style.Symbol("🟢", "active")
 		}
 
+		if allNotebooks {
+			fmt.Fprintf(w, "%s\t", utils.TruncateString(session.NotebookName, 20))
+		}
 		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\n",
 			session.ID,
 			utils.TruncateString(session.Title, 25),
@@ -150,10 +184,48 @@ func handleChatSessionsList(ctx *cli.Context) error {
 
 	w.Flush()
 
-	fmt.Printf("\nShowing %d sessions (use --limit and --offset for pagination)\n", len(displaySessions))
+	utils.Statusf("\nShowing %d sessions (use --limit and --offset for pagination)\n", len(displaySessions))
 	return nil
 }
 
+// notebookChatSession is a chat session together with the notebook it belongs to
+type notebookChatSession struct {
+	NotebookID   string
+	NotebookName string
+	models.ChatSession
+}
+
+// listAllNotebookSessions aggregates the chat sessions of every notebook.
+// Notebooks without sessions simply contribute nothing.
+func listAllNotebookSessions(ctx *cli.Context, services *ChatServices) ([]notebookChatSession, error) {
+	notebooks, err := services.NotebookService.ListNotebooks(ctx.Context)
+	if err != nil {
+		return nil, errors.WrapAPIError(err, "Failed to list notebooks",
+			"Check API connection and permissions")
+	}
+
+	var sessions []notebookChatSession
+	for _, notebook := range notebooks {
+		response, err := services.ChatService.ListSessionsForNotebook(ctx.Context, notebook.ID)
+		if err != nil {
+			return nil, errors.WrapAPIError(err,
+				fmt.Sprintf("Failed to list chat sessions for notebook '%s'", notebook.ID),
+				"Check API connection and permissions")
+		}
+		if response == nil {
+			continue
+		}
+		for _, session := range *response {
+			sessions = append(sessions, notebookChatSession{
+				NotebookID:   notebook.ID,
+				NotebookName: notebook.Name,
+				ChatSession:  session,
+			})
+		}
+	}
+	return sessions, nil
+}
+
 // handleChatSessionsCreate handles creating a new chat session
 func handleChatSessionsCreate(ctx *cli.Context) error {
 	services, err := getChatServices(ctx)
@@ -176,15 +248,15 @@ func handleChatSessionsCreate(ctx *cli.Context) error {
 
 	session, err := services.ChatService.CreateSession(ctx.Context, request)
 	if err != nil {
-		return errors.APIError("Failed to create chat session",
+		return errors.WrapAPIError(err, "Failed to create chat session",
 			"Check input parameters and API permissions")
 	}
 
-	fmt.Printf("✅ Chat session created successfully!\n")
+	utils.Status(style.OK("Chat session created successfully!"))
 	printChatSession(session)
 
-	fmt.Printf("\nStart chatting with:\n")
-	fmt.Printf("  onb chat start --session %s \"Your message here\"\n", session.ID)
+	utils.Statusf("\nStart chatting with:\n")
+	utils.Statusf("  onb chat start --session %s \"Your message here\"\n", session.ID)
 
 	return nil
 }
@@ -202,26 +274,25 @@ func handleChatSessionsDelete(ctx *cli.Context) error {
 	}
 
 	// Confirm deletion unless force flag is used
-	if !ctx.Bool("force") {
-		fmt.Printf("⚠️  Are you sure you want to delete chat session '%s'? [y/N]: ", sessionID)
-		var response string
-		fmt.Scanln(&response)
-		if response != "y" && response != "Y" {
-			fmt.Println("❌ Deletion cancelled")
-			return nil
-		}
+	ok, err := confirmDestructive(ctx, style.Warn(fmt.Sprintf("Are you sure you want to delete chat session '%s'?", sessionID)))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Println(style.Error("Deletion cancelled"))
+		return nil
 	}
 
-	fmt.Printf("🗑️  Deleting chat session: %s\n", sessionID)
+	utils.Statusf(style.Icon("🗑️", "Deleting chat session: %s\n"), sessionID)
 	services.Logger.Info("Deleting chat session", "session_id", sessionID)
 
 	err = services.ChatService.DeleteSession(ctx.Context, sessionID)
 	if err != nil {
-		return errors.APIError("Failed to delete chat session",
+		return errors.WrapAPIError(err, "Failed to delete chat session",
 			"Check session ID and permissions")
 	}
 
-	fmt.Printf("✅ Chat session '%s' deleted successfully!\n", sessionID)
+	utils.Statusf(style.OK("Chat session '%s' deleted successfully!\n"), sessionID)
 	return nil
 }
 
@@ -241,11 +312,11 @@ func handleChatSessionsShow(ctx *cli.Context) error {
 
 	session, err := services.ChatService.GetSession(ctx.Context, sessionID)
 	if err != nil {
-		return errors.APIError("Failed to get chat session details",
+		return errors.WrapAPIError(err, "Failed to get chat session details",
 			"Check session ID and permissions")
 	}
 
-	fmt.Printf("💬 Chat Session Details:\n")
+	fmt.Println(style.Icon("💬", "Chat Session Details:"))
 	printChatSession(session)
 
 	return nil
@@ -263,22 +334,80 @@ func handleChatStart(ctx *cli.Context) error {
 			"Usage: open-notebook chat start [--session <id>] \"Your message\"")
 	}
 
-	message := ctx.Args().First()
-	sessionID := ctx.String("session")
+	notebookID, err := resolveNotebookFlag(ctx, services.NotebookService)
+	if err != nil {
+		return err
+	}
+
+	return runChat(ctx, services, ctx.Args().First(), ctx.String("session"), notebookID)
+}
+
+// handleChatContinue resumes the last session used for the notebook, or
+// starts a new one with --new
+func handleChatContinue(ctx *cli.Context) error {
+	services, err := getChatServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	if ctx.NArg() < 1 {
+		return errors.UsageError("Message is required",
+			"Usage: open-notebook chat continue [--notebook <id>] [--new] \"Your message\"")
+	}
+
+	notebookID, err := resolveNotebookFlag(ctx, services.NotebookService)
+	if err != nil {
+		return err
+	}
+	if ctx.Bool("new") {
+		if err := services.Sessions.ClearLastSession(notebookID); err != nil {
+			return errors.ConfigError("Failed to reset the last chat session", err.Error())
+		}
+		return runChat(ctx, services, ctx.Args().First(), "", notebookID)
+	}
+
+	sessionID, err := services.Sessions.LastSession(notebookID)
+	if err != nil {
+		return errors.ConfigError("Failed to read the last chat session", err.Error())
+	}
+	if sessionID == "" {
+		scope := "without a notebook"
+		if notebookID != "" {
+			scope = fmt.Sprintf("for notebook '%s'", notebookID)
+		}
+		return errors.NotFoundError("No previous chat session "+scope,
+			"Start one with 'onb chat start' or use 'onb chat continue --new'")
+	}
+
+	return runChat(ctx, services, ctx.Args().First(), sessionID, notebookID)
+}
+
+// runChat sends message in sessionID (a new session when empty) with
+// notebookID and the other context flags of ctx as context, and remembers the
+// session as the notebook's last one
+func runChat(ctx *cli.Context, services *ChatServices, message, sessionID, notebookID string) error {
 	modelID := ctx.String("model")
-	notebookID := ctx.String("notebook")
 	sources := ctx.StringSlice("source")
 	maxTokens := ctx.Int("max-tokens")
 	stream := ctx.Bool("stream")
 
+	contextConfig, err := chatContextConfig(ctx, sources)
+	if err != nil {
+		return err
+	}
+	if contextConfig != nil {
+		sources = contextSourceIDs(contextConfig)
+	}
+
 	services.Logger.Info("Starting chat", "session_id", sessionID, "message", utils.TruncateString(message, 50))
 
 	// Build context request if any context options are provided
 	var context *models.ChatContextRequest
-	if notebookID != "" || len(sources) > 0 || maxTokens > 0 {
+	if notebookID != "" || len(sources) > 0 || maxTokens > 0 || contextConfig != nil {
 		context = &models.ChatContextRequest{
-			NotebookID: notebookID,
-			Sources:    sources,
+			NotebookID:    notebookID,
+			Sources:       sources,
+			ContextConfig: contextConfig,
 		}
 		if maxTokens > 0 {
 			context.MaxTokens = &maxTokens
@@ -300,76 +429,203 @@ func handleChatStart(ctx *cli.Context) error {
 		request.ModelID = &modelID
 	}
 
-	fmt.Printf("💬 Starting chat...\n")
+	utils.Status(style.Icon("💬", "Starting chat..."))
 	if sessionID != "" {
-		fmt.Printf("  Session: %s\n", sessionID)
+		utils.Statusf("  Session: %s\n", sessionID)
 	}
 	if modelID != "" {
-		fmt.Printf("  Model:   %s\n", modelID)
+		utils.Statusf("  Model:   %s\n", modelID)
 	}
-	fmt.Printf("  Message: %s\n", utils.TruncateString(message, 100))
+	utils.Statusf("  Message: %s\n", utils.TruncateString(message, 100))
 
 	if context != nil {
-		fmt.Printf("  Context: ")
+		utils.Statusf("  Context: ")
 		if notebookID != "" {
-			fmt.Printf("Notebook: %s ", notebookID)
+			utils.Statusf("Notebook: %s ", notebookID)
 		}
 		if len(sources) > 0 {
-			fmt.Printf("Sources: %v ", sources)
+			utils.Statusf("Sources: %v ", sources)
 		}
 		if maxTokens > 0 {
-			fmt.Printf("Max Tokens: %d ", maxTokens)
+			utils.Statusf("Max Tokens: %d ", maxTokens)
 		}
-		fmt.Printf("\n")
+		utils.Statusf("\n")
+	}
+
+	if notebookID != "" {
+		estimateChatContext(ctx, services, notebookID, sources, contextConfig, maxTokens)
 	}
 
+	var usedSession string
 	if stream {
-		return handleStreamingChat(services, ctx, request)
+		usedSession, err = handleStreamingChat(services, ctx, request)
 	} else {
-		return handleSimpleChat(services, ctx, request)
+		usedSession, err = handleSimpleChat(services, ctx, request)
 	}
+	if err != nil {
+		return err
+	}
+
+	if usedSession != "" {
+		if err := services.Sessions.SetLastSession(notebookID, usedSession); err != nil {
+			services.Logger.Warn("Failed to remember chat session", "session_id", usedSession, "error", err)
+		}
+	}
+	return nil
 }
 
-// handleStreamingChat handles streaming chat responses
-func handleStreamingChat(services *ChatServices, ctx *cli.Context, request *models.ChatExecuteRequest) error {
-	fmt.Printf("🔄 Assistant (streaming):\n")
+// handleStreamingChat handles streaming chat responses and returns the
+// session used, if known
+func handleStreamingChat(services *ChatServices, ctx *cli.Context, request *models.ChatExecuteRequest) (string, error) {
+	utils.Status(style.Icon("🔄", "Assistant (streaming):"))
 
 	chunkChan, err := services.ChatService.StreamChat(ctx.Context, request)
 	if err != nil {
-		return errors.APIError("Failed to start chat stream",
+		return "", errors.WrapAPIError(err, "Failed to start chat stream",
 			"Check connection and permissions")
 	}
 
 	// Stream chunks
+	sessionID := request.SessionID
+	var contextSources []models.ChatContextSource
+	var usage *models.TokenUsage
 	for chunk := range chunkChan {
 		if chunk.Error != "" {
-			return errors.APIError("Chat streaming error", chunk.Error)
+			return "", errors.APIError("Chat streaming error", chunk.Error)
+		}
+		if chunk.SessionID != "" {
+			sessionID = chunk.SessionID
 		}
 
 		fmt.Printf("%s", chunk.Content)
+		if len(chunk.ContextSources) > 0 {
+			contextSources = chunk.ContextSources
+		}
+		if chunk.Usage != nil {
+			usage = chunk.Usage
+		}
 
 		if chunk.Done {
 			break
 		}
 	}
 
-	fmt.Printf("\n\n✅ Chat completed\n")
-	return nil
+	fmt.Println()
+	printContextSources(contextSources, request.Context != nil)
+	printTokenUsage(usage, chatMaxTokens(request))
+	utils.Status("\n" + style.OK("Chat completed"))
+	return sessionID, nil
 }
 
-// handleSimpleChat handles simple (non-streaming) chat responses
-func handleSimpleChat(services *ChatServices, ctx *cli.Context, request *models.ChatExecuteRequest) error {
-	fmt.Printf("🤖 Thinking...\n")
+// handleSimpleChat handles simple (non-streaming) chat responses and returns
+// the session used
+func handleSimpleChat(services *ChatServices, ctx *cli.Context, request *models.ChatExecuteRequest) (string, error) {
+	utils.Status(style.Icon("🤖", "Thinking..."))
 
 	response, err := services.ChatService.ExecuteChat(ctx.Context, request)
 	if err != nil {
-		return errors.APIError("Failed to execute chat",
+		return "", errors.WrapAPIError(err, "Failed to execute chat",
 			"Check connection and permissions")
 	}
 
-	fmt.Printf("💬 Assistant:\n%s\n\n", response.Content)
-	fmt.Printf("✅ Chat completed (Session: %s, Message: %s)\n", response.SessionID, response.MessageID)
-	return nil
+	fmt.Printf(style.Icon("💬", "Assistant:\n%s\n\n"), response.Content)
+	printContextSources(response.ContextSources, request.Context != nil)
+	printTokenUsage(response.Usage, chatMaxTokens(request))
+	utils.Statusf(style.OK("Chat completed (Session: %s, Message: %s)\n"), response.SessionID, response.MessageID)
+	return response.SessionID, nil
+}
+
+// chatMaxTokens returns the context token budget of a chat request, or 0
+func chatMaxTokens(request *models.ChatExecuteRequest) int {
+	if request.Context == nil || request.Context.MaxTokens == nil {
+		return 0
+	}
+	return *request.Context.MaxTokens
+}
+
+// chatContextConfig loads --context-config-file and adds the --source IDs
+// missing from it at high relevance. It returns nil without a config file.
+func chatContextConfig(ctx *cli.Context, sources []string) (*models.ContextConfig, error) {
+	path := ctx.String("context-config-file")
+	if path == "" {
+		return nil, nil
+	}
+
+	config, err := loadContextConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, sourceID := range sources {
+		if _, ok := config.Sources[sourceID]; ok {
+			continue
+		}
+		if config.Sources == nil {
+			config.Sources = map[string]models.ContextLevel{}
+		}
+		config.Sources[sourceID] = models.ContextLevelHigh
+	}
+	return config, nil
+}
+
+// estimateChatContext previews the context the server will assemble for a
+// notebook chat and reports its size against --max-tokens, listing the
+// sources that would not fit. Failures only produce a warning.
+func estimateChatContext(ctx *cli.Context, services *ChatServices, notebookID string, sources []string, contextConfig *models.ContextConfig, maxTokens int) {
+	request := &models.ContextRequest{NotebookID: &notebookID, ContextConfig: contextConfig}
+	if contextConfig == nil && len(sources) > 0 {
+		config := &models.ContextConfig{Sources: map[string]models.ContextLevel{}}
+		for _, sourceID := range sources {
+			config.Sources[sourceID] = models.ContextLevelHigh
+		}
+		request.ContextConfig = config
+	}
+
+	response, err := services.ContextRepo.Get(ctx.Context, request)
+	if err != nil {
+		services.Logger.Warn("Failed to estimate chat context", "notebook_id", notebookID, "error", err)
+		utils.Status("  " + style.Warn("Could not estimate context size"))
+		return
+	}
+
+	items := contextItems(append(response.Sources, response.Notes...))
+	total := contextTotalTokens(response, items)
+	printTokenBudget("Estimated context", total, maxTokens)
+
+	if maxTokens <= 0 || total <= maxTokens {
+		return
+	}
+	dropped := droppedContextItems(items, maxTokens)
+	if len(dropped) == 0 {
+		return
+	}
+	utils.Statusf("  %s\n", style.Warn(fmt.Sprintf("%d context item(s) exceed --max-tokens and may be dropped:", len(dropped))))
+	for _, item := range dropped {
+		utils.Statusf("    • %s (~%d tokens)\n", item.label(), item.Tokens)
+	}
+}
+
+// printContextSources lists the context the server reported using for a
+// response. When context was requested but the server did not report any,
+// a short note says so instead.
+func printContextSources(sources []models.ChatContextSource, contextRequested bool) {
+	if len(sources) == 0 {
+		if contextRequested {
+			utils.Status(style.Warn("Server did not report which context was used"))
+		}
+		return
+	}
+
+	utils.Status(style.Icon("📚", "Context used:"))
+	for _, source := range sources {
+		label := source.ID
+		if source.Title != "" {
+			label = fmt.Sprintf("%s (%s)", source.Title, source.ID)
+		}
+		if source.Type != "" {
+			label = fmt.Sprintf("[%s] %s", source.Type, label)
+		}
+		utils.Statusf("  • %s\n", label)
+	}
 }
 
 // handleChatHistory handles showing chat history
@@ -391,7 +647,7 @@ func handleChatHistory(ctx *cli.Context) error {
 
 	messages, err := services.ChatService.GetMessages(ctx.Context, sessionID)
 	if err != nil {
-		return errors.APIError("Failed to get chat history",
+		return errors.WrapAPIError(err, "Failed to get chat history",
 			"Check session ID and permissions")
 	}
 
@@ -409,8 +665,8 @@ func handleChatHistory(ctx *cli.Context) error {
 		}
 	}
 
-	fmt.Printf("💬 Chat History (Session: %s)\n", sessionID)
-	fmt.Printf("   Showing %d messages\n\n", len(messages))
+	fmt.Printf(style.Icon("💬", "Chat History (Session: %s)\n"), sessionID)
+	utils.Statusf("   Showing %d messages\n\n", len(messages))
 
 	// Display messages
 	if reverse {
@@ -439,6 +695,129 @@ func displayChatMessage(msg *models.ChatMessage) {
 		roleIcon = "⚙️"
 	}
 
-	fmt.Printf("%s %s (%s):\n", roleIcon, msg.Role, utils.FormatTimestamp(msg.Created))
+	fmt.Printf("%s (%s):\n", style.Icon(roleIcon, msg.Role), utils.FormatTimestamp(msg.Created))
 	fmt.Printf("   %s\n\n", msg.Content)
 }
+
+// chatTranscript is the JSON form of an exported chat session
+type chatTranscript struct {
+	Session  *models.ChatSession   `json:"session"`
+	Exported string                `json:"exported"`
+	Messages []*models.ChatMessage `json:"messages"`
+}
+
+// handleChatExport writes the messages of a session to a markdown or JSON transcript
+func handleChatExport(ctx *cli.Context) error {
+	sessionID, err := validateChatArgs(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	format := strings.ToLower(ctx.String("format"))
+	if format == "md" {
+		format = "markdown"
+	}
+	if format != "markdown" && format != "json" {
+		return errors.UsageError(fmt.Sprintf("Invalid format '%s'", ctx.String("format")),
+			"Use --format markdown or --format json")
+	}
+
+	services, err := getChatServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	outputPath := ctx.String("output")
+	services.Logger.Info("Exporting chat session", "session_id", sessionID, "format", format, "output", outputPath)
+
+	session, err := services.ChatService.GetSession(ctx.Context, sessionID)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to get chat session",
+			"Check session ID and permissions")
+	}
+
+	messages, err := services.ChatService.GetMessages(ctx.Context, sessionID)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to get chat history",
+			"Check session ID and permissions")
+	}
+	if messages == nil {
+		messages = []*models.ChatMessage{}
+	}
+
+	transcript := &chatTranscript{
+		Session:  session,
+		Exported: time.Now().Format(time.RFC3339),
+		Messages: messages,
+	}
+
+	var data []byte
+	if format == "json" {
+		data, err = json.MarshalIndent(transcript, "", "  ")
+		if err != nil {
+			return errors.ValidationError("Failed to format transcript as JSON",
+				fmt.Sprintf("JSON marshaling error: %v", err))
+		}
+		data = append(data, '\n')
+	} else {
+		data = []byte(formatChatMarkdown(transcript))
+	}
+
+	if outputPath == "" || outputPath == "-" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(outputPath, data, 0644); err != nil {
+		return errors.ValidationError("Failed to write transcript",
+			fmt.Sprintf("Check that '%s' is writable", outputPath))
+	}
+
+	utils.Statusf(style.OK("Exported %d messages to %s\n"), len(messages), outputPath)
+	return nil
+}
+
+// formatChatMarkdown renders a transcript with a metadata header and one
+// section per message
+func formatChatMarkdown(transcript *chatTranscript) string {
+	var b strings.Builder
+
+	session := transcript.Session
+	title := session.Title
+	if title == "" {
+		title = "Chat session " + session.ID
+	}
+	fmt.Fprintf(&b, "# %s\n\n", title)
+	fmt.Fprintf(&b, "- Session: %s\n", session.ID)
+	if session.ModelID != "" {
+		fmt.Fprintf(&b, "- Model: %s\n", session.ModelID)
+	}
+	if session.Created != "" {
+		fmt.Fprintf(&b, "- Created: %s\n", exportTimestamp(session.Created))
+	}
+	fmt.Fprintf(&b, "- Exported: %s\n", transcript.Exported)
+	fmt.Fprintf(&b, "- Messages: %d\n", len(transcript.Messages))
+
+	for _, msg := range transcript.Messages {
+		role := msg.Role
+		if role != "" {
+			role = strings.ToUpper(role[:1]) + role[1:]
+		}
+		fmt.Fprintf(&b, "\n## %s", role)
+		if msg.Created != "" {
+			fmt.Fprintf(&b, " (%s)", exportTimestamp(msg.Created))
+		}
+		fmt.Fprintf(&b, "\n\n%s\n", strings.TrimSpace(msg.Content))
+	}
+
+	return b.String()
+}
+
+// exportTimestamp renders an API timestamp as RFC 3339 in UTC. Exports are
+// kept, so they never use --relative-time ages like "2h ago".
+func exportTimestamp(timestamp string) string {
+	if t, ok := utils.ParseTimestamp(timestamp); ok {
+		return t.UTC().Format(time.RFC3339)
+	}
+	return timestamp
+}
@@ -0,0 +1,27 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatChatMarkdown_AbsoluteTimestamps(t *testing.T) {
+	utils.ConfigureTimestamps(true)
+	defer utils.ConfigureTimestamps(false)
+
+	recent := time.Now().Add(-2 * time.Hour).UTC()
+	transcript := &chatTranscript{
+		Session:  &models.ChatSession{ID: "chat_session:1", Created: recent.Format("2006-01-02T15:04:05.000000")},
+		Exported: "2026-01-02T03:04:05Z",
+		Messages: []*models.ChatMessage{{Role: "user", Content: "hi", Created: recent.Format(time.RFC3339)}},
+	}
+
+	markdown := formatChatMarkdown(transcript)
+	assert.NotContains(t, markdown, "ago")
+	assert.Contains(t, markdown, "- Created: "+recent.Format(time.RFC3339)+"\n")
+	assert.Contains(t, markdown, "## User ("+recent.Format(time.RFC3339)+")")
+}
@@ -0,0 +1,87 @@
+package commands
+
+import (
+	stderrors "errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/urfave/cli/v2"
+)
+
+// confirmDestructive asks prompt before a destructive operation unless
+// --force is set. Without a terminal to ask on it fails with a hint to use
+// --force rather than proceeding or silently doing nothing.
+func confirmDestructive(ctx *cli.Context, prompt string) (bool, error) {
+	if ctx.Bool("force") {
+		return true, nil
+	}
+
+	ok, err := utils.Confirm(prompt, false)
+	if stderrors.Is(err, utils.ErrNotInteractive) {
+		return false, errors.UsageError("Confirmation required but stdin is not interactive",
+			"Use --force to skip the confirmation prompt")
+	}
+	return ok, err
+}
+
+// itemConfirmer asks for confirmation of every item of a destructive batch
+// operation. Besides yes and no, answering "all" accepts the current and
+// every remaining item without asking again, and "quit" declines them all.
+type itemConfirmer struct {
+	all  bool
+	quit bool
+}
+
+// newItemConfirmer returns an itemConfirmer, or a usage error when stdin is
+// not a terminal to ask on
+func newItemConfirmer() (*itemConfirmer, error) {
+	if !utils.IsTerminal(os.Stdin) {
+		return nil, errors.UsageError("--confirm-each requires an interactive terminal",
+			"Pass the IDs to delete explicitly and use --force in scripts")
+	}
+	stopPager()
+	return &itemConfirmer{}, nil
+}
+
+// Confirm prints prompt and reports whether the item should be processed
+func (c *itemConfirmer) Confirm(prompt string) bool {
+	switch {
+	case c.all:
+		return true
+	case c.quit:
+		return false
+	}
+
+	for {
+		fmt.Printf("%s [y/N/a/q]: ", prompt)
+		response, err := utils.ReadLine()
+		if err != nil {
+			fmt.Println()
+			c.quit = true
+			return false
+		}
+
+		switch strings.ToLower(response) {
+		case "y", "yes":
+			return true
+		case "", "n", "no":
+			return false
+		case "a", "all":
+			c.all = true
+			return true
+		case "q", "quit":
+			c.quit = true
+			return false
+		default:
+			fmt.Println("Please answer y (yes), n (no), a (all remaining) or q (quit).")
+		}
+	}
+}
+
+// Quit reports whether the user aborted the remaining items
+func (c *itemConfirmer) Quit() bool {
+	return c.quit
+}
@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// ContextCommand returns the context command
+func ContextCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "context",
+		Usage: "Notebook context commands",
+		Description: "Inspect the context the server assembles from a notebook's sources\n" +
+			"and notes before it is sent to a model.\n\n" +
+			"Examples:\n" +
+			"  onb context estimate <notebook-id>                                # Whole notebook\n" +
+			"  onb context estimate <notebook-id> --source source:abc:high       # Selected sources\n" +
+			"  onb context estimate <notebook-id> --context-config-file ctx.yaml # Levels from a file\n" +
+			"  onb context estimate <notebook-id> --max-tokens 32000             # Warn above a limit",
+		Subcommands: []*cli.Command{
+			contextEstimateCommand(),
+		},
+	}
+}
+
+// contextEstimateCommand previews the size of a notebook context
+func contextEstimateCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "estimate",
+		Usage:     "Estimate the token size of a notebook context",
+		ArgsUsage: "<notebook>",
+		Description: "Assemble the context of a notebook without asking a model and report\n" +
+			"its total token count with a per-source and per-note breakdown.\n\n" +
+			"--source and --note take an ID with an optional level suffix\n" +
+			"(low, medium, high, critical), e.g. source:abc:low; the level\n" +
+			"defaults to high. They override the entries of --context-config-file.",
+		Flags: []cli.Flag{
+			&cli.StringSliceFlag{
+				Name:    "source",
+				Aliases: []string{"s"},
+				Usage:   "Source to include as ID[:level] (can be repeated)",
+			},
+			&cli.StringSliceFlag{
+				Name:  "note",
+				Usage: "Note to include as ID[:level] (can be repeated)",
+			},
+			contextConfigFileFlag(),
+			&cli.IntFlag{
+				Name:    "max-tokens",
+				Aliases: []string{"m"},
+				Usage:   "Warn when the context approaches or exceeds this many tokens (0 disables)",
+			},
+			fieldsFlag(),
+			noHeaderFlag(),
+		},
+		Action: handleContextEstimate,
+	}
+}
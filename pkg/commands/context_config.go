@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"bytes"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// contextLevels are the valid relevance levels of context items
+var contextLevels = []models.ContextLevel{
+	models.ContextLevelLow,
+	models.ContextLevelMedium,
+	models.ContextLevelHigh,
+	models.ContextLevelCritical,
+}
+
+// contextConfigFileFlag returns the --context-config-file flag
+func contextConfigFileFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:      "context-config-file",
+		Usage:     "YAML or JSON file mapping source and note IDs to context levels (low, medium, high, critical)",
+		TakesFile: true,
+	}
+}
+
+// contextConfigFile is the layout of a --context-config-file:
+//
+//	sources:
+//	  source:abc: high
+//	notes:
+//	  note:xyz: low
+type contextConfigFile struct {
+	Sources map[string]string `yaml:"sources"`
+	Notes   map[string]string `yaml:"notes"`
+}
+
+// loadContextConfig reads and validates a --context-config-file. Errors name
+// the offending entry.
+func loadContextConfig(path string) (*models.ContextConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.UsageError(fmt.Sprintf("Cannot read context config file '%s'", path), err.Error())
+	}
+
+	var file contextConfigFile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && !stderrors.Is(err, io.EOF) {
+		return nil, errors.ValidationError(fmt.Sprintf("Invalid context config file '%s': %v", path, err),
+			"Expected 'sources' and 'notes' maps of ID to level")
+	}
+
+	config := &models.ContextConfig{}
+	if config.Sources, err = parseContextLevels("sources", "source:", file.Sources); err != nil {
+		return nil, err
+	}
+	if config.Notes, err = parseContextLevels("notes", "note:", file.Notes); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// parseContextLevels validates the IDs and levels of one section of a
+// context config, checking entries in ID order
+func parseContextLevels(section, idPrefix string, entries map[string]string) (map[string]models.ContextLevel, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(entries))
+	for id := range entries {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	levels := make(map[string]models.ContextLevel, len(entries))
+	for _, id := range ids {
+		if !strings.HasPrefix(id, idPrefix) || len(id) == len(idPrefix) {
+			return nil, errors.ValidationError(fmt.Sprintf("Invalid ID '%s' in context config %s", id, section),
+				fmt.Sprintf("IDs in %s look like %sabc123", section, idPrefix))
+		}
+		level, err := parseContextLevel(entries[id])
+		if err != nil {
+			return nil, errors.ValidationError(fmt.Sprintf("Invalid level for %s in context config %s: %v", id, section, err))
+		}
+		levels[id] = level
+	}
+	return levels, nil
+}
+
+// parseContextLevel validates a context level, ignoring case
+func parseContextLevel(value string) (models.ContextLevel, error) {
+	level := models.ContextLevel(strings.ToLower(strings.TrimSpace(value)))
+	if !slices.Contains(contextLevels, level) {
+		return "", fmt.Errorf("'%s' is not one of low, medium, high, critical", value)
+	}
+	return level, nil
+}
+
+// contextSourceIDs returns the source IDs of config in a stable order
+func contextSourceIDs(config *models.ContextConfig) []string {
+	if config == nil {
+		return nil
+	}
+	ids := make([]string, 0, len(config.Sources))
+	for id := range config.Sources {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
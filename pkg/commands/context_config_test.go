@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeContextConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ctx.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadContextConfig(t *testing.T) {
+	path := writeContextConfig(t, "sources:\n  source:a: high\n  source:b: Low\nnotes:\n  note:x: critical\n")
+
+	config, err := loadContextConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]models.ContextLevel{
+		"source:a": models.ContextLevelHigh,
+		"source:b": models.ContextLevelLow,
+	}, config.Sources)
+	assert.Equal(t, map[string]models.ContextLevel{"note:x": models.ContextLevelCritical}, config.Notes)
+	assert.Equal(t, []string{"source:a", "source:b"}, contextSourceIDs(config))
+}
+
+func TestLoadContextConfig_ReportsOffendingEntry(t *testing.T) {
+	tests := map[string]struct {
+		content string
+		entry   string
+	}{
+		"invalid level":    {"sources:\n  source:a: high\n  source:b: huge\n", "source:b"},
+		"source ID format": {"sources:\n  abc: high\n", "abc"},
+		"note ID format":   {"notes:\n  source:a: low\n", "source:a"},
+		"unknown section":  {"source:\n  source:a: high\n", "source"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := loadContextConfig(writeContextConfig(t, tt.content))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.entry)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/render"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
+	"github.com/samber/do/v2"
+	"github.com/urfave/cli/v2"
+)
+
+// ContextServices holds all the services needed for context commands
+type ContextServices struct {
+	ContextService  shared.ContextService
+	NotebookService shared.NotebookService
+	Logger          shared.Logger
+}
+
+// getContextServices retrieves all required services via dependency injection
+func getContextServices(ctx *cli.Context) (*ContextServices, error) {
+	injector, ok := ctx.App.Metadata["injector"].(do.Injector)
+	if !ok {
+		return nil, errors.UsageError("Dependency injector not found",
+			"This command requires proper DI setup")
+	}
+
+	return &ContextServices{
+		ContextService:  do.MustInvoke[shared.ContextService](injector),
+		NotebookService: do.MustInvoke[shared.NotebookService](injector),
+		Logger:          do.MustInvoke[shared.Logger](injector),
+	}, nil
+}
+
+// contextEstimate is the result of 'context estimate'
+type contextEstimate struct {
+	NotebookID  string                `json:"notebook_id"`
+	TotalTokens int                   `json:"total_tokens"`
+	Items       []contextEstimateItem `json:"items"`
+}
+
+// contextEstimateItem is the size of one source or note of a context
+type contextEstimateItem struct {
+	Type   string `json:"type"`
+	ID     string `json:"id"`
+	Title  string `json:"title,omitempty"`
+	Tokens int    `json:"tokens"`
+}
+
+// handleContextEstimate handles the context estimate command
+func handleContextEstimate(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.UsageError("Expected exactly one notebook",
+			"Usage: onb context estimate <notebook> [--source ID[:level]...]")
+	}
+
+	services, err := getContextServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	notebookID, err := resolveNotebookID(ctx.Context, services.NotebookService, ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	config, err := contextConfigFromFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	response, err := services.ContextService.GetContext(ctx.Context, notebookID, config)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to assemble notebook context",
+			"Check the notebook, source and note IDs")
+	}
+
+	estimate := &contextEstimate{NotebookID: notebookID}
+	sourceItems := contextItems(response.Sources)
+	noteItems := contextItems(response.Notes)
+	for _, item := range sourceItems {
+		estimate.Items = append(estimate.Items, contextEstimateItem{Type: "source", ID: item.ID, Title: item.Title, Tokens: item.Tokens})
+	}
+	for _, item := range noteItems {
+		estimate.Items = append(estimate.Items, contextEstimateItem{Type: "note", ID: item.ID, Title: item.Title, Tokens: item.Tokens})
+	}
+	estimate.TotalTokens = contextTotalTokens(response, append(sourceItems, noteItems...))
+
+	services.Logger.Info("Estimated notebook context", "notebook_id", notebookID,
+		"items", len(estimate.Items), "tokens", estimate.TotalTokens)
+
+	maxTokens := ctx.Int("max-tokens")
+	if handled, err := renderObject(ctx, estimate); handled {
+		if err == nil && maxTokens > 0 && estimate.TotalTokens > maxTokens {
+			fmt.Fprintln(os.Stderr, style.Warn(fmt.Sprintf("Context of ~%d tokens exceeds --max-tokens %d", estimate.TotalTokens, maxTokens)))
+		}
+		return err
+	}
+
+	if len(estimate.Items) > 0 {
+		table := render.NewTable("TYPE", "ID", "TITLE", "TOKENS")
+		for _, item := range estimate.Items {
+			table.AddRow(item.Type, item.ID, item.Title, strconv.Itoa(item.Tokens))
+		}
+		if err := renderList(ctx, estimate.Items, table); err != nil {
+			return err
+		}
+		utils.Status("")
+	}
+
+	utils.Status(style.Icon("📏", fmt.Sprintf("Context of notebook %s: %d sources, %d notes", notebookID, len(sourceItems), len(noteItems))))
+	printTokenBudget("Estimated context", estimate.TotalTokens, maxTokens)
+	return nil
+}
+
+// contextConfigFromFlags builds the context config of --context-config-file,
+// --source and --note. It returns nil when none is set, so the server uses
+// its default context.
+func contextConfigFromFlags(ctx *cli.Context) (*models.ContextConfig, error) {
+	config := &models.ContextConfig{}
+	if path := ctx.String("context-config-file"); path != "" {
+		var err error
+		if config, err = loadContextConfig(path); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	if config.Sources, err = addContextItemFlags(config.Sources, "source", "source:", ctx.StringSlice("source")); err != nil {
+		return nil, err
+	}
+	if config.Notes, err = addContextItemFlags(config.Notes, "note", "note:", ctx.StringSlice("note")); err != nil {
+		return nil, err
+	}
+
+	if len(config.Sources) == 0 && len(config.Notes) == 0 {
+		return nil, nil
+	}
+	return config, nil
+}
+
+// addContextItemFlags adds the ID[:level] values of a --source or --note flag
+// to levels
+func addContextItemFlags(levels map[string]models.ContextLevel, flag, idPrefix string, values []string) (map[string]models.ContextLevel, error) {
+	for _, value := range values {
+		id, level, err := parseContextItem(value, idPrefix)
+		if err != nil {
+			return nil, errors.ValidationError(fmt.Sprintf("Invalid --%s '%s': %v", flag, value, err),
+				fmt.Sprintf("Use ID[:level], e.g. %sabc123:high", idPrefix))
+		}
+		if levels == nil {
+			levels = map[string]models.ContextLevel{}
+		}
+		levels[id] = level
+	}
+	return levels, nil
+}
+
+// parseContextItem splits an ID[:level] value. IDs may be given without
+// idPrefix; the level defaults to high.
+func parseContextItem(value, idPrefix string) (string, models.ContextLevel, error) {
+	id, level := strings.TrimSpace(value), models.ContextLevelHigh
+	if i := strings.LastIndex(id, ":"); i >= 0 && id[:i+1] != idPrefix {
+		parsed, err := parseContextLevel(id[i+1:])
+		if err != nil {
+			return "", "", err
+		}
+		id, level = id[:i], parsed
+	}
+	if id == "" || id == idPrefix {
+		return "", "", fmt.Errorf("missing ID")
+	}
+	if !strings.HasPrefix(id, idPrefix) {
+		id = idPrefix + id
+	}
+	return id, level, nil
+}
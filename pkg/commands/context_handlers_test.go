@@ -0,0 +1,36 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseContextItem(t *testing.T) {
+	tests := map[string]struct {
+		value string
+		id    string
+		level models.ContextLevel
+	}{
+		"ID with level":          {"source:abc:low", "source:abc", models.ContextLevelLow},
+		"ID defaults to high":    {"source:abc", "source:abc", models.ContextLevelHigh},
+		"bare ID with level":     {"abc:Critical", "source:abc", models.ContextLevelCritical},
+		"bare ID without level":  {"abc", "source:abc", models.ContextLevelHigh},
+		"surrounding whitespace": {" source:abc:medium ", "source:abc", models.ContextLevelMedium},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			id, level, err := parseContextItem(tt.value, "source:")
+			require.NoError(t, err)
+			assert.Equal(t, tt.id, id)
+			assert.Equal(t, tt.level, level)
+		})
+	}
+
+	for _, value := range []string{"source:abc:huge", "source:", ":high", ""} {
+		_, _, err := parseContextItem(value, "source:")
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,28 @@
+package commands
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// DiagnoseCommand returns the diagnose command
+func DiagnoseCommand(info BuildInfo) *cli.Command {
+	return &cli.Command{
+		Name:  "diagnose",
+		Usage: "Diagnose connectivity and compatibility with the API server",
+		Description: "Run DNS, TCP and HTTP checks against the configured API URL and\n" +
+			"compare the CLI version with the server version.\n\n" +
+			"Examples:\n" +
+			"  onb diagnose                 # Human readable report\n" +
+			"  onb --output json diagnose   # Machine readable report\n" +
+			"  onb diagnose --reset-circuit # Retry a server the circuit breaker marked as down",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "reset-circuit",
+				Usage: "Close the circuit breaker so requests reach the server again immediately",
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			return handleDiagnose(ctx, info)
+		},
+	}
+}
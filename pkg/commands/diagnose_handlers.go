@@ -0,0 +1,161 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
+	"github.com/urfave/cli/v2"
+)
+
+// connectivityDiagnoser is implemented by HTTP clients that can run network
+// diagnostics against the configured API URL
+type connectivityDiagnoser interface {
+	DiagnoseConnectivity(ctx context.Context) map[string]interface{}
+}
+
+// diagnosticTests maps diagnostic result keys to display labels, in display order
+var diagnosticTests = []struct{ key, label string }{
+	{"dns_test", "DNS"},
+	{"tcp_test", "TCP"},
+	{"http_test", "HTTP"},
+}
+
+// handleDiagnose runs connectivity diagnostics and a version check
+func handleDiagnose(ctx *cli.Context, info BuildInfo) error {
+	services, err := getSystemServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	apiURL := services.Config.GetAPIURL()
+	services.Logger.Info("Running diagnostics", "api_url", apiURL)
+
+	if ctx.Bool("reset-circuit") {
+		if err := services.CircuitBreaker.Reset(); err != nil {
+			return errors.ConfigError("Failed to reset circuit breaker", err.Error())
+		}
+		utils.Status(style.OK("Circuit breaker reset"))
+	}
+
+	var connectivity map[string]interface{}
+	if diagnoser, ok := services.HTTPClient.(connectivityDiagnoser); ok {
+		connectivity = diagnoser.DiagnoseConnectivity(ctx.Context)
+	}
+	check := checkServerVersion(ctx.Context, services, info.Version)
+	authStatus := fetchAuthStatus(ctx.Context, services)
+	circuit := services.CircuitBreaker.Status()
+
+	if ctx.String("output") == "json" {
+		report := map[string]interface{}{
+			"api_url":      apiURL,
+			"connectivity": connectivity,
+			"version":      check,
+			"auth":         authStatus,
+			"circuit":      circuit,
+		}
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return errors.ValidationError("Failed to format diagnostics as JSON",
+				fmt.Sprintf("JSON marshaling error: %v", err))
+		}
+		fmt.Println(string(data))
+		return diagnoseResult(connectivity)
+	}
+
+	fmt.Println(style.Icon("🩺", "OpenNotebook diagnostics"))
+	fmt.Printf("  API URL:    %s\n\n", apiURL)
+
+	fmt.Println("Connectivity:")
+	if connectivity == nil {
+		fmt.Println("  Not available for this HTTP client")
+	}
+	for _, test := range diagnosticTests {
+		result, ok := connectivity[test.key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		fmt.Printf("  %-5s %s\n", test.label+":", formatDiagnostic(result))
+	}
+
+	fmt.Println("\nVersion:")
+	fmt.Printf("  CLI:        %s\n", check.CLIVersion)
+	printVersionCheck(check)
+
+	fmt.Println("\nAuthentication:")
+	fmt.Printf("  Server:     %s\n", formatAuthStatus(authStatus))
+
+	fmt.Println("\nCircuit breaker:")
+	fmt.Printf("  State:      %s\n", formatCircuitStatus(circuit))
+
+	return diagnoseResult(connectivity)
+}
+
+// formatCircuitStatus renders the circuit breaker state
+func formatCircuitStatus(status models.CircuitStatus) string {
+	if status.Threshold <= 0 {
+		return "disabled"
+	}
+	switch status.State {
+	case models.CircuitOpen:
+		return style.Error(fmt.Sprintf("open until %s after %d failures (reset with --reset-circuit)",
+			status.OpenUntil.Format("15:04:05"), status.Failures))
+	case models.CircuitHalfOpen:
+		return style.Warn(fmt.Sprintf("half-open, the next request tests the server (%d failures)", status.Failures))
+	}
+	return style.OK(fmt.Sprintf("closed (%d/%d failures)", status.Failures, status.Threshold))
+}
+
+// formatDiagnostic renders a single diagnostic test result
+func formatDiagnostic(result map[string]interface{}) string {
+	var details []string
+	if status, ok := result["status_code"].(int); ok {
+		details = append(details, fmt.Sprintf("status %d", status))
+	}
+	if ips, ok := result["ips"].([]string); ok && len(ips) > 0 {
+		sorted := append([]string(nil), ips...)
+		sort.Strings(sorted)
+		details = append(details, strings.Join(sorted, ", "))
+	}
+	if duration, ok := result["duration"].(time.Duration); ok {
+		details = append(details, duration.Round(time.Millisecond).String())
+	}
+
+	suffix := ""
+	if len(details) > 0 {
+		suffix = " (" + strings.Join(details, ", ") + ")"
+	}
+
+	if success, _ := result["success"].(bool); success {
+		return style.OK("ok" + suffix)
+	}
+	message, _ := result["error"].(string)
+	return style.Error("failed" + suffix + ": " + message)
+}
+
+// diagnoseResult returns a network error when any connectivity test failed
+func diagnoseResult(connectivity map[string]interface{}) error {
+	var failed []string
+	for _, test := range diagnosticTests {
+		result, ok := connectivity[test.key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if success, _ := result["success"].(bool); !success {
+			failed = append(failed, test.label)
+		}
+	}
+
+	if len(failed) > 0 {
+		return errors.NetworkError(fmt.Sprintf("Connectivity checks failed: %s", strings.Join(failed, ", ")),
+			"Check that the API server is running and --api-url is correct")
+	}
+	return nil
+}
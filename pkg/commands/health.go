@@ -0,0 +1,21 @@
+package commands
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// HealthCommand returns the health command
+func HealthCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "health",
+		Usage: "Check whether the API server is up",
+		Description: "Call the server health endpoint and report status, latency and\n" +
+			"authentication state. Exits non-zero when the server is unhealthy,\n" +
+			"so it can be used in readiness probes.\n\n" +
+			"Examples:\n" +
+			"  onb health                 # Human readable report\n" +
+			"  onb --output json health   # Machine readable report\n" +
+			"  onb -q health && echo up   # Exit code only",
+		Action: handleHealth,
+	}
+}
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
+	"github.com/urfave/cli/v2"
+)
+
+// healthReport is the result of a health check
+type healthReport struct {
+	APIURL    string             `json:"api_url"`
+	Healthy   bool               `json:"healthy"`
+	Status    string             `json:"status"`
+	LatencyMS int64              `json:"latency_ms"`
+	Auth      *models.AuthStatus `json:"auth,omitempty"`
+	Error     string             `json:"error,omitempty"`
+}
+
+// handleHealth checks the server health endpoint and its auth configuration
+func handleHealth(ctx *cli.Context) error {
+	services, err := getSystemServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	report := &healthReport{APIURL: services.Config.GetAPIURL(), Status: "down"}
+
+	// Measure a single attempt: retries would hide an outage and inflate the latency
+	retryConfig := services.HTTPClient.GetRetryConfig()
+	retryConfig.MaxRetries = 0
+	services.HTTPClient.SetRetryConfig(retryConfig)
+
+	start := time.Now()
+	health, err := services.SystemRepository.Health(ctx.Context)
+	report.LatencyMS = time.Since(start).Milliseconds()
+
+	if err != nil {
+		report.Error = err.Error()
+	} else {
+		report.Status = health.Status
+		report.Healthy = health.IsHealthy()
+		if report.Status == "" {
+			report.Status = "ok"
+		}
+	}
+
+	if report.Healthy {
+		report.Auth = fetchAuthStatus(ctx.Context, services)
+	}
+
+	if ctx.String("output") == "json" {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return errors.ValidationError("Failed to format health report as JSON",
+				fmt.Sprintf("JSON marshaling error: %v", err))
+		}
+		fmt.Println(string(data))
+		return healthResult(report)
+	}
+
+	if report.Healthy {
+		utils.Status(style.OK(fmt.Sprintf("API server is up (%s)", report.Status)))
+	} else {
+		utils.Status(style.Error("API server is down"))
+	}
+	utils.Statusf("  API URL:    %s\n", report.APIURL)
+	utils.Statusf("  Latency:    %dms\n", report.LatencyMS)
+	if report.Error != "" {
+		utils.Statusf("  Error:      %s\n", report.Error)
+	}
+	if report.Healthy {
+		utils.Statusf("  Auth:       %s\n", formatAuthStatus(report.Auth))
+	}
+
+	return healthResult(report)
+}
+
+// fetchAuthStatus returns the auth status, or nil when the server does not report it
+func fetchAuthStatus(ctx context.Context, services *SystemServices) *models.AuthStatus {
+	status, err := services.Auth.Status(ctx)
+	if err != nil {
+		services.Logger.Debug("Failed to get auth status", "error", err)
+		return nil
+	}
+	return status
+}
+
+// formatAuthStatus describes the server auth mode and whether the CLI holds a valid token
+func formatAuthStatus(status *models.AuthStatus) string {
+	switch {
+	case status == nil:
+		return "unknown"
+	case !status.AuthEnabled:
+		return "disabled (no password needed)"
+	case status.TokenValid:
+		return "enabled (token valid)"
+	default:
+		return "enabled (no valid token, set --password)"
+	}
+}
+
+// healthResult turns an unhealthy report into a non-zero exit
+func healthResult(report *healthReport) error {
+	if report.Healthy {
+		return nil
+	}
+	return errors.NetworkError("API server is unhealthy",
+		fmt.Sprintf("Health check against %s failed", report.APIURL),
+		"Run 'onb diagnose' for connectivity details")
+}
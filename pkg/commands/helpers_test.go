@@ -0,0 +1,21 @@
+package commands
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+// newTestContext parses args with flags, as a command with those flags would
+func newTestContext(t *testing.T, flags []cli.Flag, args ...string) *cli.Context {
+	t.Helper()
+
+	flagSet := flag.NewFlagSet("onb-test", flag.ContinueOnError)
+	for _, f := range flags {
+		require.NoError(t, f.Apply(flagSet))
+	}
+	require.NoError(t, flagSet.Parse(args))
+	return cli.NewContext(cli.NewApp(), flagSet, nil)
+}
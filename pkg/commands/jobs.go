@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -18,8 +20,10 @@ func JobsCommand() *cli.Command {
 			"Examples:\n" +
 			"  onb jobs list                           # List all background jobs\n" +
 			"  onb jobs status <job-id>                # Check job status\n" +
+			"  onb jobs status <job-id> --watch        # Follow a job until it finishes\n" +
 			"  onb jobs cancel <job-id>                # Cancel a running job\n" +
-			"  onb jobs list --status running          # Show only running jobs",
+			"  onb jobs list --status running          # Show only running jobs\n" +
+			"  onb jobs list --watch --until-done      # Follow all jobs until they finish",
 		Subcommands: []*cli.Command{
 			jobsListCommand(),
 			jobsStatusCommand(),
@@ -51,6 +55,26 @@ func jobsListCommand() *cli.Command {
 				Usage:   "Number of jobs to skip",
 				Value:   0,
 			},
+			fieldsFlag(),
+			noHeaderFlag(),
+			countFlag(),
+			&cli.BoolFlag{
+				Name:    "watch",
+				Aliases: []string{"w"},
+				Usage:   "Keep refreshing and report job status changes (Ctrl+C to stop)",
+				Value:   false,
+			},
+			&cli.DurationFlag{
+				Name:    "interval",
+				Aliases: []string{"i"},
+				Usage:   "Polling interval when watching",
+				Value:   2 * time.Second,
+			},
+			&cli.BoolFlag{
+				Name:  "until-done",
+				Usage: "With --watch, exit once every listed job has finished",
+				Value: false,
+			},
 		},
 		Action: handleJobsList,
 	}
@@ -66,9 +90,16 @@ func jobsStatusCommand() *cli.Command {
 			&cli.BoolFlag{
 				Name:    "watch",
 				Aliases: []string{"w"},
-				Usage:   "Watch job status updates continuously",
+				Usage:   "Watch job status updates until the job finishes",
 				Value:   false,
 			},
+			&cli.DurationFlag{
+				Name:    "interval",
+				Aliases: []string{"i"},
+				Usage:   "Polling interval when watching",
+				Value:   2 * time.Second,
+			},
+			stallTimeoutFlag(),
 		},
 		Action: handleJobsStatus,
 	}
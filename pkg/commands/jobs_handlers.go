@@ -3,7 +3,6 @@ package commands
 import (
 	"fmt"
 	"os"
-	"text/tabwriter"
 	"time"
 
 	"github.com/denkhaus/open-notebook-cli/pkg/config"
@@ -11,6 +10,8 @@ import (
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
 	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/render"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
 	"github.com/samber/do/v2"
 	"github.com/urfave/cli/v2"
 )
@@ -41,10 +42,10 @@ func getJobsServices(ctx *cli.Context) (*JobsServices, error) {
 func validateJobArgs(ctx *cli.Context, requireJobID bool) (string, error) {
 	if requireJobID {
 		if ctx.NArg() < 1 {
-			return "", fmt.Errorf("❌ Error: Missing job ID")
+			return "", errors.UsageError("Missing job ID")
 		}
 		if ctx.NArg() > 1 {
-			return "", fmt.Errorf("❌ Error: Too many arguments. Expected only job ID")
+			return "", errors.UsageError("Too many arguments. Expected only job ID")
 		}
 	}
 
@@ -68,11 +69,23 @@ func getJobStatusIcon(status string) string {
 		return "✅"
 	case "failed":
 		return "❌"
+	case "cancelled":
+		return "🛑"
 	default:
 		return "❓"
 	}
 }
 
+// formatJobStatus returns the job status prefixed with its icon
+func formatJobStatus(status string) string {
+	return style.Icon(getJobStatusIcon(status), status)
+}
+
+// isJobTerminal reports whether a job status will no longer change
+func isJobTerminal(status string) bool {
+	return status == "completed" || status == "failed" || status == "cancelled"
+}
+
 // formatJobDuration formats duration between created and updated timestamps
 func formatJobDuration(created, updated string) string {
 	if created == "" || updated == "" {
@@ -123,14 +136,18 @@ func handleJobsList(ctx *cli.Context) error {
 
 	services.Logger.Info("Listing background jobs", "status_filter", statusFilter)
 
+	if ctx.Bool("watch") {
+		return watchJobs(ctx, services)
+	}
+
 	response, err := services.JobService.List(ctx.Context)
 	if err != nil {
-		return errors.APIError("Failed to list jobs",
+		return errors.WrapAPIError(err, "Failed to list jobs",
 			"Check API connection and permissions")
 	}
 
-	if len(response.Jobs) == 0 {
-		fmt.Println("No background jobs found.")
+	if len(response.Jobs) == 0 && !ctx.Bool("count") {
+		utils.Status("No background jobs found.")
 		return nil
 	}
 
@@ -141,6 +158,9 @@ func handleJobsList(ctx *cli.Context) error {
 			filteredJobs = append(filteredJobs, job)
 		}
 	}
+	if printCount(ctx, len(filteredJobs)) {
+		return nil
+	}
 
 	// Apply pagination
 	start := offset
@@ -154,14 +174,13 @@ func handleJobsList(ctx *cli.Context) error {
 
 	displayJobs := filteredJobs[start:end]
 
-	if len(displayJobs) == 0 {
-		fmt.Printf("No jobs found matching criteria.\n")
+	if len(displayJobs) == 0 && !isStructuredOutput(ctx) {
+		utils.Statusf("No jobs found matching criteria.\n")
 		return nil
 	}
 
 	// Display jobs in a table
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "ID\tSTATUS\tPROGRESS\tDURATION\tMESSAGE")
+	table := render.NewTable("ID", "STATUS", "PROGRESS", "DURATION", "MESSAGE")
 
 	for _, job := range displayJobs {
 		progress := "N/A"
@@ -176,21 +195,139 @@ func handleJobsList(ctx *cli.Context) error {
 			message = *job.Message
 		}
 
-		fmt.Fprintf(w, "%s\t%s %s\t%s\t%s\t%s\n",
+		table.AddRow(
 			job.ID,
-			getJobStatusIcon(job.Status),
-			job.Status,
+			formatJobStatus(job.Status),
 			progress,
 			duration,
 			message)
 	}
 
-	w.Flush()
+	if err := renderList(ctx, displayJobs, table); err != nil {
+		return err
+	}
 
-	fmt.Printf("\nShowing %d jobs (use --limit and --offset for pagination)\n", len(displayJobs))
+	if !isStructuredOutput(ctx) {
+		utils.Statusf("\nShowing %d jobs (use --limit and --offset for pagination)\n", len(displayJobs))
+	}
 	return nil
 }
 
+// jobProgressStep is the minimum progress change reported while watching
+const jobProgressStep = 0.1
+
+// watchJobs polls the job list until interrupted, or with --until-done until
+// every job has finished. On a terminal the table is redrawn on each poll;
+// otherwise only changed jobs are printed as timestamped lines.
+func watchJobs(ctx *cli.Context, services *JobsServices) error {
+	stopPager()
+	interval := ctx.Duration("interval")
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	statusFilter := ctx.String("status")
+	untilDone := ctx.Bool("until-done")
+	tty := utils.IsTerminal(os.Stdout)
+
+	previous := map[string]models.JobStatus{}
+	for {
+		response, err := services.JobService.List(ctx.Context)
+		if err != nil {
+			if ctx.Context.Err() != nil {
+				return nil
+			}
+			return errors.WrapAPIError(err, "Failed to list jobs",
+				"Check API connection and permissions")
+		}
+
+		jobs := []models.JobStatus{}
+		for _, job := range response.Jobs {
+			if statusFilter == "" || job.Status == statusFilter {
+				jobs = append(jobs, job)
+			}
+		}
+
+		now := time.Now()
+		if tty {
+			redrawJobsTable(now, interval, jobs)
+		}
+
+		current := make(map[string]models.JobStatus, len(jobs))
+		allDone := true
+		for _, job := range jobs {
+			current[job.ID] = job
+			if !isJobTerminal(job.Status) {
+				allDone = false
+			}
+			if tty {
+				continue
+			}
+			if change := describeJobChange(previous, job); change != "" {
+				fmt.Printf("%s  %s  %s\n", now.Format(time.RFC3339), job.ID, change)
+			}
+		}
+		previous = current
+
+		if untilDone && allDone {
+			utils.Statusf("All %d jobs finished\n", len(jobs))
+			return nil
+		}
+
+		select {
+		case <-ctx.Context.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// describeJobChange summarizes how a job differs from its previous snapshot,
+// or returns "" when nothing worth reporting changed
+func describeJobChange(previous map[string]models.JobStatus, job models.JobStatus) string {
+	before, seen := previous[job.ID]
+	if !seen {
+		return fmt.Sprintf("%s %s", formatJobStatus(job.Status), formatJobProgress(job.Progress))
+	}
+
+	if before.Status != job.Status {
+		return fmt.Sprintf("%s %s %s %s", before.Status, style.Symbol("→", "->"),
+			formatJobStatus(job.Status), formatJobProgress(job.Progress))
+	}
+
+	if job.Progress != nil && (before.Progress == nil || *job.Progress-*before.Progress >= jobProgressStep) {
+		return fmt.Sprintf("%s %s", formatJobStatus(job.Status), formatJobProgress(job.Progress))
+	}
+	return ""
+}
+
+// formatJobProgress formats a 0..1 progress value as a percentage
+func formatJobProgress(progress *float64) string {
+	if progress == nil {
+		return "N/A"
+	}
+	return fmt.Sprintf("%.0f%%", *progress*100)
+}
+
+// redrawJobsTable clears the terminal and prints the current job table
+func redrawJobsTable(now time.Time, interval time.Duration, jobs []models.JobStatus) {
+	fmt.Print("\033[H\033[2J")
+	fmt.Printf("Every %s: onb jobs list --watch    %s\n\n", interval, now.Format("15:04:05"))
+
+	table := render.NewTable("ID", "STATUS", "PROGRESS", "DURATION", "MESSAGE")
+	for _, job := range jobs {
+		table.AddRow(
+			job.ID,
+			formatJobStatus(job.Status),
+			formatJobProgress(job.Progress),
+			formatJobDuration(job.Created, utils.SafeDereferenceString(job.Updated)),
+			utils.SafeDereferenceString(job.Message))
+	}
+	if width, _, ok := utils.TerminalSize(os.Stdout); ok {
+		table.Fit(width)
+	}
+	table.Write(os.Stdout)
+}
+
 // handleJobsStatus handles detailed job status display
 func handleJobsStatus(ctx *cli.Context) error {
 	jobID, err := validateJobArgs(ctx, true)
@@ -204,19 +341,22 @@ func handleJobsStatus(ctx *cli.Context) error {
 	}
 
 	watch := ctx.Bool("watch")
+	if watch {
+		stopPager()
+	}
 
-	fmt.Printf("📊 Job Status: %s\n", jobID)
+	fmt.Printf(style.Icon("📊", "Job Status: %s\n"), jobID)
 	services.Logger.Info("Getting job status", "job_id", jobID)
 
 	job, err := services.JobService.GetStatus(ctx.Context, jobID)
 	if err != nil {
-		return errors.APIError("Failed to get job status",
+		return errors.WrapAPIError(err, "Failed to get job status",
 			"Check job ID and permissions")
 	}
 
 	// Display job details
 	fmt.Printf("  ID:       %s\n", job.ID)
-	fmt.Printf("  Status:   %s %s\n", getJobStatusIcon(job.Status), job.Status)
+	fmt.Printf("  Status:   %s\n", formatJobStatus(job.Status))
 
 	if job.Progress != nil {
 		fmt.Printf("  Progress: %.0f%%\n", *job.Progress*100)
@@ -234,32 +374,60 @@ func handleJobsStatus(ctx *cli.Context) error {
 		fmt.Printf("  Updated:  %s\n", utils.FormatTimestamp(*job.Updated))
 	}
 
-	if watch {
-		fmt.Println("   🔄 Watching for status updates... (Press Ctrl+C to stop)")
-		// Simple polling implementation for watching
-		for i := 0; i < 10; i++ { // Watch for 10 iterations
-			time.Sleep(2 * time.Second)
+	if watch && !isJobTerminal(job.Status) {
+		utils.Status("   " + style.Icon("🔄", "Watching for status updates... (Press Ctrl+C to stop)"))
+		interval := ctx.Duration("interval")
+		if interval <= 0 {
+			interval = 2 * time.Second
+		}
+		stall := newStallDetector(ctx.Duration("stall-timeout"))
+		stall.Observe(jobProgressKey(job.Status, job.Progress, job.Message))
+
+		for !isJobTerminal(job.Status) {
+			select {
+			case <-ctx.Context.Done():
+				utils.Status("   Watch stopped")
+				return nil
+			case <-time.After(interval):
+			}
 
 			// Get updated status
 			updatedJob, err := services.JobService.GetStatus(ctx.Context, jobID)
 			if err != nil {
-				fmt.Printf("   Error checking status: %v\n", err)
-				break
+				return errors.WrapAPIError(err, "Failed to get job status",
+					"Check job ID and permissions")
+			}
+
+			progress := "N/A"
+			if updatedJob.Progress != nil {
+				progress = fmt.Sprintf("%.0f%%", *updatedJob.Progress*100)
 			}
 
 			if updatedJob.Status != job.Status {
-				fmt.Printf("   Status changed: %s → %s\n", job.Status, updatedJob.Status)
-				job = updatedJob
-			} else {
-				fmt.Printf("   Checking status... (%d/10)\n", i+1)
+				utils.Statusf("   Status changed: %s %s %s\n", job.Status, style.Symbol("→", "->"), updatedJob.Status)
+			}
+			message := ""
+			if updatedJob.Message != nil {
+				message = *updatedJob.Message
 			}
+			utils.Statusf("   %s | Progress: %s %s\n", formatJobStatus(updatedJob.Status), progress, message)
+			job = updatedJob
 
-			if job.Status == "completed" || job.Status == "failed" {
-				fmt.Printf("   Job finished with status: %s\n", job.Status)
-				break
+			if stall.Observe(jobProgressKey(job.Status, job.Progress, job.Message)) {
+				return errors.APIError(fmt.Sprintf("Job '%s' made no progress for %s", jobID, stall.Timeout()),
+					fmt.Sprintf("The job may be hung; cancel it with 'onb jobs cancel %s'", jobID),
+					"Raise --stall-timeout if the job legitimately pauses between updates")
 			}
 		}
-		fmt.Println("   Watch completed")
+
+		utils.Statusf("   Job finished with status: %s\n", job.Status)
+	}
+
+	if watch && job.Status == "failed" {
+		if job.Message != nil {
+			return errors.APIError(fmt.Sprintf("Job '%s' failed", jobID), *job.Message)
+		}
+		return errors.APIError(fmt.Sprintf("Job '%s' failed", jobID))
 	}
 
 	return nil
@@ -277,30 +445,27 @@ func handleJobsCancel(ctx *cli.Context) error {
 		return err
 	}
 
-	force := ctx.Bool("force")
-
 	// Confirm cancellation unless force flag is used
-	if !force {
-		fmt.Printf("⚠️  Are you sure you want to cancel job '%s'? [y/N]: ", jobID)
-		var response string
-		fmt.Scanln(&response)
-		if response != "y" && response != "Y" {
-			fmt.Println("❌ Cancellation cancelled")
-			return nil
-		}
+	ok, err := confirmDestructive(ctx, style.Warn(fmt.Sprintf("Are you sure you want to cancel job '%s'?", jobID)))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Println(style.Error("Cancellation cancelled"))
+		return nil
 	}
 
-	fmt.Printf("🛑 Cancelling job: %s\n", jobID)
+	utils.Statusf(style.Icon("🛑", "Cancelling job: %s\n"), jobID)
 	services.Logger.Info("Cancelling job", "job_id", jobID)
 
 	err = services.JobService.Cancel(ctx.Context, jobID)
 	if err != nil {
-		return errors.APIError("Failed to cancel job",
+		return errors.WrapAPIError(err, "Failed to cancel job",
 			"Check job ID and permissions. Job may not be cancellable")
 	}
 
-	fmt.Printf("✅ Job '%s' cancellation requested!\n", jobID)
-	fmt.Println("   Note: The job may take a moment to stop gracefully")
+	utils.Statusf(style.OK("Job '%s' cancellation requested!\n"), jobID)
+	utils.Status("   Note: The job may take a moment to stop gracefully")
 
 	return nil
 }
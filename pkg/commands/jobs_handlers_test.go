@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/mocks"
+	"github.com/denkhaus/open-notebook-cli/pkg/services"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchJobs_NeverPages(t *testing.T) {
+	client := mocks.NewMockHTTPClient()
+	client.SetJSONResponse("GET", "/commands/jobs", `{"jobs":[{"id":"command:1","status":"completed"}]}`)
+
+	injector := do.New()
+	do.ProvideValue[shared.HTTPClient](injector, client)
+	do.ProvideValue[shared.Logger](injector, mocks.NewMockLogger(false))
+	do.Provide(injector, services.NewJobRepository)
+	svc := &JobsServices{
+		JobService: do.MustInvoke[shared.JobRepository](injector),
+		Logger:     do.MustInvoke[shared.Logger](injector),
+	}
+
+	var stopped int
+	defer func(original func()) { stopPager = original }(stopPager)
+	stopPager = func() {
+		// No frame may be drawn before paging stops
+		assert.Empty(t, client.Requests())
+		stopped++
+	}
+
+	ctx := newTestContext(t, jobsListCommand().Flags, "--watch", "--until-done")
+	require.NoError(t, watchJobs(ctx, svc))
+	assert.Equal(t, 1, stopped)
+}
@@ -1,6 +1,10 @@
 package commands
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/urfave/cli/v2"
 )
 
@@ -17,8 +21,10 @@ func ModelsCommand() *cli.Command {
 			"Examples:\n" +
 			"  onb models list                           # List all available models\n" +
 			"  onb models add --name gpt-4 --provider openai --type language # Add new model\n" +
-			"  onb models defaults                       # Show default model assignments\n" +
-			"  onb models defaults --set chat=gpt-4      # Set default chat model\n" +
+			"  onb models defaults show                  # Show default model assignments\n" +
+			"  onb models defaults set --chat gpt-4      # Set default chat model\n" +
+			"  onb models defaults validate              # Check defaults point to existing models\n" +
+			"  onb models defaults export -o defaults.json # Export defaults to mirror on another instance\n" +
 			"  onb models providers                      # Check provider availability\n" +
 			"  onb models delete model-id                # Remove a model",
 		Subcommands: []*cli.Command{
@@ -37,7 +43,7 @@ func modelsListCommand() *cli.Command {
 	return &cli.Command{
 		Name:  "list",
 		Usage: "List all available AI models",
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			&cli.StringFlag{
 				Name:    "type",
 				Aliases: []string{"t"},
@@ -60,7 +66,11 @@ func modelsListCommand() *cli.Command {
 				Usage:   "Number of models to skip",
 				Value:   0,
 			},
-		},
+			fieldsFlag(),
+			noHeaderFlag(),
+			countFlag(),
+			filterFlag(),
+		}, dateFilterFlags()...),
 		Action: handleModelsList,
 	}
 }
@@ -70,11 +80,16 @@ func modelsAddCommand() *cli.Command {
 	return &cli.Command{
 		Name:  "add",
 		Usage: "Add a new AI model",
+		Description: "Registers models of an available provider. Models already registered with\n" +
+			"the same name, provider and type are skipped.\n\n" +
+			"Examples:\n" +
+			"  onb models add --name gpt-4o --provider openai --type language\n" +
+			"  onb models add --name gpt-4o,gpt-4o-mini,o3-mini --provider openai --type language",
 		Flags: []cli.Flag{
-			&cli.StringFlag{
+			&cli.StringSliceFlag{
 				Name:     "name",
 				Aliases:  []string{"n"},
-				Usage:    "Model name (e.g., gpt-4, llama2)",
+				Usage:    "Model name (e.g., gpt-4, llama2); repeat or separate with commas to add several",
 				Required: true,
 			},
 			&cli.StringFlag{
@@ -130,6 +145,10 @@ func modelsDefaultsCommand() *cli.Command {
 		Subcommands: []*cli.Command{
 			modelsDefaultsShowCommand(),
 			modelsDefaultsSetCommand(),
+			modelsDefaultsClearCommand(),
+			modelsDefaultsValidateCommand(),
+			modelsDefaultsExportCommand(),
+			modelsDefaultsImportCommand(),
 		},
 	}
 }
@@ -182,6 +201,66 @@ func modelsDefaultsSetCommand() *cli.Command {
 	}
 }
 
+// modelsDefaultsClearCommand unsets default model assignments
+func modelsDefaultsClearCommand() *cli.Command {
+	flags := []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "all",
+			Usage: "Clear every default model assignment",
+		},
+	}
+	for _, slot := range defaultModelSlots(&models.DefaultModelsResponse{}) {
+		flags = append(flags, &cli.BoolFlag{
+			Name:  slot.Flag,
+			Usage: fmt.Sprintf("Clear the default %s model", strings.ToLower(slot.Label)),
+		})
+	}
+
+	return &cli.Command{
+		Name:   "clear",
+		Usage:  "Clear default model assignments",
+		Flags:  flags,
+		Action: handleModelsDefaultsClear,
+	}
+}
+
+// modelsDefaultsValidateCommand checks that default models still exist
+func modelsDefaultsValidateCommand() *cli.Command {
+	return &cli.Command{
+		Name:   "validate",
+		Usage:  "Check that every default model still exists (exits non-zero otherwise)",
+		Action: handleModelsDefaultsValidate,
+	}
+}
+
+// modelsDefaultsExportCommand writes default model assignments to a file
+func modelsDefaultsExportCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "export",
+		Usage: "Export default model assignments (by model name) to a JSON file",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "output",
+				Aliases:  []string{"o"},
+				Usage:    "Output file path (use '-' for stdout)",
+				Required: true,
+			},
+		},
+		Action: handleModelsDefaultsExport,
+	}
+}
+
+// modelsDefaultsImportCommand applies default model assignments from a file
+func modelsDefaultsImportCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "import",
+		Usage:     "Import default model assignments, resolving model names to IDs on this instance",
+		ArgsUsage: "<defaults.json>",
+		Args:      true,
+		Action:    handleModelsDefaultsImport,
+	}
+}
+
 // modelsProvidersCommand checks provider availability
 func modelsProvidersCommand() *cli.Command {
 	return &cli.Command{
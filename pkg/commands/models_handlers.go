@@ -1,15 +1,19 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
-	"text/tabwriter"
+	"slices"
+	"strings"
 
 	"github.com/denkhaus/open-notebook-cli/pkg/config"
 	"github.com/denkhaus/open-notebook-cli/pkg/errors"
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
 	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/render"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
 	"github.com/samber/do/v2"
 	"github.com/urfave/cli/v2"
 )
@@ -40,10 +44,10 @@ func getModelsServices(ctx *cli.Context) (*ModelsServices, error) {
 func validateModelArgs(ctx *cli.Context, requireModelID bool) (string, error) {
 	if requireModelID {
 		if ctx.NArg() < 1 {
-			return "", fmt.Errorf("❌ Error: Missing model ID")
+			return "", errors.UsageError("Missing model ID")
 		}
 		if ctx.NArg() > 1 {
-			return "", fmt.Errorf("❌ Error: Too many arguments. Expected only model ID")
+			return "", errors.UsageError("Too many arguments. Expected only model ID")
 		}
 	}
 
@@ -75,7 +79,7 @@ func validateModelType(modelType string) error {
 
 // printModelSuccess prints standardized success messages for model operations
 func printModelSuccess(operation string, model *models.Model) {
-	fmt.Printf("✅ Model %s successfully!\n", operation)
+	utils.Statusf(style.OK("Model %s successfully!\n"), operation)
 	fmt.Printf("  ID:       %s\n", model.ID)
 	fmt.Printf("  Name:     %s\n", model.Name)
 	fmt.Printf("  Provider: %s\n", model.Provider)
@@ -105,12 +109,12 @@ func handleModelsList(ctx *cli.Context) error {
 
 	modelList, err := services.ModelService.List(ctx.Context)
 	if err != nil {
-		return errors.APIError("Failed to list models",
+		return errors.WrapAPIError(err, "Failed to list models",
 			"Check API connection and permissions")
 	}
 
-	if len(modelList) == 0 {
-		fmt.Println("No models found.")
+	if len(modelList) == 0 && !isStructuredOutput(ctx) && !ctx.Bool("count") {
+		utils.Status("No models found.")
 		return nil
 	}
 
@@ -128,6 +132,18 @@ func handleModelsList(ctx *cli.Context) error {
 		filteredModels = append(filteredModels, model)
 	}
 
+	filteredModels, err = filterItems(ctx, filteredModels)
+	if err != nil {
+		return err
+	}
+	filteredModels, err = filterByDate(ctx, filteredModels)
+	if err != nil {
+		return err
+	}
+	if printCount(ctx, len(filteredModels)) {
+		return nil
+	}
+
 	// Apply limit and offset
 	limit := 50
 	offset := 0
@@ -151,20 +167,27 @@ func handleModelsList(ctx *cli.Context) error {
 	displayModels := filteredModels[start:end]
 
 	// Display models in a table
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "ID\tNAME\tPROVIDER\tTYPE")
-
+	wide := isWideOutput(ctx)
+	headers := []string{"ID", "NAME", "PROVIDER", "TYPE"}
+	if wide {
+		headers = append(headers, "CREATED", "UPDATED")
+	}
+	table := render.NewTable(headers...)
 	for _, model := range displayModels {
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
-			model.ID,
-			utils.TruncateString(model.Name, 25),
-			model.Provider,
-			string(model.Type))
+		row := []string{model.ID, model.Name, model.Provider, string(model.Type)}
+		if wide {
+			row = append(row, utils.FormatTimestamp(model.Created), utils.FormatTimestamp(model.Updated))
+		}
+		table.AddRow(row...)
 	}
 
-	w.Flush()
+	if err := renderList(ctx, displayModels, table); err != nil {
+		return err
+	}
 
-	fmt.Printf("\nShowing %d models (use --limit and --offset for pagination)\n", len(displayModels))
+	if !isStructuredOutput(ctx) {
+		utils.Statusf("\nShowing %d models (use --limit and --offset for pagination)\n", len(displayModels))
+	}
 	return nil
 }
 
@@ -185,7 +208,7 @@ func handleModelsShow(ctx *cli.Context) error {
 	// First get all models and find the one with matching ID
 	modelList, err := services.ModelService.List(ctx.Context)
 	if err != nil {
-		return errors.APIError("Failed to get model details",
+		return errors.WrapAPIError(err, "Failed to get model details",
 			"Check API connection and permissions")
 	}
 
@@ -202,6 +225,10 @@ func handleModelsShow(ctx *cli.Context) error {
 			fmt.Sprintf("Model with ID '%s' does not exist", modelID))
 	}
 
+	if handled, err := renderObject(ctx, targetModel); handled {
+		return err
+	}
+
 	// Display model details
 	fmt.Printf("Model Details:\n")
 	fmt.Printf("  ID:           %s\n", targetModel.ID)
@@ -221,7 +248,7 @@ func handleModelsAdd(ctx *cli.Context) error {
 		return err
 	}
 
-	name := ctx.String("name")
+	names := ctx.StringSlice("name")
 	provider := ctx.String("provider")
 	modelType := ctx.String("type")
 
@@ -229,22 +256,89 @@ func handleModelsAdd(ctx *cli.Context) error {
 	if err := validateModelType(modelType); err != nil {
 		return err
 	}
+	if err := validateModelProvider(ctx, services, provider, modelType); err != nil {
+		return err
+	}
+
+	registered, err := services.ModelService.List(ctx.Context)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to list existing models",
+			"Check API connection and permissions")
+	}
+	existing := make(map[string]string, len(registered))
+	for _, model := range registered {
+		if model.Provider == provider && string(model.Type) == modelType {
+			existing[model.Name] = model.ID
+		}
+	}
+
+	created, skipped, failed := 0, 0, 0
+	for _, name := range names {
+		if id, ok := existing[name]; ok {
+			skipped++
+			utils.Statusf(style.Warn("Skipped %s: already registered as %s\n"), name, id)
+			continue
+		}
 
-	services.Logger.Info("Creating model", "name", name, "provider", provider, "type", modelType)
+		services.Logger.Info("Creating model", "name", name, "provider", provider, "type", modelType)
 
-	model := &models.ModelCreate{
-		Name:     name,
-		Provider: provider,
-		Type:     models.ModelType(modelType),
+		model := &models.ModelCreate{
+			Name:     name,
+			Provider: provider,
+			Type:     models.ModelType(modelType),
+		}
+
+		createdModel, err := services.ModelService.Create(ctx.Context, model)
+		if err != nil {
+			if len(names) == 1 {
+				return errors.WrapAPIError(err, "Failed to create model",
+					"Check input parameters and API permissions")
+			}
+			failed++
+			fmt.Fprintln(os.Stderr, style.Error(fmt.Sprintf("Failed to create %s: %v", name, err)))
+			continue
+		}
+
+		created++
+		existing[name] = createdModel.ID
+		if len(names) == 1 {
+			printModelSuccess("created", createdModel)
+		} else {
+			utils.Statusf(style.OK("Created %s: %s\n"), name, createdModel.ID)
+		}
 	}
 
-	createdModel, err := services.ModelService.Create(ctx.Context, model)
+	if len(names) > 1 {
+		utils.Statusf("\nCreated %d, skipped %d, failed %d of %d models\n", created, skipped, failed, len(names))
+	}
+	if failed > 0 {
+		return errors.APIError(fmt.Sprintf("Failed to create %d of %d models", failed, len(names)),
+			"Check the model names and API permissions, then rerun; registered models are skipped")
+	}
+	return nil
+}
+
+// validateModelProvider checks that provider is available on the server and
+// supports modelType
+func validateModelProvider(ctx *cli.Context, services *ModelsServices, provider, modelType string) error {
+	providers, err := services.ModelService.GetProviders(ctx.Context)
 	if err != nil {
-		return errors.APIError("Failed to create model",
-			"Check input parameters and API permissions")
+		return errors.WrapAPIError(err, "Failed to check provider availability",
+			"Check API connection and permissions")
+	}
+
+	if !slices.Contains(providers.Available, provider) {
+		suggestions := []string{"Available providers: " + strings.Join(providers.Available, ", ")}
+		if slices.Contains(providers.Unavailable, provider) {
+			suggestions = append([]string{"Configure the provider's API key on the server"}, suggestions...)
+		}
+		return errors.ValidationError(fmt.Sprintf("Provider '%s' is not available", provider), suggestions...)
 	}
 
-	printModelSuccess("created", createdModel)
+	if types, ok := providers.SupportedTypes[provider]; ok && !slices.Contains(types, modelType) {
+		return errors.ValidationError(fmt.Sprintf("Provider '%s' does not support %s models", provider, modelType),
+			"Supported types: "+strings.Join(types, ", "))
+	}
 	return nil
 }
 
@@ -261,29 +355,48 @@ func handleModelsDelete(ctx *cli.Context) error {
 	}
 
 	// Confirm deletion unless force flag is used
-	if !ctx.Bool("force") {
-		fmt.Printf("⚠️  Are you sure you want to delete model '%s'? [y/N]: ", modelID)
-		var response string
-		fmt.Scanln(&response)
-		response = fmt.Sprintf("%s", response) // Format the response
-		if response != "y" && response != "yes" {
-			fmt.Println("❌ Deletion cancelled")
-			return nil
-		}
+	ok, err := confirmDestructive(ctx, style.Warn(fmt.Sprintf("Are you sure you want to delete model '%s'?", modelID)))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Println(style.Error("Deletion cancelled"))
+		return nil
 	}
 
 	services.Logger.Info("Deleting model", "model_id", modelID)
 
 	err = services.ModelService.Delete(ctx.Context, modelID)
 	if err != nil {
-		return errors.APIError("Failed to delete model",
+		return errors.WrapAPIError(err, "Failed to delete model",
 			"Check model ID and permissions")
 	}
 
-	fmt.Printf("✅ Model '%s' deleted successfully!\n", modelID)
+	utils.Statusf(style.OK("Model '%s' deleted successfully!\n"), modelID)
 	return nil
 }
 
+// defaultModelSlot describes one default model assignment; Field points into
+// the DefaultModelsResponse the slot was created from
+type defaultModelSlot struct {
+	Flag  string
+	Label string
+	Field **string
+}
+
+// defaultModelSlots lists the default model assignments of defaults in display order
+func defaultModelSlots(defaults *models.DefaultModelsResponse) []defaultModelSlot {
+	return []defaultModelSlot{
+		{"chat", "Chat", &defaults.DefaultChatModel},
+		{"transformation", "Transformation", &defaults.DefaultTransformationModel},
+		{"large-context", "Large Context", &defaults.LargeContextModel},
+		{"tts", "Text-to-Speech", &defaults.DefaultTextToSpeechModel},
+		{"stt", "Speech-to-Text", &defaults.DefaultSpeechToTextModel},
+		{"embedding", "Embedding", &defaults.DefaultEmbeddingModel},
+		{"tools", "Tools", &defaults.DefaultToolsModel},
+	}
+}
+
 // handleModelsDefaultsShow handles showing current default models
 func handleModelsDefaultsShow(ctx *cli.Context) error {
 	services, err := getModelsServices(ctx)
@@ -295,30 +408,17 @@ func handleModelsDefaultsShow(ctx *cli.Context) error {
 
 	defaults, err := services.ModelService.GetDefaults(ctx.Context)
 	if err != nil {
-		return errors.APIError("Failed to get default models",
+		return errors.WrapAPIError(err, "Failed to get default models",
 			"Check API connection and permissions")
 	}
 
-	fmt.Println("🎯 Current default models:")
-
-	defaultTypes := []struct {
-		field *string
-		label string
-	}{
-		{defaults.DefaultChatModel, "Chat"},
-		{defaults.DefaultTransformationModel, "Transformation"},
-		{defaults.LargeContextModel, "Large Context"},
-		{defaults.DefaultTextToSpeechModel, "Text-to-Speech"},
-		{defaults.DefaultSpeechToTextModel, "Speech-to-Text"},
-		{defaults.DefaultEmbeddingModel, "Embedding"},
-		{defaults.DefaultToolsModel, "Tools"},
-	}
+	fmt.Println(style.Icon("🎯", "Current default models:"))
 
-	for _, dt := range defaultTypes {
-		if dt.field != nil && *dt.field != "" {
-			fmt.Printf("  %s: %s\n", dt.label, *dt.field)
+	for _, slot := range defaultModelSlots(defaults) {
+		if id := utils.SafeDereferenceString(*slot.Field); id != "" {
+			fmt.Printf("  %s: %s\n", slot.Label, id)
 		} else {
-			fmt.Printf("  %s: (not set)\n", dt.label)
+			fmt.Printf("  %s: (not set)\n", slot.Label)
 		}
 	}
 
@@ -397,11 +497,11 @@ func handleModelsDefaultsSet(ctx *cli.Context) error {
 
 	err = services.ModelService.SetDefaults(ctx.Context, defaults)
 	if err != nil {
-		return errors.APIError("Failed to set default models",
+		return errors.WrapAPIError(err, "Failed to set default models",
 			"Check model IDs and API permissions")
 	}
 
-	fmt.Println("✅ Default models updated successfully!")
+	utils.Status(style.OK("Default models updated successfully!"))
 	for key, value := range assignments {
 		fmt.Printf("  %s: %s\n", key, value)
 	}
@@ -420,17 +520,17 @@ func handleModelsProviders(ctx *cli.Context) error {
 
 	providers, err := services.ModelService.GetProviders(ctx.Context)
 	if err != nil {
-		return errors.APIError("Failed to get provider status",
+		return errors.WrapAPIError(err, "Failed to get provider status",
 			"Check API connection and permissions")
 	}
 
-	fmt.Println("🔌 Provider availability:")
+	fmt.Println(style.Icon("🔌", "Provider availability:"))
 
 	// Display available providers
 	if len(providers.Available) > 0 {
 		fmt.Println("  Available:")
 		for _, provider := range providers.Available {
-			fmt.Printf("    ✅ %s\n", provider)
+			fmt.Printf("    "+style.OK("%s\n"), provider)
 		}
 	}
 
@@ -438,7 +538,7 @@ func handleModelsProviders(ctx *cli.Context) error {
 	if len(providers.Unavailable) > 0 {
 		fmt.Println("  Unavailable:")
 		for _, provider := range providers.Unavailable {
-			fmt.Printf("    ❌ %s\n", provider)
+			fmt.Printf("    "+style.Error("%s\n"), provider)
 		}
 	}
 
@@ -452,3 +552,284 @@ func handleModelsProviders(ctx *cli.Context) error {
 
 	return nil
 }
+
+// handleModelsDefaultsClear unsets the selected default model assignments
+func handleModelsDefaultsClear(ctx *cli.Context) error {
+	services, err := getModelsServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaults := &models.DefaultModelsResponse{}
+	var cleared []string
+	for _, slot := range defaultModelSlots(defaults) {
+		if ctx.Bool("all") || ctx.Bool(slot.Flag) {
+			empty := ""
+			*slot.Field = &empty
+			cleared = append(cleared, slot.Label)
+		}
+	}
+
+	if len(cleared) == 0 {
+		return errors.UsageError("No defaults specified",
+			"Use --all or at least one of the --chat, --embedding, --transformation, --large-context, --tts, --stt, --tools flags")
+	}
+
+	services.Logger.Info("Clearing default models", "cleared", cleared)
+
+	if err := services.ModelService.SetDefaults(ctx.Context, defaults); err != nil {
+		return errors.WrapAPIError(err, "Failed to clear default models",
+			"Check API connection and permissions")
+	}
+
+	utils.Status(style.OK("Default models cleared!"))
+	for _, label := range cleared {
+		fmt.Printf("  %s: (not set)\n", label)
+	}
+	return nil
+}
+
+// handleModelsDefaultsValidate checks every assigned default against the model list
+func handleModelsDefaultsValidate(ctx *cli.Context) error {
+	services, err := getModelsServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	services.Logger.Info("Validating default models")
+
+	defaults, err := services.ModelService.GetDefaults(ctx.Context)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to get default models",
+			"Check API connection and permissions")
+	}
+
+	modelList, err := services.ModelService.List(ctx.Context)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to list models",
+			"Check API connection and permissions")
+	}
+
+	known := make(map[string]*models.Model, len(modelList))
+	for _, model := range modelList {
+		known[model.ID] = model
+	}
+
+	var dangling []defaultModelSlot
+	for _, slot := range defaultModelSlots(defaults) {
+		id := utils.SafeDereferenceString(*slot.Field)
+		if id == "" {
+			continue
+		}
+		if model, ok := known[id]; ok {
+			utils.Statusf(style.OK("%s: %s (%s)\n"), slot.Label, id, model.Name)
+			continue
+		}
+		fmt.Printf(style.Error("%s: %s does not exist\n"), slot.Label, id)
+		dangling = append(dangling, slot)
+	}
+
+	if len(dangling) == 0 {
+		utils.Status(style.OK("All default models are valid."))
+		return nil
+	}
+
+	flags := make([]string, len(dangling))
+	for i, slot := range dangling {
+		flags[i] = "--" + slot.Flag
+	}
+	return errors.ValidationError(fmt.Sprintf("%d default model(s) point to missing models", len(dangling)),
+		"Run 'onb models defaults clear "+strings.Join(flags, " ")+"' to remove them",
+		"Or assign existing models with 'onb models defaults set'")
+}
+
+// exportedDefaultModel is a default model assignment in an export file. Name,
+// provider and type let the import resolve the model on another instance.
+type exportedDefaultModel struct {
+	ID       string           `json:"id"`
+	Name     string           `json:"name,omitempty"`
+	Provider string           `json:"provider,omitempty"`
+	Type     models.ModelType `json:"type,omitempty"`
+}
+
+// handleModelsDefaultsExport writes the current default models, keyed by role, to a file
+func handleModelsDefaultsExport(ctx *cli.Context) error {
+	services, err := getModelsServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	outputPath := ctx.String("output")
+	services.Logger.Info("Exporting default models", "output", outputPath)
+
+	defaults, err := services.ModelService.GetDefaults(ctx.Context)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to get default models",
+			"Check API connection and permissions")
+	}
+
+	modelList, err := services.ModelService.List(ctx.Context)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to list models",
+			"Check API connection and permissions")
+	}
+
+	byID := make(map[string]*models.Model, len(modelList))
+	for _, model := range modelList {
+		byID[model.ID] = model
+	}
+
+	export := map[string]exportedDefaultModel{}
+	for _, slot := range defaultModelSlots(defaults) {
+		id := utils.SafeDereferenceString(*slot.Field)
+		if id == "" {
+			continue
+		}
+		entry := exportedDefaultModel{ID: id}
+		if model, ok := byID[id]; ok {
+			entry.Name = model.Name
+			entry.Provider = model.Provider
+			entry.Type = model.Type
+		} else {
+			utils.Statusf(style.Warn("%s default %s does not exist; exporting its ID only\n"), slot.Label, id)
+		}
+		export[slot.Flag] = entry
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return errors.ValidationError("Failed to format default models as JSON",
+			fmt.Sprintf("JSON marshaling error: %v", err))
+	}
+
+	if outputPath == "-" {
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(outputPath, append(data, '\n'), 0644); err != nil {
+		return errors.ValidationError("Failed to write export file",
+			fmt.Sprintf("Check that '%s' is writable", outputPath))
+	}
+
+	utils.Statusf(style.OK("Exported %d default models to %s\n"), len(export), outputPath)
+	return nil
+}
+
+// handleModelsDefaultsImport sets default models from an export file, mapping
+// models by name (and provider/type when present) before falling back to the ID
+func handleModelsDefaultsImport(ctx *cli.Context) error {
+	if ctx.NArg() < 1 {
+		return errors.MissingArgument("import file", ctx.Command.Name)
+	}
+	if ctx.NArg() > 1 {
+		return errors.TooManyArguments("import file", ctx.Command.Name)
+	}
+
+	services, err := getModelsServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	inputPath := ctx.Args().First()
+	data, err := os.ReadFile(inputPath)
+	if err != nil {
+		return errors.ValidationError("Failed to read import file",
+			fmt.Sprintf("Check that '%s' exists and is readable", inputPath))
+	}
+
+	var imported map[string]exportedDefaultModel
+	if err := json.Unmarshal(data, &imported); err != nil {
+		return errors.ValidationError("Invalid import file",
+			fmt.Sprintf("Expected a JSON object of default models by role: %v", err))
+	}
+
+	if len(imported) == 0 {
+		utils.Status("No default models found in import file.")
+		return nil
+	}
+
+	services.Logger.Info("Importing default models", "file", inputPath, "count", len(imported))
+
+	modelList, err := services.ModelService.List(ctx.Context)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to list models",
+			"Check API connection and permissions")
+	}
+
+	defaults := &models.DefaultModelsResponse{}
+	slots := defaultModelSlots(defaults)
+	known := make(map[string]bool, len(slots))
+	for _, slot := range slots {
+		known[slot.Flag] = true
+	}
+	for role := range imported {
+		if !known[role] {
+			return errors.ValidationError(fmt.Sprintf("Unknown default model role '%s' in import file", role),
+				"Valid roles: chat, transformation, large-context, tts, stt, embedding, tools")
+		}
+	}
+
+	var resolved, unresolved []string
+	for _, slot := range slots {
+		entry, ok := imported[slot.Flag]
+		if !ok {
+			continue
+		}
+		model := resolveDefaultModel(modelList, entry)
+		if model == nil {
+			fmt.Printf(style.Error("%s: could not resolve model '%s' (%s)\n"), slot.Label, entry.Name, entry.ID)
+			unresolved = append(unresolved, slot.Label)
+			continue
+		}
+		id := model.ID
+		*slot.Field = &id
+		resolved = append(resolved, fmt.Sprintf("%s: %s (%s)", slot.Label, model.Name, model.ID))
+	}
+
+	if len(resolved) > 0 {
+		if err := services.ModelService.SetDefaults(ctx.Context, defaults); err != nil {
+			return errors.WrapAPIError(err, "Failed to set default models",
+				"Check model IDs and API permissions")
+		}
+		utils.Status(style.OK("Default models imported!"))
+		for _, line := range resolved {
+			fmt.Printf("  %s\n", line)
+		}
+	}
+
+	if len(unresolved) > 0 {
+		return errors.ValidationError(fmt.Sprintf("Could not resolve %d default model(s): %s",
+			len(unresolved), strings.Join(unresolved, ", ")),
+			"Add the missing models with 'onb models add' and import again")
+	}
+	return nil
+}
+
+// resolveDefaultModel finds the model an export entry refers to. An exact
+// name/provider/type match wins, then a unique name match, then the raw ID.
+func resolveDefaultModel(modelList []*models.Model, entry exportedDefaultModel) *models.Model {
+	if entry.Name != "" {
+		var byName []*models.Model
+		for _, model := range modelList {
+			if model.Name != entry.Name {
+				continue
+			}
+			if (entry.Provider == "" || model.Provider == entry.Provider) &&
+				(entry.Type == "" || model.Type == entry.Type) {
+				return model
+			}
+			byName = append(byName, model)
+		}
+		if len(byName) == 1 {
+			return byName[0]
+		}
+	}
+
+	for _, model := range modelList {
+		if entry.ID != "" && model.ID == entry.ID {
+			return model
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
+)
+
+// notebookCascade lists what 'notebooks delete --cascade' removes along with
+// a notebook. Sources that also belong to other notebooks are only detached.
+type notebookCascade struct {
+	DeleteSources []string
+	DetachSources []string
+	Notes         []string
+}
+
+// Summary describes the cascade, e.g. "delete 2 sources and 3 notes and
+// detach 1 sources shared with other notebooks"
+func (c *notebookCascade) Summary() string {
+	summary := fmt.Sprintf("delete %d sources and %d notes", len(c.DeleteSources), len(c.Notes))
+	if len(c.DetachSources) > 0 {
+		summary += fmt.Sprintf(" and detach %d sources shared with other notebooks", len(c.DetachSources))
+	}
+	return summary
+}
+
+// notebookCascadeResult counts what a cascade removed
+type notebookCascadeResult struct {
+	DeletedSources  int
+	DetachedSources int
+	DeletedNotes    int
+}
+
+func (r *notebookCascadeResult) add(other notebookCascadeResult) {
+	r.DeletedSources += other.DeletedSources
+	r.DetachedSources += other.DetachedSources
+	r.DeletedNotes += other.DeletedNotes
+}
+
+// planNotebookCascade collects the notes and sources of a notebook, looking
+// up each source to tell whether it is shared with other notebooks
+func planNotebookCascade(ctx context.Context, services *NotebookServices, notebookID string) (*notebookCascade, error) {
+	cascade := &notebookCascade{}
+
+	sources, err := services.SourceService.ListAllByNotebook(ctx, notebookID)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range sources {
+		id := utils.SafeDereferenceString(item.ID)
+		source, err := services.SourceService.Get(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get source %s: %w", id, err)
+		}
+		if inOtherNotebooks(source.Notebooks, notebookID) {
+			cascade.DetachSources = append(cascade.DetachSources, id)
+		} else {
+			cascade.DeleteSources = append(cascade.DeleteSources, id)
+		}
+	}
+
+	notes, err := listNotebookNotes(ctx, services.NoteRepo, notebookID)
+	if err != nil {
+		return nil, err
+	}
+	for _, note := range notes {
+		cascade.Notes = append(cascade.Notes, utils.SafeDereferenceString(note.ID))
+	}
+	return cascade, nil
+}
+
+// inOtherNotebooks reports whether notebooks names a notebook other than
+// notebookID, comparing IDs with or without the table prefix
+func inOtherNotebooks(notebooks []string, notebookID string) bool {
+	self := strings.TrimPrefix(notebookID, notebookIDPrefix)
+	for _, id := range notebooks {
+		if strings.TrimPrefix(id, notebookIDPrefix) != self {
+			return true
+		}
+	}
+	return false
+}
+
+// runNotebookCascade removes the notes and sources of a notebook. It stops at
+// the first failure so that the notebook is not deleted with contents left.
+func runNotebookCascade(ctx context.Context, services *NotebookServices, notebookID string, cascade *notebookCascade) (notebookCascadeResult, error) {
+	var result notebookCascadeResult
+	for _, id := range cascade.Notes {
+		if err := services.NoteRepo.Delete(ctx, id); err != nil {
+			return result, fmt.Errorf("failed to delete note %s: %w", id, err)
+		}
+		result.DeletedNotes++
+	}
+	for _, id := range cascade.DetachSources {
+		if err := services.NotebookService.RemoveSourceFromNotebook(ctx, notebookID, id); err != nil {
+			return result, fmt.Errorf("failed to detach source %s: %w", id, err)
+		}
+		result.DetachedSources++
+	}
+	for _, id := range cascade.DeleteSources {
+		if err := services.SourceService.Delete(ctx, id); err != nil {
+			return result, fmt.Errorf("failed to delete source %s: %w", id, err)
+		}
+		result.DeletedSources++
+	}
+	return result, nil
+}
+
+// printCascadeResult reports what --cascade removed
+func printCascadeResult(result notebookCascadeResult) {
+	utils.Statusf(style.OK("Deleted %d sources and %d notes, detached %d sources\n"),
+		result.DeletedSources, result.DeletedNotes, result.DetachedSources)
+}
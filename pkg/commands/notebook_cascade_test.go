@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInOtherNotebooks(t *testing.T) {
+	assert.False(t, inOtherNotebooks(nil, "notebook:a"))
+	assert.False(t, inOtherNotebooks([]string{"notebook:a"}, "notebook:a"))
+	assert.False(t, inOtherNotebooks([]string{"a"}, "notebook:a"))
+	assert.True(t, inOtherNotebooks([]string{"notebook:a", "notebook:b"}, "a"))
+}
+
+func TestNotebookCascadeSummary(t *testing.T) {
+	cascade := &notebookCascade{DeleteSources: []string{"source:a", "source:b"}, Notes: []string{"note:x"}}
+	assert.Equal(t, "delete 2 sources and 1 notes", cascade.Summary())
+
+	cascade.DetachSources = []string{"source:c"}
+	assert.Equal(t, "delete 2 sources and 1 notes and detach 1 sources shared with other notebooks", cascade.Summary())
+}
+
+func TestNotebookDeleteDetails(t *testing.T) {
+	notebook := &models.Notebook{ID: "notebook:a", SourceCount: 2, NoteCount: 3}
+	assert.Equal(t, "keeps 2 sources, 3 notes", notebookDeleteDetails(notebook, nil))
+
+	cascade := &notebookCascade{DeleteSources: []string{"source:a"}, DetachSources: []string{"source:b"}, Notes: []string{"note:x"}}
+	assert.Equal(t, "will delete 1 sources and 1 notes and detach 1 sources shared with other notebooks",
+		notebookDeleteDetails(notebook, cascade))
+}
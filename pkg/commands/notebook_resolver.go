@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/urfave/cli/v2"
+)
+
+// notebookIDPrefix starts every notebook record ID
+const notebookIDPrefix = "notebook:"
+
+// notebookIDFlag returns the --notebook-id flag that accompanies a
+// --notebook flag accepting names
+func notebookIDFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "notebook-id",
+		Usage: "Notebook ID, used as is without matching notebook names (alternative to --notebook)",
+	}
+}
+
+// looksLikeNotebookID reports whether value is a notebook record ID rather
+// than a name
+func looksLikeNotebookID(value string) bool {
+	return strings.HasPrefix(value, notebookIDPrefix)
+}
+
+// resolveNotebookFlag returns the notebook selected by --notebook-id, or by
+// --notebook given as ID or name. It returns "" when neither is set.
+func resolveNotebookFlag(ctx *cli.Context, notebooks shared.NotebookService) (string, error) {
+	if id := ctx.String("notebook-id"); id != "" {
+		if ctx.String("notebook") != "" {
+			return "", errors.UsageError("--notebook and --notebook-id cannot be combined")
+		}
+		return id, nil
+	}
+	return resolveNotebookID(ctx.Context, notebooks, ctx.String("notebook"))
+}
+
+// resolveNotebookFlags resolves every --notebook value of a repeatable flag
+// and appends the --notebook-id values as they are
+func resolveNotebookFlags(ctx *cli.Context, notebooks shared.NotebookService) ([]string, error) {
+	var ids []string
+	for _, value := range ctx.StringSlice("notebook") {
+		id, err := resolveNotebookID(ctx.Context, notebooks, value)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return append(ids, ctx.StringSlice("notebook-id")...), nil
+}
+
+// resolveNotebookID returns value when it is a notebook ID. Otherwise it
+// returns the ID of the notebook whose ID without prefix equals value, or
+// else whose name matches value case-insensitively. Several notebooks with
+// that name are reported as ambiguous.
+func resolveNotebookID(ctx context.Context, notebooks shared.NotebookService, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || looksLikeNotebookID(value) {
+		return value, nil
+	}
+
+	all, err := notebooks.ListNotebooks(ctx)
+	if err != nil {
+		return "", errors.WrapAPIError(err, fmt.Sprintf("Failed to look up notebook '%s'", value),
+			"Pass the notebook ID with --notebook-id instead")
+	}
+
+	for _, notebook := range all {
+		if notebook.ID == value || notebook.ID == notebookIDPrefix+value {
+			return notebook.ID, nil
+		}
+	}
+
+	var matches []string
+	for _, notebook := range all {
+		if strings.EqualFold(notebook.Name, value) {
+			matches = append(matches, notebook.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", errors.NotFoundError(fmt.Sprintf("No notebook named '%s'", value),
+			"List notebooks with 'onb notebooks list'")
+	case 1:
+		return matches[0], nil
+	default:
+		return "", errors.ValidationError(fmt.Sprintf("Notebook name '%s' is ambiguous", value),
+			fmt.Sprintf("Matching notebooks: %s", strings.Join(matches, ", ")),
+			"Select one with --notebook-id <id>")
+	}
+}
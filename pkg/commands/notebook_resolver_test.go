@@ -0,0 +1,91 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/mocks"
+	"github.com/denkhaus/open-notebook-cli/pkg/services"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+// newResolverTestNotebooks wires the notebook service against a mock API
+// listing a few notebooks, two of them named alike
+func newResolverTestNotebooks(t *testing.T) shared.NotebookService {
+	t.Helper()
+
+	client := mocks.NewMockHTTPClient()
+	client.SetJSONResponse("GET", "/notebooks", `[
+		{"id":"notebook:abc123","name":"Research"},
+		{"id":"notebook:def456","name":"abc123"},
+		{"id":"notebook:x1","name":"Drafts"},
+		{"id":"notebook:x2","name":"drafts"}]`)
+
+	injector := do.New()
+	do.ProvideValue[shared.HTTPClient](injector, client)
+	do.ProvideValue[shared.Logger](injector, mocks.NewMockLogger(false))
+	do.Provide(injector, services.NewNotebookRepository)
+	do.Provide(injector, services.NewNotebookService)
+	return do.MustInvoke[shared.NotebookService](injector)
+}
+
+func TestResolveNotebookID(t *testing.T) {
+	notebooks := newResolverTestNotebooks(t)
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		value string
+		want  string
+	}{
+		"prefixed id":              {"notebook:x1", "notebook:x1"},
+		"bare id":                  {"abc123", "notebook:abc123"},
+		"name":                     {"research", "notebook:abc123"},
+		"empty":                    {"", ""},
+		"bare id wins over a name": {" abc123 ", "notebook:abc123"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			id, err := resolveNotebookID(ctx, notebooks, tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, id)
+		})
+	}
+
+	_, err := resolveNotebookID(ctx, notebooks, "drafts")
+	assert.Error(t, err, "ambiguous name")
+
+	_, err = resolveNotebookID(ctx, notebooks, "missing")
+	assert.Error(t, err)
+}
+
+func TestParseNotebookSourceArgs_ResolvesNames(t *testing.T) {
+	notebooks := newResolverTestNotebooks(t)
+	var flags []cli.Flag
+	for _, command := range NotebooksCommand().Subcommands {
+		if command.Name == "add-source" {
+			flags = command.Flags
+		}
+	}
+	require.NotEmpty(t, flags)
+
+	tests := map[string][]string{
+		"positional name": {"Research", "source:1"},
+		"--notebook name": {"--notebook", "research", "source:1"},
+		"--notebook-id":   {"--notebook-id", "notebook:abc123", "--source", "source:1"},
+	}
+	for name, args := range tests {
+		t.Run(name, func(t *testing.T) {
+			notebookID, sourceIDs, err := parseNotebookSourceArgs(newTestContext(t, flags, args...), notebooks)
+			require.NoError(t, err)
+			assert.Equal(t, "notebook:abc123", notebookID)
+			assert.Equal(t, []string{"source:1"}, sourceIDs)
+		})
+	}
+
+	_, _, err := parseNotebookSourceArgs(newTestContext(t, flags, "drafts", "source:1"), notebooks)
+	assert.Error(t, err, "ambiguous name")
+}
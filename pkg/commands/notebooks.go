@@ -3,12 +3,15 @@ package commands
 import (
 	"github.com/denkhaus/open-notebook-cli/pkg/config"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
 	"github.com/urfave/cli/v2"
 )
 
 // NotebookServices holds all the services needed for notebook commands
 type NotebookServices struct {
 	NotebookService shared.NotebookService
+	SourceService   shared.SourceService
+	NoteRepo        shared.NoteRepository
 	Config          config.Service
 	Logger          shared.Logger
 }
@@ -22,13 +25,17 @@ func NotebooksCommand() *cli.Command {
 			{
 				Name:  "list",
 				Usage: "List all notebooks",
-				Flags: []cli.Flag{
+				Flags: append([]cli.Flag{
 					&cli.BoolFlag{
 						Name:  "archived",
 						Usage: "Show archived notebooks",
 						Value: false,
 					},
-				},
+					fieldsFlag(),
+					noHeaderFlag(),
+					countFlag(),
+					filterFlag(),
+				}, dateFilterFlags()...),
 				Action: handleNotebooksList,
 			},
 			{
@@ -92,12 +99,12 @@ func NotebooksCommand() *cli.Command {
 			},
 			{
 				Name:  "delete",
-				Usage: "Delete notebook",
+				Usage: "Delete notebooks",
 				Flags: []cli.Flag{
-					&cli.StringFlag{
+					&cli.StringSliceFlag{
 						Name:     "id",
 						Aliases:  []string{"i"},
-						Usage:    "Notebook ID",
+						Usage:    "Notebook ID (can be specified multiple times)",
 						Required: true,
 					},
 					&cli.BoolFlag{
@@ -106,43 +113,65 @@ func NotebooksCommand() *cli.Command {
 						Usage:   "Skip confirmation prompt",
 						Value:   false,
 					},
+					&cli.BoolFlag{
+						Name:  "confirm-each",
+						Usage: "Ask for every notebook: y(es), N(o), a(ll remaining) or q(uit)",
+					},
+					&cli.BoolFlag{
+						Name:  "cascade",
+						Usage: "Also delete the notebook's notes and sources; sources in other notebooks are detached instead",
+					},
 				},
 				Action: handleNotebooksDelete,
 			},
 			{
-				Name:  "add-source",
-				Usage: "Add source to notebook",
+				Name:  "stats",
+				Usage: "Show source, embedding and note counts per notebook with totals",
 				Flags: []cli.Flag{
-					&cli.StringFlag{
-						Name:     "notebook",
-						Aliases:  []string{"n"},
-						Usage:    "Notebook ID",
-						Required: true,
+					&cli.IntFlag{
+						Name:  "parallel",
+						Usage: "Number of notebooks to gather statistics for concurrently",
+						Value: utils.DefaultParallelism,
 					},
+					fieldsFlag(),
+					noHeaderFlag(),
+				},
+				Action: handleNotebooksStats,
+			},
+			{
+				Name:      "add-source",
+				Usage:     "Add sources to notebook",
+				ArgsUsage: "<notebook> <source-id> [source-id...]",
+				Flags: []cli.Flag{
 					&cli.StringFlag{
-						Name:     "source",
-						Aliases:  []string{"s"},
-						Usage:    "Source ID",
-						Required: true,
+						Name:    "notebook",
+						Aliases: []string{"n"},
+						Usage:   "Notebook ID or name (alternative to the first argument)",
+					},
+					notebookIDFlag(),
+					&cli.StringSliceFlag{
+						Name:    "source",
+						Aliases: []string{"s"},
+						Usage:   "Source ID (repeatable, alternative to positional arguments)",
 					},
 				},
 				Action: handleNotebooksAddSource,
 			},
 			{
-				Name:  "remove-source",
-				Usage: "Remove source from notebook",
+				Name:      "remove-source",
+				Usage:     "Remove sources from notebook",
+				ArgsUsage: "<notebook> <source-id> [source-id...]",
 				Flags: []cli.Flag{
 					&cli.StringFlag{
-						Name:     "notebook",
-						Aliases:  []string{"n"},
-						Usage:    "Notebook ID",
-						Required: true,
+						Name:    "notebook",
+						Aliases: []string{"n"},
+						Usage:   "Notebook ID or name (alternative to the first argument)",
 					},
-					&cli.StringFlag{
-						Name:     "source",
-						Aliases:  []string{"s"},
-						Usage:    "Source ID",
-						Required: true,
+					notebookIDFlag(),
+					&cli.StringSliceFlag{
+						Name:    "source",
+						Aliases: []string{"s"},
+						Usage:   "Source ID (repeatable, alternative to positional arguments)",
 					},
 				},
 				Action: handleNotebooksRemoveSource,
@@ -13,7 +13,9 @@ func NotesCommand() *cli.Command {
 			notesListCommand(),
 			notesAddCommand(),
 			notesShowCommand(),
+			notesDiffCommand(),
 			notesUpdateCommand(),
+			notesMoveCommand(),
 			notesDeleteCommand(),
 			notesSearchCommand(),
 		},
@@ -25,7 +27,7 @@ func notesListCommand() *cli.Command {
 	return &cli.Command{
 		Name:  "list",
 		Usage: "List notes",
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			&cli.StringFlag{
 				Name:    "notebook",
 				Aliases: []string{"n"},
@@ -43,7 +45,11 @@ func notesListCommand() *cli.Command {
 				Usage:   "Number of notes to skip",
 				Value:   0,
 			},
-		},
+			allFlag(),
+			fieldsFlag(),
+			noHeaderFlag(),
+			countFlag(),
+		}, dateFilterFlags()...),
 		Action: handleNotesList,
 	}
 }
@@ -92,6 +98,39 @@ func notesShowCommand() *cli.Command {
 	}
 }
 
+// notesDiffCommand implements notes diff functionality
+func notesDiffCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "diff",
+		Usage:     "Compare a note's content with a file or another note",
+		ArgsUsage: "<note-id>",
+		Description: "Print a unified diff from the note's content to the content of a\n" +
+			"local file or another note, e.g. to review an edit before updating.\n\n" +
+			"Examples:\n" +
+			"  onb notes diff --file draft.md note:abc\n" +
+			"  onb notes diff --note note:def note:abc",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:      "file",
+				Aliases:   []string{"f"},
+				Usage:     "File to compare with ('-' for stdin)",
+				TakesFile: true,
+			},
+			&cli.StringFlag{
+				Name:  "note",
+				Usage: "Other note to compare with",
+			},
+			&cli.IntFlag{
+				Name:    "context",
+				Aliases: []string{"c"},
+				Usage:   "Number of unchanged lines shown around each change",
+				Value:   3,
+			},
+		},
+		Action: handleNotesDiff,
+	}
+}
+
 // notesUpdateCommand implements notes update functionality
 func notesUpdateCommand() *cli.Command {
 	return &cli.Command{
@@ -119,6 +158,30 @@ func notesUpdateCommand() *cli.Command {
 	}
 }
 
+// notesMoveCommand implements notes move functionality
+func notesMoveCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "move",
+		Usage:     "Move a note to another notebook",
+		ArgsUsage: "<note-id>",
+		Description: "The API cannot reassign a note to another notebook, so the note is\n" +
+			"recreated in the target notebook with the same title, content and type,\n" +
+			"and the original is deleted afterwards. The moved note therefore gets a\n" +
+			"new ID and a new created timestamp; the original timestamp is printed\n" +
+			"after the move. If deleting the original fails, the note exists in both\n" +
+			"notebooks and the error names both IDs.",
+		Args: true,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "to",
+				Usage:    "Notebook ID or name the note is moved into",
+				Required: true,
+			},
+		},
+		Action: handleNotesMove,
+	}
+}
+
 // notesDeleteCommand implements notes delete functionality
 func notesDeleteCommand() *cli.Command {
 	return &cli.Command{
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"text/tabwriter"
 
@@ -10,15 +11,19 @@ import (
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
 	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/diff"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/render"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
 	"github.com/samber/do/v2"
 	"github.com/urfave/cli/v2"
 )
 
 // NotesServices holds all the services needed for note commands
 type NotesServices struct {
-	NoteService shared.NoteRepository
-	Config      config.Service
-	Logger      shared.Logger
+	NoteService     shared.NoteRepository
+	NotebookService shared.NotebookService
+	Config          config.Service
+	Logger          shared.Logger
 }
 
 // getNotesServices retrieves all required services via dependency injection
@@ -30,9 +35,10 @@ func getNotesServices(ctx *cli.Context) (*NotesServices, error) {
 	}
 
 	return &NotesServices{
-		NoteService: do.MustInvoke[shared.NoteRepository](injector),
-		Config:      do.MustInvoke[config.Service](injector),
-		Logger:      do.MustInvoke[shared.Logger](injector),
+		NoteService:     do.MustInvoke[shared.NoteRepository](injector),
+		NotebookService: do.MustInvoke[shared.NotebookService](injector),
+		Config:          do.MustInvoke[config.Service](injector),
+		Logger:          do.MustInvoke[shared.Logger](injector),
 	}, nil
 }
 
@@ -40,10 +46,10 @@ func getNotesServices(ctx *cli.Context) (*NotesServices, error) {
 func validateNoteArgs(ctx *cli.Context, requireNoteID bool) (string, error) {
 	if requireNoteID {
 		if ctx.NArg() < 1 {
-			return "", fmt.Errorf("❌ Error: Missing note ID")
+			return "", errors.UsageError("Missing note ID")
 		}
 		if ctx.NArg() > 1 {
-			return "", fmt.Errorf("❌ Error: Too many arguments. Expected only note ID")
+			return "", errors.UsageError("Too many arguments. Expected only note ID")
 		}
 	}
 
@@ -58,7 +64,7 @@ func validateNoteArgs(ctx *cli.Context, requireNoteID bool) (string, error) {
 
 // printNoteSuccess prints standardized success messages for note operations
 func printNoteSuccess(operation string, note *models.Note) {
-	fmt.Printf("✅ Note %s successfully!\n", operation)
+	utils.Statusf(style.OK("Note %s successfully!\n"), operation)
 	fmt.Printf("  ID:     %s\n", utils.SafeDereferenceString(note.ID))
 	fmt.Printf("  Title:  %s\n", utils.SafeDereferenceString(note.Title))
 	if note.NoteType != nil {
@@ -78,24 +84,33 @@ func handleNotesList(ctx *cli.Context) error {
 	services.Logger.Info("Listing notes...")
 
 	notebookID := ctx.String("notebook")
-	limit := ctx.Int("limit")
-	offset := ctx.Int("offset")
 
-	notes, err := services.NoteService.List(ctx.Context, notebookID, limit, offset)
-	if err != nil {
-		return errors.APIError("Failed to list notes",
-			"Check API connection and permissions")
+	notes, streamed, err := listPages(ctx,
+		func(limit, offset int) ([]*models.Note, error) {
+			notes, err := services.NoteService.List(ctx.Context, notebookID, limit, offset)
+			if err != nil {
+				return nil, errors.WrapAPIError(err, "Failed to list notes",
+					"Check API connection and permissions")
+			}
+			return notes, nil
+		},
+		func(notes []*models.Note) ([]*models.Note, error) {
+			return filterByDate(ctx, notes)
+		})
+	if err != nil || streamed {
+		return err
+	}
+	if printCount(ctx, len(notes)) {
+		return nil
 	}
 
-	if len(notes) == 0 {
-		fmt.Println("No notes found.")
+	if len(notes) == 0 && !isStructuredOutput(ctx) {
+		utils.Status("No notes found.")
 		return nil
 	}
 
 	// Display notes in a table
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "ID\tTITLE\tTYPE\tCREATED")
-
+	table := render.NewTable("ID", "TITLE", "TYPE", "CREATED")
 	for _, note := range notes {
 		title := "Untitled"
 		if note.Title != nil {
@@ -106,16 +121,24 @@ func handleNotesList(ctx *cli.Context) error {
 			noteType = string(*note.NoteType)
 		}
 
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+		table.AddRow(
 			utils.SafeDereferenceString(note.ID),
-			utils.TruncateString(title, 30),
+			title,
 			noteType,
 			utils.FormatTimestamp(note.Created))
 	}
 
-	w.Flush()
+	if err := renderList(ctx, notes, table); err != nil {
+		return err
+	}
 
-	fmt.Printf("\nShowing %d notes (use --limit and --offset for pagination)\n", len(notes))
+	if !isStructuredOutput(ctx) {
+		if ctx.Bool("all") {
+			utils.Statusf("\nShowing all %d notes\n", len(notes))
+		} else {
+			utils.Statusf("\nShowing %d notes (use --limit and --offset for pagination, or --all)\n", len(notes))
+		}
+	}
 	return nil
 }
 
@@ -165,7 +188,7 @@ func handleNotesAdd(ctx *cli.Context) error {
 
 	note, err := services.NoteService.Create(ctx.Context, noteCreate)
 	if err != nil {
-		return errors.APIError("Failed to create note",
+		return errors.WrapAPIError(err, "Failed to create note",
 			"Check input parameters and API permissions")
 	}
 
@@ -173,6 +196,62 @@ func handleNotesAdd(ctx *cli.Context) error {
 	return nil
 }
 
+// handleNotesDiff handles the notes diff command
+func handleNotesDiff(ctx *cli.Context) error {
+	noteID, err := validateNoteArgs(ctx, true)
+	if err != nil {
+		return err
+	}
+	filePath, otherID := ctx.String("file"), ctx.String("note")
+	if (filePath == "") == (otherID == "") {
+		return errors.UsageError("Specify exactly one of --file or --note to compare with",
+			"Example: onb notes diff --file draft.md <note-id>")
+	}
+
+	services, err := getNotesServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	services.Logger.Info("Comparing note", "note_id", noteID, "file", filePath, "other_note_id", otherID)
+
+	note, err := services.NoteService.Get(ctx.Context, noteID)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to get note",
+			"Check note ID and permissions")
+	}
+
+	var otherName, otherContent string
+	if filePath != "" {
+		var data []byte
+		if filePath == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(filePath)
+		}
+		if err != nil {
+			return errors.UsageError(fmt.Sprintf("Cannot read file '%s'", filePath), err.Error())
+		}
+		otherName, otherContent = filePath, string(data)
+	} else {
+		other, err := services.NoteService.Get(ctx.Context, otherID)
+		if err != nil {
+			return errors.WrapAPIError(err, "Failed to get note to compare with",
+				"Check note ID and permissions")
+		}
+		otherName, otherContent = otherID, utils.SafeDereferenceString(other.Content)
+	}
+
+	lines := diff.Lines(diff.SplitLines(utils.SafeDereferenceString(note.Content)), diff.SplitLines(otherContent))
+	out := diff.Unified(noteID, otherName, lines, ctx.Int("context"))
+	if out == "" {
+		utils.Status(style.OK("No differences"))
+		return nil
+	}
+	fmt.Print(out)
+	return nil
+}
+
 // handleNotesShow handles the notes show command
 func handleNotesShow(ctx *cli.Context) error {
 	noteID, err := validateNoteArgs(ctx, true)
@@ -189,10 +268,14 @@ func handleNotesShow(ctx *cli.Context) error {
 
 	note, err := services.NoteService.Get(ctx.Context, noteID)
 	if err != nil {
-		return errors.APIError("Failed to get note details",
+		return errors.WrapAPIError(err, "Failed to get note details",
 			"Check note ID and permissions")
 	}
 
+	if handled, err := renderObject(ctx, note); handled {
+		return err
+	}
+
 	// Display note details
 	fmt.Printf("Note Details:\n")
 	fmt.Printf("  ID:           %s\n", utils.SafeDereferenceString(note.ID))
@@ -212,33 +295,22 @@ func handleNotesShow(ctx *cli.Context) error {
 	return nil
 }
 
-// handleNotesUpdate handles the notes update command
-func handleNotesUpdate(ctx *cli.Context) error {
-	noteID, err := validateNoteArgs(ctx, true)
-	if err != nil {
-		return err
-	}
-
-	services, err := getNotesServices(ctx)
-	if err != nil {
-		return err
-	}
-
-	title := ctx.String("title")
-	content := ctx.String("content")
-	noteType := ctx.String("type")
-
-	if title == "" && content == "" && noteType == "" {
-		return errors.UsageError("At least one update field is required",
+// noteUpdateFromFlags builds a partial update from the flags the user passed.
+// Fields without a flag stay nil and are left out of the request, so the
+// server keeps their current values; a flag set to "" does clear its field.
+func noteUpdateFromFlags(ctx *cli.Context) (*models.NoteUpdate, error) {
+	if !ctx.IsSet("title") && !ctx.IsSet("content") && !ctx.IsSet("type") {
+		return nil, errors.UsageError("At least one update field is required",
 			"Use --title, --content, or --type flags")
 	}
 
-	// Build update request with only provided fields
 	update := &models.NoteUpdate{}
 	if ctx.IsSet("title") {
+		title := ctx.String("title")
 		update.Title = &title
 	}
 	if ctx.IsSet("content") {
+		content := ctx.String("content")
 		update.Content = &content
 	}
 	if ctx.IsSet("type") {
@@ -248,19 +320,39 @@ func handleNotesUpdate(ctx *cli.Context) error {
 			"markdown": true,
 			"code":     true,
 		}
+		noteType := ctx.String("type")
 		if !validTypes[noteType] {
-			return errors.UsageError("Invalid note type",
+			return nil, errors.UsageError("Invalid note type",
 				"Supported types are: text, markdown, code")
 		}
 		typedNoteType := models.NoteType(noteType)
 		update.NoteType = &typedNoteType
 	}
+	return update, nil
+}
+
+// handleNotesUpdate handles the notes update command
+func handleNotesUpdate(ctx *cli.Context) error {
+	noteID, err := validateNoteArgs(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	services, err := getNotesServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	update, err := noteUpdateFromFlags(ctx)
+	if err != nil {
+		return err
+	}
 
 	services.Logger.Info("Updating note", "note_id", noteID)
 
 	updatedNote, err := services.NoteService.Update(ctx.Context, noteID, update)
 	if err != nil {
-		return errors.APIError("Failed to update note",
+		return errors.WrapAPIError(err, "Failed to update note",
 			"Check note ID and permissions")
 	}
 
@@ -268,6 +360,64 @@ func handleNotesUpdate(ctx *cli.Context) error {
 	return nil
 }
 
+// handleNotesMove handles the notes move command. Notes cannot be
+// reassigned through the API, so the note is recreated in the target
+// notebook before the original is deleted.
+func handleNotesMove(ctx *cli.Context) error {
+	noteID, err := validateNoteArgs(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	services, err := getNotesServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	notebookID, err := resolveNotebookID(ctx.Context, services.NotebookService, ctx.String("to"))
+	if err != nil {
+		return err
+	}
+	if _, err := services.NotebookService.GetNotebook(ctx.Context, notebookID); err != nil {
+		return notebookError(err, notebookID, "Failed to get target notebook")
+	}
+
+	note, err := services.NoteService.Get(ctx.Context, noteID)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to get note details",
+			"Check note ID and permissions")
+	}
+
+	services.Logger.Info("Moving note", "note_id", noteID, "notebook", notebookID)
+
+	moved, err := services.NoteService.Create(ctx.Context, &models.NoteCreate{
+		Title:      note.Title,
+		Content:    utils.SafeDereferenceString(note.Content),
+		NoteType:   note.NoteType,
+		NotebookID: &notebookID,
+	})
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to create note in target notebook",
+			"The original note was not changed")
+	}
+	movedID := utils.SafeDereferenceString(moved.ID)
+
+	if err := services.NoteService.Delete(ctx.Context, noteID); err != nil {
+		return errors.APIError(
+			fmt.Sprintf("Note copied to notebook %s as %s, but deleting the original %s failed: %v", notebookID, movedID, noteID, err),
+			fmt.Sprintf("The note now exists twice: %s (original) and %s (copy)", noteID, movedID),
+			fmt.Sprintf("Delete the original with 'onb notes delete %s'", noteID))
+	}
+
+	printNoteSuccess("moved", moved)
+	fmt.Printf("  Was:    %s\n", noteID)
+	if note.Created != "" {
+		utils.Statusf(style.Icon("💡", "The moved note has a new created timestamp; the original was created %s\n"),
+			utils.FormatTimestamp(note.Created))
+	}
+	return nil
+}
+
 // handleNotesDelete handles the notes delete command
 func handleNotesDelete(ctx *cli.Context) error {
 	noteID, err := validateNoteArgs(ctx, true)
@@ -280,28 +430,25 @@ func handleNotesDelete(ctx *cli.Context) error {
 		return err
 	}
 
-	force := ctx.Bool("force")
-
 	// Confirm deletion unless force flag is used
-	if !force {
-		fmt.Printf("Are you sure you want to delete note '%s'? (y/N): ", noteID)
-		var response string
-		fmt.Scanln(&response)
-		if response != "y" && response != "Y" {
-			fmt.Println("Deletion cancelled.")
-			return nil
-		}
+	ok, err := confirmDestructive(ctx, fmt.Sprintf("Are you sure you want to delete note '%s'?", noteID))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Println("Deletion cancelled.")
+		return nil
 	}
 
 	services.Logger.Info("Deleting note", "note_id", noteID)
 
 	err = services.NoteService.Delete(ctx.Context, noteID)
 	if err != nil {
-		return errors.APIError("Failed to delete note",
+		return errors.WrapAPIError(err, "Failed to delete note",
 			"Check note ID and permissions")
 	}
 
-	fmt.Printf("✅ Note '%s' deleted successfully!\n", noteID)
+	utils.Statusf(style.OK("Note '%s' deleted successfully!\n"), noteID)
 	return nil
 }
 
@@ -324,12 +471,12 @@ func handleNotesSearch(ctx *cli.Context) error {
 
 	notes, err := services.NoteService.Search(ctx.Context, notebookID, query)
 	if err != nil {
-		return errors.APIError("Failed to search notes",
+		return errors.WrapAPIError(err, "Failed to search notes",
 			"Check API connection and permissions")
 	}
 
 	if len(notes) == 0 {
-		fmt.Println("No notes found matching your search.")
+		utils.Status("No notes found matching your search.")
 		return nil
 	}
 
@@ -356,6 +503,6 @@ func handleNotesSearch(ctx *cli.Context) error {
 
 	w.Flush()
 
-	fmt.Printf("\nFound %d notes matching '%s'\n", len(notes), query)
+	utils.Statusf("\nFound %d notes matching '%s'\n", len(notes), query)
 	return nil
 }
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/config"
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/mocks"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/services"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestNoteUpdateFromFlags_KeepsUnspecifiedTitle(t *testing.T) {
+	update, err := noteUpdateFromFlags(newTestContext(t, notesUpdateCommand().Flags, "--content", "new content"))
+	require.NoError(t, err)
+
+	assert.Nil(t, update.Title)
+	assert.Nil(t, update.NoteType)
+	require.NotNil(t, update.Content)
+	assert.Equal(t, "new content", *update.Content)
+
+	body, err := json.Marshal(update)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"content":"new content"}`, string(body))
+}
+
+func TestNoteUpdateFromFlags_ExplicitEmptyTitle(t *testing.T) {
+	update, err := noteUpdateFromFlags(newTestContext(t, notesUpdateCommand().Flags, "--title", ""))
+	require.NoError(t, err)
+
+	require.NotNil(t, update.Title)
+	assert.Empty(t, *update.Title)
+	assert.Nil(t, update.Content)
+}
+
+func TestNoteUpdateFromFlags_RequiresAField(t *testing.T) {
+	_, err := noteUpdateFromFlags(newTestContext(t, notesUpdateCommand().Flags))
+	assert.Error(t, err)
+
+	_, err = noteUpdateFromFlags(newTestContext(t, notesUpdateCommand().Flags, "--type", "nope"))
+	assert.Error(t, err)
+}
+
+func TestNotesMove_ReportsFailedDeleteOfOriginal(t *testing.T) {
+	client := mocks.NewMockHTTPClient()
+	client.SetJSONResponse("GET", "/notebooks", `[{"id":"notebook:b","name":"Archive"}]`)
+	client.SetJSONResponse("GET", "/notebooks/notebook:b", `{"id":"notebook:b","name":"Archive"}`)
+	client.SetJSONResponse("GET", "/notes/note:1", `{"id":"note:1","title":"Plan","content":"text","created":"2024-01-31T10:00:00Z"}`)
+	client.SetJSONResponse("POST", "/notes", `{"id":"note:2","title":"Plan"}`)
+	client.SetResponse("DELETE", "/notes/note:1", &models.Response{
+		StatusCode: http.StatusInternalServerError,
+		Body:       []byte(`{"detail":"boom"}`),
+	})
+
+	injector := do.New()
+	do.ProvideValue(injector, newTestContext(t, []cli.Flag{&cli.StringFlag{Name: "config-dir", Value: t.TempDir()}}))
+	do.Provide(injector, config.NewConfig)
+	do.ProvideValue[shared.HTTPClient](injector, client)
+	do.ProvideValue[shared.Logger](injector, mocks.NewMockLogger(false))
+	do.Provide(injector, services.NewNoteRepository)
+	do.Provide(injector, services.NewNotebookRepository)
+	do.Provide(injector, services.NewNotebookService)
+
+	app := &cli.App{
+		Commands: []*cli.Command{notesMoveCommand()},
+		Metadata: map[string]interface{}{"injector": injector},
+	}
+	err := app.Run([]string{"onb", "move", "--to", "Archive", "note:1"})
+
+	cliErr, ok := err.(*errors.CLIError)
+	require.True(t, ok, "got %T", err)
+	assert.Contains(t, cliErr.Message, "Note copied to notebook notebook:b as note:2, but deleting the original note:1 failed")
+	assert.Contains(t, cliErr.Suggestions, "Delete the original with 'onb notes delete note:1'")
+}
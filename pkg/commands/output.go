@@ -0,0 +1,254 @@
+package commands
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/filter"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/render"
+	"github.com/urfave/cli/v2"
+)
+
+// fieldsFlag returns the --fields flag shared by list commands
+func fieldsFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "fields",
+		Usage: "Comma separated fields to include (JSON field names, or table columns in table output)",
+	}
+}
+
+// noHeaderFlag returns the --no-header flag shared by list commands
+func noHeaderFlag() cli.Flag {
+	return &cli.BoolFlag{
+		Name:  "no-header",
+		Usage: "Omit the header row of table output, e.g. for awk or cut",
+	}
+}
+
+// filterFlag returns the --filter flag shared by list commands
+func filterFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:  "filter",
+		Usage: "Filter expression over JSON fields, e.g. 'embedded==true && embedded_chunks>10' (==, !=, >, <, &&, ||)",
+	}
+}
+
+// filterItems keeps the items matching the --filter expression, if any
+func filterItems[T any](ctx *cli.Context, items []T) ([]T, error) {
+	expr := ctx.String("filter")
+	if expr == "" {
+		return items, nil
+	}
+
+	f, err := filter.Parse(expr)
+	if err != nil {
+		return nil, errors.UsageError("Invalid --filter expression: "+err.Error(),
+			"Compare JSON fields with literals, e.g. --filter 'status==completed && embedded==true'")
+	}
+
+	filtered, err := filter.Apply(f, items)
+	if err != nil {
+		return nil, errors.ValidationError("Failed to apply --filter", err.Error())
+	}
+	return filtered, nil
+}
+
+// dateFilterFlags returns the --since, --until and --by flags shared by
+// list commands
+func dateFilterFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  "since",
+			Usage: "Only include items created (or --by updated) on or after this date, e.g. 2024-01-01 or 7d",
+		},
+		&cli.StringFlag{
+			Name:  "until",
+			Usage: "Only include items created (or --by updated) on or before this date",
+		},
+		&cli.StringFlag{
+			Name:  "by",
+			Usage: "Timestamp --since and --until compare against (created, updated)",
+			Value: "created",
+		},
+	}
+}
+
+// filterByDate keeps the items whose created or updated timestamp lies
+// within --since and --until. Items without a parsable timestamp are
+// dropped once a bound is set.
+func filterByDate[T any](ctx *cli.Context, items []T) ([]T, error) {
+	if !ctx.IsSet("since") && !ctx.IsSet("until") {
+		return items, nil
+	}
+
+	field := ctx.String("by")
+	if field != "created" && field != "updated" {
+		return nil, errors.ValidationError(fmt.Sprintf("Invalid --by value '%s'", field),
+			"Valid values: created, updated")
+	}
+
+	var since, until time.Time
+	for _, bound := range []struct {
+		flag     string
+		endOfDay bool
+		target   *time.Time
+	}{
+		{"since", false, &since},
+		{"until", true, &until},
+	} {
+		if !ctx.IsSet(bound.flag) {
+			continue
+		}
+		t, err := utils.ParseDate(ctx.String(bound.flag), bound.endOfDay)
+		if err != nil {
+			return nil, errors.UsageError(fmt.Sprintf("Invalid --%s date '%s'", bound.flag, ctx.String(bound.flag)),
+				"Accepted formats: "+utils.DateFormatExample)
+		}
+		*bound.target = t
+	}
+	if !since.IsZero() && !until.IsZero() && until.Before(since) {
+		return nil, errors.UsageError("--until lies before --since")
+	}
+
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return nil, errors.ValidationError("Failed to apply date filter", err.Error())
+		}
+		var timestamps map[string]any
+		if err := json.Unmarshal(data, &timestamps); err != nil {
+			return nil, errors.ValidationError("Failed to apply date filter", err.Error())
+		}
+
+		value, _ := timestamps[field].(string)
+		t, ok := utils.ParseTimestamp(value)
+		if !ok {
+			continue
+		}
+		if !since.IsZero() && t.Before(since) {
+			continue
+		}
+		if !until.IsZero() && t.After(until) {
+			continue
+		}
+		result = append(result, item)
+	}
+	return result, nil
+}
+
+// outputOptions builds render options from the global --output,
+// --template and --template-file flags and the command's --fields flag
+func outputOptions(ctx *cli.Context) (render.Options, error) {
+	opts := render.Options{
+		Format:   ctx.String("output"),
+		Fields:   render.ParseFields(ctx.String("fields")),
+		NoHeader: ctx.Bool("no-header"),
+	}
+	if !render.IsStructured(opts.Format) {
+		// Fit tables to the terminal; piped output keeps full values
+		if width, _, ok := utils.TerminalSize(os.Stdout); ok && utils.IsTerminal(os.Stdout) {
+			opts.Width = width
+		}
+	}
+	if opts.Format != render.FormatTemplate {
+		return opts, nil
+	}
+
+	text, file := ctx.String("template"), ctx.String("template-file")
+	switch {
+	case text != "" && file != "":
+		return opts, errors.UsageError("--template and --template-file cannot be combined")
+	case file != "":
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return opts, errors.UsageError("Failed to read template file", err.Error())
+		}
+		text = string(data)
+	case text == "":
+		return opts, errors.UsageError("--output template requires --template or --template-file",
+			"Example: onb --output template --template '{{.ID}} {{.Title}}' sources list")
+	}
+	opts.Template = text
+	return opts, nil
+}
+
+// isWideOutput reports whether list tables should include their additional
+// columns (--output wide)
+func isWideOutput(ctx *cli.Context) bool {
+	return ctx.String("output") == render.FormatWide
+}
+
+// formatYesNo renders a boolean table cell
+func formatYesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+// renderList writes a list result to stdout in the selected output format
+func renderList(ctx *cli.Context, items any, table *render.Table) error {
+	opts, err := outputOptions(ctx)
+	if err != nil {
+		return err
+	}
+	if err := render.List(os.Stdout, opts, items, table); err != nil {
+		return renderListError(err)
+	}
+	return nil
+}
+
+// renderListError maps a failure to render a list to a CLI error
+func renderListError(err error) error {
+	var unknown *render.UnknownFieldError
+	if stderrors.As(err, &unknown) {
+		return errors.UsageError("Invalid --fields value: "+unknown.Error(),
+			"In table output --fields selects columns; otherwise it selects JSON field names")
+	}
+	return renderError(err)
+}
+
+// renderError maps a render failure to a CLI error
+func renderError(err error) error {
+	var tmplErr *render.TemplateError
+	if stderrors.As(err, &tmplErr) {
+		return errors.UsageError("Invalid output template: "+tmplErr.Error(),
+			"Template fields use Go names, e.g. {{.ID}}; helpers: truncate, formatTime")
+	}
+	return errors.ValidationError("Failed to render output", err.Error())
+}
+
+// renderObject writes a single item to stdout when the global --output
+// selects a structured format, and reports whether it did. Otherwise the
+// caller prints its human-readable view.
+func renderObject(ctx *cli.Context, item any) (bool, error) {
+	if !isStructuredOutput(ctx) {
+		return false, nil
+	}
+	opts, err := outputOptions(ctx)
+	if err != nil {
+		return true, err
+	}
+
+	if err := render.Object(os.Stdout, opts, item); err != nil {
+		var unknown *render.UnknownFieldError
+		if stderrors.As(err, &unknown) {
+			return true, errors.UsageError("Invalid --fields value: "+unknown.Error(),
+				"Select JSON field names, e.g. --fields id,title")
+		}
+		return true, renderError(err)
+	}
+	return true, nil
+}
+
+// isStructuredOutput reports whether the global --output selects a
+// machine-readable format, in which case decorative lines are omitted
+func isStructuredOutput(ctx *cli.Context) bool {
+	return render.IsStructured(ctx.String("output"))
+}
@@ -0,0 +1,8 @@
+package commands
+
+import "github.com/denkhaus/open-notebook-cli/pkg/utils"
+
+// stopPager ends paging before a command redraws, polls or prompts: a pager
+// would hold that output back, or swallow the prompt, until the command
+// exits. Tests replace it to observe the call.
+var stopPager = utils.StopPager
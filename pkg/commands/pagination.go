@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/render"
+	"github.com/urfave/cli/v2"
+)
+
+// maxListPages bounds --all in case the API keeps returning full pages
+const maxListPages = 10000
+
+// allFlag returns the --all flag of list commands paged by the API
+func allFlag() cli.Flag {
+	return &cli.BoolFlag{
+		Name:  "all",
+		Usage: "Fetch every page of --limit items, starting at --offset",
+	}
+}
+
+// countFlag returns the --count flag of list commands
+func countFlag() cli.Flag {
+	return &cli.BoolFlag{
+		Name:  "count",
+		Usage: "Print only the number of matching items, counting every page",
+	}
+}
+
+// printCount prints n as a bare number when --count is set and reports
+// whether it did
+func printCount(ctx *cli.Context, n int) bool {
+	if !ctx.Bool("count") {
+		return false
+	}
+	fmt.Println(n)
+	return true
+}
+
+// listPages fetches one page of --limit items at --offset, or with --all or
+// --count every following page until one comes back short. Each page is
+// passed through filter as it arrives. With --output jsonl and --all, pages
+// are written as soon as they are fetched and streamed is true; the caller
+// then has nothing left to render.
+func listPages[T any](ctx *cli.Context, fetch func(limit, offset int) ([]T, error), filter func([]T) ([]T, error)) (items []T, streamed bool, err error) {
+	limit, offset := ctx.Int("limit"), ctx.Int("offset")
+	if !ctx.Bool("all") && !ctx.Bool("count") {
+		page, err := fetch(limit, offset)
+		if err != nil {
+			return nil, false, err
+		}
+		items, err = filter(page)
+		return items, false, err
+	}
+	if limit <= 0 {
+		return nil, false, errors.UsageError("--all and --count require a positive --limit",
+			"--limit sets the page size used to walk the list")
+	}
+
+	var stream *render.JSONLWriter
+	if ctx.String("output") == render.FormatJSONL && !ctx.Bool("count") {
+		opts, err := outputOptions(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		stream = render.NewJSONLWriter(os.Stdout, opts.Fields)
+	}
+
+	var previous []T
+	for pages := 0; ; pages++ {
+		if pages == maxListPages {
+			return nil, false, errors.APIError(fmt.Sprintf("Stopped --all after %d pages", maxListPages),
+				"Use --limit and --offset to page manually")
+		}
+
+		page, err := fetch(limit, offset)
+		if err != nil {
+			return nil, false, err
+		}
+		// An API that ignores the offset returns the same page again
+		if len(page) > 0 && len(previous) > 0 && reflect.DeepEqual(page[0], previous[0]) {
+			return nil, false, errors.APIError("The API returned the same page twice while listing with --all",
+				"The server may not support offsets; use --limit to fetch one larger page")
+		}
+		previous = page
+		offset += len(page)
+
+		filtered, err := filter(page)
+		if err != nil {
+			return nil, false, err
+		}
+		if stream != nil {
+			if err := stream.Write(filtered); err != nil {
+				return nil, true, renderListError(err)
+			}
+		} else {
+			items = append(items, filtered...)
+		}
+
+		if len(page) < limit {
+			return items, stream != nil, nil
+		}
+	}
+}
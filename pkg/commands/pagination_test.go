@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+// listFlags are the paging flags of list commands
+func listFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.IntFlag{Name: "limit", Value: 2},
+		&cli.IntFlag{Name: "offset"},
+		&cli.StringFlag{Name: "output", Value: "table"},
+		allFlag(),
+		countFlag(),
+		fieldsFlag(),
+	}
+}
+
+func keepAll(items []int) ([]int, error) { return items, nil }
+
+func TestListPages_All(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5}
+	var offsets []int
+	fetch := func(limit, offset int) ([]int, error) {
+		offsets = append(offsets, offset)
+		return data[min(offset, len(data)):min(offset+limit, len(data))], nil
+	}
+
+	items, streamed, err := listPages(newTestContext(t, listFlags(), "--all", "--offset", "1"), fetch, keepAll)
+	require.NoError(t, err)
+	assert.False(t, streamed)
+	assert.Equal(t, []int{2, 3, 4, 5}, items)
+	assert.Equal(t, []int{1, 3, 5}, offsets)
+
+	offsets = nil
+	items, _, err = listPages(newTestContext(t, listFlags()), fetch, keepAll)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, items, "without --all one page is fetched")
+	assert.Equal(t, []int{0}, offsets)
+}
+
+func TestListPages_StopsWhenOffsetIsIgnored(t *testing.T) {
+	calls := 0
+	fetch := func(limit, offset int) ([]int, error) {
+		calls++
+		return []int{1, 2}, nil
+	}
+
+	_, _, err := listPages(newTestContext(t, listFlags(), "--all"), fetch, keepAll)
+	assert.Error(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestListPages_CountWalksEveryPage(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5}
+	fetch := func(limit, offset int) ([]int, error) {
+		return data[min(offset, len(data)):min(offset+limit, len(data))], nil
+	}
+	odd := func(items []int) ([]int, error) {
+		var kept []int
+		for _, item := range items {
+			if item%2 == 1 {
+				kept = append(kept, item)
+			}
+		}
+		return kept, nil
+	}
+
+	items, streamed, err := listPages(newTestContext(t, listFlags(), "--count", "--output", "jsonl"), fetch, odd)
+	require.NoError(t, err)
+	assert.False(t, streamed, "--count is not streamed")
+	assert.Equal(t, []int{1, 3, 5}, items)
+}
@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"time"
+
 	"github.com/urfave/cli/v2"
 )
 
@@ -78,6 +80,17 @@ func podcastGenerateCommand() *cli.Command {
 				Usage:   "Watch generation progress in real-time",
 				Value:   false,
 			},
+			&cli.DurationFlag{
+				Name:  "timeout",
+				Usage: "Maximum time to watch generation progress (with --watch, 0 for no limit)",
+				Value: 30 * time.Minute,
+			},
+			&cli.DurationFlag{
+				Name:  "interval",
+				Usage: "Polling interval for generation progress (with --watch)",
+				Value: 2 * time.Second,
+			},
+			stallTimeoutFlag(),
 		},
 		Action: handlePodcastGenerate,
 	}
@@ -98,6 +111,7 @@ func podcastEpisodesCommand() *cli.Command {
 			"  onb podcast episodes list                              # List all episodes\n" +
 			"  onb podcast episodes show abc123                       # Show episode details\n" +
 			"  onb podcast episodes download abc123                   # Download audio file\n" +
+			"  onb podcast episodes download --all --dir ./podcasts   # Download every episode\n" +
 			"  onb podcast episodes delete abc123 --force            # Delete episode",
 		Subcommands: []*cli.Command{
 			podcastEpisodesListCommand(),
@@ -158,6 +172,22 @@ func podcastEpisodesDownloadCommand() *cli.Command {
 				Aliases: []string{"o"},
 				Usage:   "Output file path (optional, defaults to episode ID)",
 			},
+			&cli.BoolFlag{
+				Name:  "all",
+				Usage: "Download every episode instead of a single one",
+				Value: false,
+			},
+			&cli.StringFlag{
+				Name:    "dir",
+				Aliases: []string{"d"},
+				Usage:   "Target directory for --all downloads",
+				Value:   ".",
+			},
+			&cli.BoolFlag{
+				Name:  "overwrite",
+				Usage: "Re-download episodes already present on disk (with --all)",
+				Value: false,
+			},
 		},
 		Action: handlePodcastEpisodesDownload,
 	}
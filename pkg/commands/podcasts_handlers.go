@@ -3,8 +3,10 @@ package commands
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
 	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
 	"github.com/samber/do/v2"
 	"github.com/urfave/cli/v2"
 )
@@ -39,6 +42,21 @@ func getPodcastServices(ctx *cli.Context) (*PodcastServices, error) {
 	}, nil
 }
 
+// knownPodcastLanguages is the set of ISO 639-1 language codes accepted for podcast generation
+var knownPodcastLanguages = map[string]bool{
+	"ar": true, "bg": true, "cs": true, "da": true, "de": true, "el": true,
+	"en": true, "es": true, "et": true, "fi": true, "fr": true, "he": true,
+	"hi": true, "hr": true, "hu": true, "id": true, "it": true, "ja": true,
+	"ko": true, "lt": true, "lv": true, "ms": true, "nl": true, "no": true,
+	"pl": true, "pt": true, "ro": true, "ru": true, "sk": true, "sl": true,
+	"sv": true, "ta": true, "th": true, "tr": true, "uk": true, "vi": true,
+	"zh": true,
+}
+
+// defaultPodcastVoices are accepted without a warning when the API does not
+// expose the available voices
+var defaultPodcastVoices = []string{"male", "female", "neutral"}
+
 // validatePodcastGenerateArgs validates podcast generation arguments
 func validatePodcastGenerateArgs(ctx *cli.Context) (*models.PodcastGenerationRequest, error) {
 	sources := ctx.StringSlice("sources")
@@ -57,6 +75,10 @@ func validatePodcastGenerateArgs(ctx *cli.Context) (*models.PodcastGenerationReq
 		return nil, errors.UsageError("Invalid language code",
 			"Language code must be 2 characters (e.g., en, es, fr)")
 	}
+	if language != "" && !knownPodcastLanguages[strings.ToLower(language)] {
+		return nil, errors.UsageError("Unsupported language code",
+			fmt.Sprintf("'%s' is not a known language code (e.g., en, es, fr, de)", language))
+	}
 
 	// Validate voice
 	voice := ctx.String("voice")
@@ -87,14 +109,55 @@ func validatePodcastGenerateArgs(ctx *cli.Context) (*models.PodcastGenerationReq
 	}, nil
 }
 
+// validatePodcastVoice cross-checks the requested voice and language against the voices
+// the text-to-speech model supports
+func validatePodcastVoice(ctx context.Context, services *PodcastServices, req *models.PodcastGenerationRequest) error {
+	voices, err := services.PodcastRepository.ListVoices(ctx)
+	if err != nil || len(voices.Voices) == 0 {
+		// The server may support more voices than the defaults; let it decide
+		services.Logger.Debug("Voice list unavailable, skipping voice validation", "error", err)
+		for _, voice := range defaultPodcastVoices {
+			if voice == req.Voice {
+				return nil
+			}
+		}
+		fmt.Fprintln(os.Stderr, style.Warn(fmt.Sprintf("Could not fetch the voice list to check voice '%s'; the server will validate it", req.Voice)))
+		return nil
+	}
+
+	validVoices := make([]string, 0, len(voices.Voices))
+	for _, voice := range voices.Voices {
+		validVoices = append(validVoices, voice.Name)
+	}
+
+	for _, voice := range voices.Voices {
+		if voice.Name != req.Voice {
+			continue
+		}
+		if req.Language == "" || len(voice.Languages) == 0 {
+			return nil
+		}
+		for _, language := range voice.Languages {
+			if strings.EqualFold(language, req.Language) {
+				return nil
+			}
+		}
+		return errors.UsageError("Unsupported language for voice",
+			fmt.Sprintf("Voice '%s' supports: %s", voice.Name, strings.Join(voice.Languages, ", ")))
+	}
+
+	return errors.UsageError("Unsupported voice",
+		fmt.Sprintf("Valid voices: %s", strings.Join(validVoices, ", ")))
+}
+
 // validateEpisodeArgs validates episode ID arguments
 func validateEpisodeArgs(ctx *cli.Context, requireEpisodeID bool) (string, error) {
 	if requireEpisodeID {
 		if ctx.NArg() < 1 {
-			return "", fmt.Errorf("❌ Error: Missing episode ID")
+			return "", errors.UsageError("Missing episode ID")
 		}
 		if ctx.NArg() > 1 {
-			return "", fmt.Errorf("❌ Error: Too many arguments. Expected only episode ID")
+			return "", errors.UsageError("Too many arguments. Expected only episode ID")
 		}
 	}
 
@@ -120,7 +183,7 @@ func formatDuration(seconds float64) string {
 
 // printPodcastSuccess prints standardized success messages for podcast operations
 func printPodcastSuccess(operation string, details interface{}) {
-	fmt.Printf("✅ Podcast %s successfully!\n", operation)
+	utils.Statusf(style.OK("Podcast %s successfully!\n"), operation)
 	switch v := details.(type) {
 	case string:
 		fmt.Printf("  %s: %s\n", "ID", v)
@@ -146,6 +209,10 @@ func handlePodcastGenerate(ctx *cli.Context) error {
 		return err
 	}
 
+	if err := validatePodcastVoice(ctx.Context, services, req); err != nil {
+		return err
+	}
+
 	services.Logger.Info("Generating podcast",
 		"query", req.Query,
 		"sources_count", len(req.SourceIDs),
@@ -156,7 +223,7 @@ func handlePodcastGenerate(ctx *cli.Context) error {
 
 	response, err := services.PodcastRepository.Generate(ctx.Context, req)
 	if err != nil {
-		return errors.APIError("Failed to generate podcast",
+		return errors.WrapAPIError(err, "Failed to generate podcast",
 			"Check content sources and API permissions")
 	}
 
@@ -164,56 +231,87 @@ func handlePodcastGenerate(ctx *cli.Context) error {
 
 	// Watch progress if requested
 	if ctx.Bool("watch") {
-		return watchPodcastGeneration(ctx.Context, services, response.JobID)
+		return watchPodcastGeneration(ctx.Context, services, response.JobID,
+			ctx.Duration("interval"), ctx.Duration("timeout"), newStallDetector(ctx.Duration("stall-timeout")))
 	}
 
-	fmt.Printf("💡 Use 'onb jobs status %s' to check progress\n", response.JobID)
+	utils.Statusf(style.Icon("💡", "Use 'onb jobs status %s' to check progress\n"), response.JobID)
 	return nil
 }
 
-// watchPodcastGeneration watches podcast generation progress
-func watchPodcastGeneration(ctx context.Context, services *PodcastServices, jobID string) error {
-	fmt.Printf("🔄 Watching podcast generation progress...\n")
-	fmt.Printf("Press Ctrl+C to stop watching\n\n")
+// watchPodcastGeneration watches podcast generation progress until the job
+// reaches a terminal state, the timeout elapses, progress stalls or the
+// context is cancelled
+func watchPodcastGeneration(ctx context.Context, services *PodcastServices, jobID string, interval, timeout time.Duration, stall *stallDetector) error {
+	stopPager()
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	utils.Status(style.Icon("🔄", "Watching podcast generation progress..."))
+	utils.Statusf("Press Ctrl+C to stop watching\n\n")
 
-	for i := 0; i < 30; i++ { // Watch for up to 30 iterations (~1 minute)
+	startTime := time.Now()
+	for {
 		jobStatus, err := services.PodcastRepository.GetJobStatus(ctx, jobID)
-		if err != nil {
-			fmt.Printf("\n❌ Error checking job status: %v\n", err)
+		if err != nil && ctx.Err() == nil {
+			fmt.Printf("\n"+style.Error("Error checking job status: %v\n"), err)
 			return err
 		}
 
-		// Display progress
-		progress := "N/A"
-		if jobStatus.Progress != nil {
-			progress = fmt.Sprintf("%.0f%%", *jobStatus.Progress*100)
-		}
-
-		fmt.Printf("\r📊 Status: %s | Progress: %s | Checking... (%d/30)",
-			jobStatus.Status, progress, i+1)
+		if err == nil {
+			// Display progress
+			progress := "N/A"
+			if jobStatus.Progress != nil {
+				progress = fmt.Sprintf("%.0f%%", *jobStatus.Progress*100)
+			}
 
-		// Check if job is completed or failed
-		if jobStatus.Status == "completed" {
-			fmt.Printf("\n✅ Podcast generation completed!\n")
-			if jobStatus.EpisodeID != nil {
-				fmt.Printf("   Episode ID: %s\n", *jobStatus.EpisodeID)
-				fmt.Printf("   💡 Use 'onb podcast episodes show %s' to view details\n", *jobStatus.EpisodeID)
+			utils.Statusf("\r"+style.Icon("📊", "Status: %s | Progress: %s | Elapsed: %s"),
+				jobStatus.Status, progress, time.Since(startTime).Round(time.Second))
+
+			// Check if job is completed or failed
+			if jobStatus.Status == "completed" {
+				utils.Status("\n" + style.OK("Podcast generation completed!"))
+				if jobStatus.EpisodeID != nil {
+					fmt.Printf("   Episode ID: %s\n", *jobStatus.EpisodeID)
+					utils.Statusf("   "+style.Icon("💡", "Use 'onb podcast episodes show %s' to view details\n"), *jobStatus.EpisodeID)
+				}
+				return nil
 			}
-			break
-		}
-		if jobStatus.Status == "failed" {
-			fmt.Printf("\n❌ Podcast generation failed!\n")
-			if jobStatus.Message != nil {
-				fmt.Printf("   Error: %s\n", *jobStatus.Message)
+			if jobStatus.Status == "failed" {
+				fmt.Println("\n" + style.Error("Podcast generation failed!"))
+				message := fmt.Sprintf("Check the job details with 'onb jobs status %s'", jobID)
+				if jobStatus.Message != nil {
+					fmt.Printf("   Error: %s\n", *jobStatus.Message)
+					message = *jobStatus.Message
+				}
+				return errors.APIError("Podcast generation failed", message)
+			}
+			if stall.Observe(jobProgressKey(jobStatus.Status, jobStatus.Progress, jobStatus.Message)) {
+				utils.Statusf("\n")
+				return errors.APIError(fmt.Sprintf("Podcast generation made no progress for %s", stall.Timeout()),
+					fmt.Sprintf("The job may be hung; cancel it with 'onb jobs cancel %s'", jobID),
+					"Raise --stall-timeout if generation legitimately pauses between updates")
 			}
-			break
 		}
 
-		time.Sleep(2 * time.Second)
+		select {
+		case <-ctx.Done():
+			utils.Statusf("\n")
+			if ctx.Err() == context.DeadlineExceeded {
+				return errors.NetworkError("Timed out watching podcast generation",
+					fmt.Sprintf("The job is still running. Use 'onb jobs status %s' to check progress", jobID))
+			}
+			utils.Statusf(style.Icon("🏁", "Watch stopped. Use 'onb jobs status %s' to check progress\n"), jobID)
+			return nil
+		case <-time.After(interval):
+		}
 	}
-
-	fmt.Printf("\n🏁 Watch completed\n")
-	return nil
 }
 
 // handlePodcastEpisodesList handles episodes list command
@@ -235,7 +333,7 @@ func handlePodcastEpisodesList(ctx *cli.Context) error {
 		// Use extended method with language filter (if implemented)
 		episodesList, err = services.PodcastRepository.ListEpisodes(ctx.Context, limit, offset)
 		if err != nil {
-			return errors.APIError("Failed to list podcast episodes",
+			return errors.WrapAPIError(err, "Failed to list podcast episodes",
 				"Check API connection and permissions")
 		}
 		// Filter by language manually
@@ -249,16 +347,16 @@ func handlePodcastEpisodesList(ctx *cli.Context) error {
 	} else {
 		episodesList, err = services.PodcastRepository.ListEpisodes(ctx.Context, limit, offset)
 		if err != nil {
-			return errors.APIError("Failed to list podcast episodes",
+			return errors.WrapAPIError(err, "Failed to list podcast episodes",
 				"Check API connection and permissions")
 		}
 	}
 
 	if len(episodesList.Episodes) == 0 {
 		if language != "" {
-			fmt.Printf("No podcast episodes found for language '%s'.\n", language)
+			utils.Statusf("No podcast episodes found for language '%s'.\n", language)
 		} else {
-			fmt.Println("No podcast episodes found.")
+			utils.Status("No podcast episodes found.")
 		}
 		return nil
 	}
@@ -279,7 +377,7 @@ func handlePodcastEpisodesList(ctx *cli.Context) error {
 
 	w.Flush()
 
-	fmt.Printf("\nShowing %d episodes (Total: %d)\n", len(episodesList.Episodes), episodesList.Total)
+	utils.Statusf("\nShowing %d episodes (Total: %d)\n", len(episodesList.Episodes), episodesList.Total)
 	return nil
 }
 
@@ -299,7 +397,7 @@ func handlePodcastEpisodesShow(ctx *cli.Context) error {
 
 	episode, err := services.PodcastRepository.GetEpisode(ctx.Context, episodeID)
 	if err != nil {
-		return errors.APIError("Failed to get episode details",
+		return errors.WrapAPIError(err, "Failed to get episode details",
 			"Check episode ID and API permissions")
 	}
 
@@ -321,8 +419,132 @@ func handlePodcastEpisodesShow(ctx *cli.Context) error {
 	return nil
 }
 
+// episodeFileNameReplacer strips characters that are unsafe in file names
+var episodeFileNameReplacer = strings.NewReplacer(
+	"/", "_", "\\", "_", ":", "_", "*", "_", "?", "_",
+	"\"", "_", "<", "_", ">", "_", "|", "_",
+)
+
+// episodeFileName builds the archive file name for an episode as <title>-<id>.mp3
+func episodeFileName(episode models.PodcastEpisodeResponse) string {
+	title := strings.TrimSpace(episodeFileNameReplacer.Replace(episode.Title))
+	if title == "" {
+		return fmt.Sprintf("%s.mp3", episode.ID)
+	}
+	return fmt.Sprintf("%s-%s.mp3", title, episode.ID)
+}
+
+// downloadEpisodeToFile downloads the audio of an episode and writes it to outputPath
+func downloadEpisodeToFile(ctx context.Context, services *PodcastServices, episodeID, outputPath string) (int64, error) {
+	audioReader, err := services.PodcastRepository.DownloadEpisodeAudio(ctx, episodeID)
+	if err != nil {
+		return 0, err
+	}
+	defer audioReader.Close()
+
+	// Written to a temporary file first: a failed download must not leave a
+	// file that later runs skip as already downloaded
+	var written int64
+	err = saveDownload(outputPath, true, func(outputFile io.Writer) error {
+		progress := utils.NewProgressWriter(outputFile, 0, "   "+style.Icon("📥", "")+filepath.Base(outputPath))
+		defer progress.Finish()
+
+		var err error
+		written, err = io.Copy(progress, audioReader)
+		return err
+	})
+	return written, err
+}
+
+// handlePodcastEpisodesDownloadAll downloads every episode into a directory
+func handlePodcastEpisodesDownloadAll(ctx *cli.Context, services *PodcastServices) error {
+	dir := ctx.String("dir")
+	overwrite := ctx.Bool("overwrite")
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return errors.ConfigError("Failed to create output directory",
+			fmt.Sprintf("Directory: %s, Error: %v", dir, err))
+	}
+
+	// Collect all episodes page by page
+	const pageSize = 100
+	var episodes []models.PodcastEpisodeResponse
+	for offset := 0; ; offset += pageSize {
+		page, err := services.PodcastRepository.ListEpisodes(ctx.Context, pageSize, offset)
+		if err != nil {
+			return errors.WrapAPIError(err, "Failed to list podcast episodes",
+				"Check API connection and permissions")
+		}
+		episodes = append(episodes, page.Episodes...)
+		if len(page.Episodes) < pageSize || (page.Total > 0 && len(episodes) >= page.Total) {
+			break
+		}
+	}
+
+	if len(episodes) == 0 {
+		utils.Status("No podcast episodes found.")
+		return nil
+	}
+
+	services.Logger.Info("Downloading all podcast episodes", "count", len(episodes), "dir", dir)
+	utils.Statusf(style.Icon("📥", "Downloading %d podcast episodes to %s\n"), len(episodes), dir)
+
+	downloaded, skipped, failed := 0, 0, 0
+	var totalBytes int64
+
+	for _, episode := range episodes {
+		if episode.AudioURL == "" {
+			utils.Statusf("   "+style.Icon("⏭️", "%s: no audio available\n"), episode.Title)
+			skipped++
+			continue
+		}
+
+		outputPath := filepath.Join(dir, episodeFileName(episode))
+		if !overwrite {
+			if _, err := os.Stat(outputPath); err == nil {
+				utils.Statusf("   "+style.Icon("⏭️", "%s: already exists\n"), outputPath)
+				skipped++
+				continue
+			}
+		}
+
+		written, err := downloadEpisodeToFile(ctx.Context, services, episode.ID, outputPath)
+		if err != nil {
+			fmt.Printf("   "+style.Error("%s: %v\n"), episode.Title, err)
+			failed++
+			continue
+		}
+
+		utils.Statusf("   "+style.OK("%s (%.1f MB)\n"), outputPath, float64(written)/1024/1024)
+		downloaded++
+		totalBytes += written
+	}
+
+	utils.Statusf("\n"+style.Icon("📊", "Download summary: %d downloaded, %d skipped, %d failed (%.1f MB total)\n"),
+		downloaded, skipped, failed, float64(totalBytes)/1024/1024)
+
+	if failed > 0 {
+		return errors.NetworkError(fmt.Sprintf("Failed to download %d episodes", failed),
+			"Re-run the command to retry the failed episodes")
+	}
+
+	return nil
+}
+
 // handlePodcastEpisodesDownload handles episode audio download
 func handlePodcastEpisodesDownload(ctx *cli.Context) error {
+	if ctx.Bool("all") {
+		if ctx.NArg() > 0 {
+			return errors.UsageError("Episode ID cannot be combined with --all",
+				"Usage: onb podcast episodes download --all [--dir <dir>] [--overwrite]")
+		}
+		services, err := getPodcastServices(ctx)
+		if err != nil {
+			return err
+		}
+		return handlePodcastEpisodesDownloadAll(ctx, services)
+	}
+
 	episodeID, err := validateEpisodeArgs(ctx, true)
 	if err != nil {
 		return err
@@ -348,12 +570,12 @@ func handlePodcastEpisodesDownload(ctx *cli.Context) error {
 	services.Logger.Info("Downloading podcast episode audio",
 		"episode_id", episodeID, "output_path", outputPath)
 
-	fmt.Printf("📥 Downloading podcast episode: %s\n", episodeID)
-	fmt.Printf("   Output: %s\n", outputPath)
+	utils.Statusf(style.Icon("📥", "Downloading podcast episode: %s\n"), episodeID)
+	utils.Statusf("   Output: %s\n", outputPath)
 
 	audioReader, err := services.PodcastRepository.DownloadEpisodeAudio(ctx.Context, episodeID)
 	if err != nil {
-		return errors.APIError("Failed to download episode audio",
+		return errors.WrapAPIError(err, "Failed to download episode audio",
 			"Check episode ID and API permissions")
 	}
 	defer audioReader.Close()
@@ -367,15 +589,17 @@ func handlePodcastEpisodesDownload(ctx *cli.Context) error {
 	defer outputFile.Close()
 
 	// Copy audio data to file
-	written, err := outputFile.ReadFrom(audioReader)
+	progress := utils.NewProgressWriter(outputFile, 0, style.Icon("📥", "Downloading"))
+	written, err := io.Copy(progress, audioReader)
+	progress.Finish()
 	if err != nil {
 		return errors.NetworkError("Failed to save audio file",
 			fmt.Sprintf("Error: %v", err))
 	}
 
-	fmt.Printf("✅ Download completed!\n")
-	fmt.Printf("   File size: %.1f MB\n", float64(written)/1024/1024)
-	fmt.Printf("   Location: %s\n", outputPath)
+	utils.Status(style.OK("Download completed!"))
+	utils.Statusf("   File size: %.1f MB\n", float64(written)/1024/1024)
+	utils.Statusf("   Location: %s\n", outputPath)
 
 	return nil
 }
@@ -395,20 +619,21 @@ func handlePodcastEpisodesDelete(ctx *cli.Context) error {
 	// Get episode details first for confirmation
 	episode, err := services.PodcastRepository.GetEpisode(ctx.Context, episodeID)
 	if err != nil {
-		return errors.APIError("Failed to get episode details",
+		return errors.WrapAPIError(err, "Failed to get episode details",
 			"Check episode ID and API permissions")
 	}
 
 	// Confirm deletion unless force flag is used
 	if !ctx.Bool("force") {
-		fmt.Printf("⚠️  Are you sure you want to delete episode '%s'? (%.0fs)\n",
+		fmt.Printf(style.Warn("Are you sure you want to delete episode '%s'? (%.0fs)\n"),
 			episode.Title, episode.Duration)
 		fmt.Printf("   This will permanently delete the episode and its audio file.\n")
-		fmt.Printf("   Continue? [y/N]: ")
-		var response string
-		fmt.Scanln(&response)
-		if response != "y" && response != "Y" {
-			fmt.Println("❌ Deletion cancelled")
+		ok, err := confirmDestructive(ctx, "   Continue?")
+		if err != nil {
+			return err
+		}
+		if !ok {
+			fmt.Println(style.Error("Deletion cancelled"))
 			return nil
 		}
 	}
@@ -417,10 +642,10 @@ func handlePodcastEpisodesDelete(ctx *cli.Context) error {
 
 	err = services.PodcastRepository.DeleteEpisode(ctx.Context, episodeID)
 	if err != nil {
-		return errors.APIError("Failed to delete episode",
+		return errors.WrapAPIError(err, "Failed to delete episode",
 			"Check episode ID and permissions")
 	}
 
-	fmt.Printf("✅ Episode '%s' deleted successfully!\n", episode.Title)
+	utils.Statusf(style.OK("Episode '%s' deleted successfully!\n"), episode.Title)
 	return nil
 }
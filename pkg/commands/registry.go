@@ -16,6 +16,8 @@ func RegisterCommands() []*cli.Command {
 		PodcastCommand(),
 		SettingsCommand(),
 		ChatCommand(),
+		ContextCommand(),
+		HealthCommand(),
 		// TODO: Add more commands as they are implemented
 	}
 }
\ No newline at end of file
@@ -15,10 +15,16 @@ func SearchCommand() *cli.Command {
 			"Examples:\n" +
 			"  onb search query --query \"machine learning\"           # Vector search\n" +
 			"  onb search query --query \"python\" --type text       # Text search\n" +
+			"  onb search query --query \"python\" --notebook Work   # Search one notebook\n" +
+			"  onb search save --query \"machine learning\" -l 20 ml  # Save a search\n" +
+			"  onb search run ml                                    # Run a saved search\n" +
 			"  onb search ask --question \"What is AI?\"             # Streaming AI response\n" +
 			"  onb search ask-simple --question \"Explain ML\"       # Simple AI response",
 		Subcommands: []*cli.Command{
 			searchQueryCommand(),
+			searchSaveCommand(),
+			searchRunCommand(),
+			searchSavedCommand(),
 			searchAskCommand(),
 			searchAskSimpleCommand(),
 		},
@@ -30,42 +36,120 @@ func searchQueryCommand() *cli.Command {
 	return &cli.Command{
 		Name:  "query",
 		Usage: "Search knowledge base",
-		Flags: []cli.Flag{
-			&cli.StringFlag{
-				Name:     "query",
-				Aliases:  []string{"q"},
-				Usage:    "Search query",
-				Required: true,
-			},
-			&cli.StringFlag{
-				Name:    "type",
-				Aliases: []string{"t"},
-				Usage:   "Search type (vector, text)",
-				Value:   "vector",
-			},
-			&cli.IntFlag{
-				Name:    "limit",
-				Aliases: []string{"l"},
-				Usage:   "Result limit",
-				Value:   10,
-			},
-			&cli.StringFlag{
-				Name:    "sources",
-				Aliases: []string{"s"},
-				Usage:   "Comma-separated source IDs to search",
-			},
-			&cli.StringFlag{
-				Name:    "notes",
-				Aliases: []string{"n"},
-				Usage:   "Comma-separated note IDs to search",
+		Description: "Search sources and notes by vector similarity or text.\n\n" +
+			"The search API is not scoped to notebooks, so --notebook fetches more\n" +
+			"results and keeps those whose source or note belongs to the notebook.\n" +
+			"Results that cannot be traced to a source or note are left out with a\n" +
+			"warning.",
+		Flags:  searchQueryFlags(),
+		Action: handleSearchQuery,
+	}
+}
+
+// searchQueryFlags are the flags of 'search query', which also define a
+// saved search
+func searchQueryFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:     "query",
+			Aliases:  []string{"q"},
+			Usage:    "Search query",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:    "type",
+			Aliases: []string{"t"},
+			Usage:   "Search type (vector, text)",
+			Value:   "vector",
+		},
+		&cli.IntFlag{
+			Name:    "limit",
+			Aliases: []string{"l"},
+			Usage:   "Result limit",
+			Value:   10,
+		},
+		&cli.StringFlag{
+			Name:    "sources",
+			Aliases: []string{"s"},
+			Usage:   "Comma-separated source IDs to search",
+		},
+		&cli.StringFlag{
+			Name:    "notes",
+			Aliases: []string{"n"},
+			Usage:   "Comma-separated note IDs to search",
+		},
+		&cli.Float64Flag{
+			Name:    "minimum-score",
+			Aliases: []string{"m", "min-score"},
+			Usage:   "Minimum similarity score for vector search",
+		},
+		&cli.StringFlag{
+			Name:  "notebook",
+			Usage: "Only return results from this notebook (ID or name)",
+		},
+		notebookIDFlag(),
+		outputFileFlag(),
+	}
+}
+
+// outputFileFlag returns the --output-file flag of the search and ask commands
+func outputFileFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:      "output-file",
+		Usage:     "Also write the full response as JSON to this file (with --quiet, only write it)",
+		TakesFile: true,
+	}
+}
+
+// searchSaveCommand stores a search under a name
+func searchSaveCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "save",
+		Usage:     "Save a search query under a name",
+		ArgsUsage: "<name>",
+		Description: "Store a search with its flags in the config directory so it can be\n" +
+			"repeated with 'onb search run <name>'.",
+		Flags: append(searchQueryFlags(), &cli.BoolFlag{
+			Name:    "force",
+			Aliases: []string{"f"},
+			Usage:   "Replace an existing saved search of the same name",
+		}),
+		Action: handleSearchSave,
+	}
+}
+
+// searchRunCommand runs a saved search
+func searchRunCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "run",
+		Usage:     "Run a saved search",
+		ArgsUsage: "<name>",
+		Flags:     []cli.Flag{outputFileFlag()},
+		Action:    handleSearchRun,
+	}
+}
+
+// searchSavedCommand manages saved searches
+func searchSavedCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "saved",
+		Usage: "List and remove saved searches",
+		Subcommands: []*cli.Command{
+			{
+				Name:   "list",
+				Usage:  "List saved searches",
+				Flags:  []cli.Flag{fieldsFlag(), noHeaderFlag()},
+				Action: handleSearchSavedList,
 			},
-			&cli.Float64Flag{
-				Name:    "minimum-score",
-				Aliases: []string{"m"},
-				Usage:   "Minimum similarity score for vector search",
+			{
+				Name:      "remove",
+				Aliases:   []string{"rm"},
+				Usage:     "Remove a saved search",
+				ArgsUsage: "<name>",
+				Action:    handleSearchSavedRemove,
 			},
 		},
-		Action: handleSearchQuery,
+		Action: handleSearchSavedList,
 	}
 }
 
@@ -90,18 +174,23 @@ func searchAskCommand() *cli.Command {
 			&cli.StringFlag{
 				Name:    "answer-model",
 				Aliases: []string{"a"},
-				Usage:   "Model for answer generation",
+				Usage:   "Model ID or name for answer generation (default: the default chat model)",
 			},
 			&cli.StringFlag{
 				Name:    "strategy-model",
 				Aliases: []string{"S"},
-				Usage:   "Model for search strategy",
+				Usage:   "Model ID or name for search strategy (default: the default chat model)",
 			},
 			&cli.StringFlag{
 				Name:    "final-model",
-				Aliases: []string{"f"},
-				Usage:   "Model for final response",
+				Aliases: []string{"f", "final-answer-model"},
+				Usage:   "Model ID or name for final response (default: the default chat model)",
+			},
+			&cli.IntFlag{
+				Name:  "token-limit",
+				Usage: "Model context window in tokens; warns when the reported context approaches it",
 			},
+			outputFileFlag(),
 		},
 		Action: handleSearchAsk,
 	}
@@ -119,6 +208,7 @@ func searchAskSimpleCommand() *cli.Command {
 				Usage:    "Question to ask",
 				Required: true,
 			},
+			outputFileFlag(),
 		},
 		Action: handleSearchAskSimple,
 	}
@@ -1,25 +1,35 @@
 package commands
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/denkhaus/open-notebook-cli/pkg/config"
 	"github.com/denkhaus/open-notebook-cli/pkg/errors"
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
 	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/render"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
 	"github.com/samber/do/v2"
 	"github.com/urfave/cli/v2"
 )
 
 // SearchServices holds all the services needed for search commands
 type SearchServices struct {
-	SearchService shared.SearchService
-	Config        config.Service
-	Logger        shared.Logger
+	SearchService   shared.SearchService
+	ModelService    shared.ModelService
+	NotebookService shared.NotebookService
+	SourceService   shared.SourceService
+	NoteRepo        shared.NoteRepository
+	Config          config.Service
+	Logger          shared.Logger
 }
 
 // getSearchServices retrieves all required services via dependency injection
@@ -31,12 +41,115 @@ func getSearchServices(ctx *cli.Context) (*SearchServices, error) {
 	}
 
 	return &SearchServices{
-		SearchService: do.MustInvoke[shared.SearchService](injector),
-		Config:        do.MustInvoke[config.Service](injector),
-		Logger:        do.MustInvoke[shared.Logger](injector),
+		SearchService:   do.MustInvoke[shared.SearchService](injector),
+		ModelService:    do.MustInvoke[shared.ModelService](injector),
+		NotebookService: do.MustInvoke[shared.NotebookService](injector),
+		SourceService:   do.MustInvoke[shared.SourceService](injector),
+		NoteRepo:        do.MustInvoke[shared.NoteRepository](injector),
+		Config:          do.MustInvoke[config.Service](injector),
+		Logger:          do.MustInvoke[shared.Logger](injector),
 	}, nil
 }
 
+// getSavedSearchStore retrieves the saved search store via dependency injection.
+// It needs no API services, so saved searches can be managed offline.
+func getSavedSearchStore(ctx *cli.Context) (shared.SavedSearchStore, error) {
+	injector, ok := ctx.App.Metadata["injector"].(do.Injector)
+	if !ok {
+		return nil, errors.UsageError("Dependency injector not found",
+			"This command requires proper DI setup")
+	}
+	return do.MustInvoke[shared.SavedSearchStore](injector), nil
+}
+
+// resolveAskModels checks the models of options against the registered
+// language models, accepting IDs or names, and fills the unset ones with the
+// default chat model. The API needs all three and only reports an opaque
+// error for unknown models.
+func resolveAskModels(ctx *cli.Context, services *SearchServices, options *models.AskOptions) (*models.AskOptions, error) {
+	registered, err := services.ModelService.List(ctx.Context)
+	if err != nil {
+		return nil, errors.WrapAPIError(err, "Failed to list models",
+			"Check API connection and permissions")
+	}
+
+	var language []*models.Model
+	for _, model := range registered {
+		if model.Type == models.ModelTypeLanguage {
+			language = append(language, model)
+		}
+	}
+
+	resolve := func(flag, value string) (string, error) {
+		var matches []string
+		for _, model := range language {
+			if model.ID == value {
+				return model.ID, nil
+			}
+			if strings.EqualFold(model.Name, value) {
+				matches = append(matches, model.ID)
+			}
+		}
+		if len(matches) == 1 {
+			return matches[0], nil
+		}
+
+		valid := make([]string, len(language))
+		for i, model := range language {
+			valid[i] = fmt.Sprintf("%s (%s)", model.Name, model.ID)
+		}
+		message := fmt.Sprintf("Unknown language model '%s' for --%s", value, flag)
+		if len(matches) > 1 {
+			message = fmt.Sprintf("Model name '%s' for --%s is ambiguous", value, flag)
+		}
+		if len(valid) == 0 {
+			return "", errors.ValidationError(message,
+				"No language models are registered; add one with 'onb models add --type language'")
+		}
+		return "", errors.ValidationError(message,
+			"Valid language models: "+strings.Join(valid, ", "),
+			"Pass the model ID or name")
+	}
+
+	var defaultModel string
+	resolved := &models.AskOptions{}
+	for _, field := range []struct {
+		flag   string
+		value  string
+		target *string
+	}{
+		{"strategy-model", options.StrategyModel, &resolved.StrategyModel},
+		{"answer-model", options.AnswerModel, &resolved.AnswerModel},
+		{"final-model", options.FinalAnswerModel, &resolved.FinalAnswerModel},
+	} {
+		if field.value != "" {
+			if *field.target, err = resolve(field.flag, field.value); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if defaultModel == "" {
+			defaults, err := services.ModelService.GetDefaults(ctx.Context)
+			if err != nil {
+				return nil, errors.WrapAPIError(err, "Failed to get default models",
+					"Pass --strategy-model, --answer-model and --final-model explicitly")
+			}
+			if defaults.DefaultChatModel == nil || *defaults.DefaultChatModel == "" {
+				return nil, errors.ValidationError(fmt.Sprintf("No --%s given and no default chat model configured", field.flag),
+					"Set one with 'onb models defaults set --chat <model-id>'",
+					"Or pass --strategy-model, --answer-model and --final-model")
+			}
+			defaultModel = *defaults.DefaultChatModel
+		}
+		*field.target = defaultModel
+	}
+
+	services.Logger.Debug("Resolved ask models", "strategy", resolved.StrategyModel,
+		"answer", resolved.AnswerModel, "final", resolved.FinalAnswerModel)
+	return resolved, nil
+}
+
 // validateSearchArgs validates common argument patterns for search commands
 func validateSearchArgs(ctx *cli.Context, requireQuery bool) (string, error) {
 	query := ctx.String("query")
@@ -50,7 +163,7 @@ func validateSearchArgs(ctx *cli.Context, requireQuery bool) (string, error) {
 // printSearchResults prints search results in a formatted table
 func printSearchResults(results []models.SearchResult, searchType string) {
 	if len(results) == 0 {
-		fmt.Println("No results found.")
+		utils.Status("No results found.")
 		return
 	}
 
@@ -67,7 +180,71 @@ func printSearchResults(results []models.SearchResult, searchType string) {
 	}
 
 	w.Flush()
-	fmt.Printf("\nFound %d results (%s search)\n", len(results), searchType)
+	utils.Statusf("\nFound %d results (%s search)\n", len(results), searchType)
+}
+
+// notebookSearchOverfetch multiplies the search limit when results are
+// filtered by notebook, so that enough results remain after filtering
+const notebookSearchOverfetch = 5
+
+// notebookNotesPageSize is the page size used to list the notes of a notebook
+const notebookNotesPageSize = 100
+
+// notebookMembers returns the IDs of the sources and notes of a notebook
+func notebookMembers(ctx context.Context, services *SearchServices, notebookID string) (map[string]bool, error) {
+	sources, err := services.SourceService.ListAllByNotebook(ctx, notebookID)
+	if err != nil {
+		return nil, err
+	}
+	members := make(map[string]bool, len(sources))
+	for _, source := range sources {
+		members[utils.SafeDereferenceString(source.ID)] = true
+	}
+
+	notes, err := listNotebookNotes(ctx, services.NoteRepo, notebookID)
+	if err != nil {
+		return nil, err
+	}
+	for _, note := range notes {
+		members[utils.SafeDereferenceString(note.ID)] = true
+	}
+	return members, nil
+}
+
+// listNotebookNotes returns all notes of a notebook, page by page
+func listNotebookNotes(ctx context.Context, repo shared.NoteRepository, notebookID string) ([]*models.Note, error) {
+	var all []*models.Note
+	for offset := 0; ; offset += notebookNotesPageSize {
+		notes, err := repo.List(ctx, notebookID, notebookNotesPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, notes...)
+		if len(notes) < notebookNotesPageSize {
+			return all, nil
+		}
+	}
+}
+
+// filterSearchResults keeps the results whose source or note is in members.
+// A result belongs to its parent record, or to itself when it is a source or
+// note without a parent; results with neither are counted as unresolved.
+func filterSearchResults(results []models.SearchResult, members map[string]bool) ([]models.SearchResult, int) {
+	var kept []models.SearchResult
+	unresolved := 0
+	for _, result := range results {
+		owner := result.ParentID
+		if owner == "" && (strings.HasPrefix(result.ID, "source:") || strings.HasPrefix(result.ID, "note:")) {
+			owner = result.ID
+		}
+		switch {
+		case owner == "":
+			unresolved++
+		case members[owner]:
+			kept = append(kept, result)
+		}
+	}
+	return kept, unresolved
 }
 
 // Handler functions with proper separation of concerns
@@ -79,16 +256,29 @@ func handleSearchQuery(ctx *cli.Context) error {
 		return err
 	}
 
-	query, err := validateSearchArgs(ctx, true)
+	request, err := searchRequestFromFlags(ctx)
+	if err != nil {
+		return err
+	}
+	notebookID, err := resolveNotebookFlag(ctx, services.NotebookService)
 	if err != nil {
 		return err
 	}
 
+	return runSearch(ctx, services, request, notebookID)
+}
+
+// searchRequestFromFlags builds a search request from the flags of 'search
+// query' and 'search save'
+func searchRequestFromFlags(ctx *cli.Context) (*models.SearchRequest, error) {
+	query, err := validateSearchArgs(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
 	searchType := ctx.String("type")
-	limit := ctx.Int("limit")
 	sources := ctx.String("sources")
 	notes := ctx.String("notes")
-	minScore := ctx.Float64("minimum-score")
 
 	// Validate search type
 	validTypes := map[string]bool{
@@ -96,12 +286,10 @@ func handleSearchQuery(ctx *cli.Context) error {
 		"text":   true,
 	}
 	if !validTypes[searchType] {
-		return errors.UsageError("Invalid search type",
+		return nil, errors.UsageError("Invalid search type",
 			"Supported types are: vector, text")
 	}
 
-	services.Logger.Info("Performing search query", "query", query, "type", searchType, "limit", limit)
-
 	// Parse sources and notes if provided
 	searchSources := true
 	searchNotes := true
@@ -111,21 +299,234 @@ func handleSearchQuery(ctx *cli.Context) error {
 		searchNotes = notes != ""
 	}
 
-	options := &models.SearchOptions{
-		Type:          searchType,
-		Limit:         limit,
-		MinimumScore:  minScore,
+	return &models.SearchRequest{
+		Query:         query,
+		Type:          models.SearchType(searchType),
+		Limit:         ctx.Int("limit"),
 		SearchSources: searchSources,
 		SearchNotes:   searchNotes,
+		MinimumScore:  ctx.Float64("minimum-score"),
+	}, nil
+}
+
+// runSearch performs request and prints the results. With a notebookID the
+// results are filtered to that notebook.
+func runSearch(ctx *cli.Context, services *SearchServices, request *models.SearchRequest, notebookID string) error {
+	query, limit := request.Query, request.Limit
+	services.Logger.Info("Performing search query", "query", query, "type", request.Type, "limit", limit)
+
+	options := &models.SearchOptions{
+		Type:          string(request.Type),
+		Limit:         limit,
+		MinimumScore:  request.MinimumScore,
+		SearchSources: request.SearchSources,
+		SearchNotes:   request.SearchNotes,
+	}
+	if notebookID != "" {
+		// Results outside the notebook are dropped after the search
+		options.Limit = limit * notebookSearchOverfetch
 	}
 
 	response, err := services.SearchService.Search(ctx.Context, query, options)
 	if err != nil {
-		return errors.APIError("Failed to perform search",
+		return errors.WrapAPIError(err, "Failed to perform search",
 			"Check query parameters and API permissions")
 	}
 
-	printSearchResults(response.Results, response.SearchType)
+	if notebookID != "" {
+		services.Logger.Debug("Filtering search results by notebook client-side", "notebook_id", notebookID)
+		members, err := notebookMembers(ctx.Context, services, notebookID)
+		if err != nil {
+			return errors.WrapAPIError(err, "Failed to list notebook sources and notes",
+				"Check the notebook ID and API connection")
+		}
+		results, unresolved := filterSearchResults(response.Results, members)
+		if unresolved > 0 {
+			fmt.Fprintln(os.Stderr, style.Warn(fmt.Sprintf(
+				"%d results could not be traced to a source or note and were left out", unresolved)))
+		}
+		if len(results) > limit {
+			results = results[:limit]
+		}
+		response.Results = results
+	}
+
+	if err := writeOutputFile(ctx, response); err != nil {
+		return err
+	}
+	if printsResults(ctx) {
+		printSearchResults(response.Results, response.SearchType)
+	}
+	return nil
+}
+
+// writeOutputFile writes v as indented JSON to the --output-file path, if set
+func writeOutputFile(ctx *cli.Context, v any) error {
+	outputPath := ctx.String("output-file")
+	if outputPath == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return errors.ValidationError("Failed to format results as JSON",
+			fmt.Sprintf("JSON marshaling error: %v", err))
+	}
+	if err := os.WriteFile(outputPath, append(data, '\n'), 0644); err != nil {
+		return errors.ValidationError("Failed to write output file",
+			fmt.Sprintf("Check that '%s' is writable", outputPath))
+	}
+
+	utils.Statusf(style.OK("Wrote results to %s\n"), outputPath)
+	return nil
+}
+
+// printsResults reports whether results go to the terminal: with --quiet and
+// --output-file they are only written to the file
+func printsResults(ctx *cli.Context) bool {
+	return ctx.String("output-file") == "" || !utils.IsQuiet()
+}
+
+// savedSearchName returns the single name argument of the saved search commands
+func savedSearchName(ctx *cli.Context) (string, error) {
+	if ctx.NArg() != 1 || strings.TrimSpace(ctx.Args().First()) == "" {
+		return "", errors.UsageError("Expected exactly one saved search name",
+			fmt.Sprintf("Usage: onb search %s <name>", ctx.Command.Name))
+	}
+	return strings.TrimSpace(ctx.Args().First()), nil
+}
+
+// handleSearchSave handles the search save command
+func handleSearchSave(ctx *cli.Context) error {
+	name, err := savedSearchName(ctx)
+	if err != nil {
+		return err
+	}
+	request, err := searchRequestFromFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	notebook := ctx.String("notebook")
+	if id := ctx.String("notebook-id"); id != "" {
+		if notebook != "" {
+			return errors.UsageError("--notebook and --notebook-id cannot be combined")
+		}
+		notebook = id
+	}
+
+	store, err := getSavedSearchStore(ctx)
+	if err != nil {
+		return err
+	}
+	existing, err := store.Get(name)
+	if err != nil {
+		return errors.ValidationError("Failed to read saved searches", err.Error())
+	}
+	if existing != nil && !ctx.Bool("force") {
+		return errors.UsageError(fmt.Sprintf("A saved search named '%s' already exists", name),
+			"Use --force to replace it")
+	}
+
+	search := models.SavedSearch{
+		Name:     name,
+		Request:  *request,
+		Notebook: notebook,
+		Created:  time.Now(),
+	}
+	if err := store.Save(search); err != nil {
+		return errors.ValidationError("Failed to save search", err.Error())
+	}
+
+	utils.Status(style.OK(fmt.Sprintf("Saved search '%s'", name)))
+	utils.Statusf("   Run it with: onb search run %s\n", name)
+	return nil
+}
+
+// handleSearchRun handles the search run command
+func handleSearchRun(ctx *cli.Context) error {
+	name, err := savedSearchName(ctx)
+	if err != nil {
+		return err
+	}
+
+	store, err := getSavedSearchStore(ctx)
+	if err != nil {
+		return err
+	}
+	search, err := store.Get(name)
+	if err != nil {
+		return errors.ValidationError("Failed to read saved searches", err.Error())
+	}
+	if search == nil {
+		return errors.NotFoundError(fmt.Sprintf("Saved search '%s' not found", name),
+			"List saved searches with 'onb search saved list'")
+	}
+
+	services, err := getSearchServices(ctx)
+	if err != nil {
+		return err
+	}
+	notebookID, err := resolveNotebookID(ctx.Context, services.NotebookService, search.Notebook)
+	if err != nil {
+		return err
+	}
+
+	return runSearch(ctx, services, &search.Request, notebookID)
+}
+
+// handleSearchSavedList handles the search saved list command
+func handleSearchSavedList(ctx *cli.Context) error {
+	store, err := getSavedSearchStore(ctx)
+	if err != nil {
+		return err
+	}
+	searches, err := store.List()
+	if err != nil {
+		return errors.ValidationError("Failed to read saved searches", err.Error())
+	}
+
+	if len(searches) == 0 && !isStructuredOutput(ctx) {
+		utils.Status("No saved searches. Create one with 'onb search save <name> --query ...'")
+		return nil
+	}
+
+	table := render.NewTable("NAME", "QUERY", "TYPE", "LIMIT", "MIN SCORE", "NOTEBOOK", "CREATED")
+	for _, search := range searches {
+		table.AddRow(
+			search.Name,
+			search.Request.Query,
+			string(search.Request.Type),
+			strconv.Itoa(search.Request.Limit),
+			strconv.FormatFloat(search.Request.MinimumScore, 'g', -1, 64),
+			search.Notebook,
+			utils.FormatTimestamp(search.Created.Format(time.RFC3339)),
+		)
+	}
+	return renderList(ctx, searches, table)
+}
+
+// handleSearchSavedRemove handles the search saved remove command
+func handleSearchSavedRemove(ctx *cli.Context) error {
+	name, err := savedSearchName(ctx)
+	if err != nil {
+		return err
+	}
+
+	store, err := getSavedSearchStore(ctx)
+	if err != nil {
+		return err
+	}
+	removed, err := store.Remove(name)
+	if err != nil {
+		return errors.ValidationError("Failed to remove saved search", err.Error())
+	}
+	if !removed {
+		return errors.NotFoundError(fmt.Sprintf("Saved search '%s' not found", name),
+			"List saved searches with 'onb search saved list'")
+	}
+
+	utils.Status(style.OK(fmt.Sprintf("Removed saved search '%s'", name)))
 	return nil
 }
 
@@ -138,62 +539,88 @@ func handleSearchAsk(ctx *cli.Context) error {
 
 	question := ctx.String("question")
 	streaming := ctx.Bool("streaming")
-	answerModel := ctx.String("answer-model")
-	strategyModel := ctx.String("strategy-model")
-	finalModel := ctx.String("final-model")
 
 	if question == "" {
 		return errors.UsageError("Question is required",
 			"Use --question flag to specify the question")
 	}
 
-	services.Logger.Info("Starting AI ask", "question", question, "streaming", streaming)
-
-	options := &models.AskOptions{
-		StrategyModel:    strategyModel,
-		AnswerModel:      answerModel,
-		FinalAnswerModel: finalModel,
+	options, err := resolveAskModels(ctx, services, &models.AskOptions{
+		StrategyModel:    ctx.String("strategy-model"),
+		AnswerModel:      ctx.String("answer-model"),
+		FinalAnswerModel: ctx.String("final-model"),
+	})
+	if err != nil {
+		return err
 	}
 
-	fmt.Printf("🤖 Asking: %s\n", question)
-	fmt.Println("─" + strings.Repeat("─", len(question)+10))
-	fmt.Println()
+	services.Logger.Info("Starting AI ask", "question", question, "streaming", streaming)
+
+	utils.Statusf(style.Icon("🤖", "Asking: %s\n"), question)
+	utils.Status("─" + strings.Repeat("─", len(question)+10))
+	utils.Status()
 
 	if streaming {
 		// Streaming response
 		chunkChan, err := services.SearchService.Ask(ctx.Context, question, options)
 		if err != nil {
-			return errors.APIError("Failed to start AI conversation",
+			return errors.WrapAPIError(err, "Failed to start AI conversation",
 				"Check API connection and model availability")
 		}
 
-		// Print streaming response
+		// Print streaming response, collecting the answer for --output-file
+		show := printsResults(ctx)
+		var answer strings.Builder
+		var usage *models.TokenUsage
 		for chunk := range chunkChan {
 			if chunk.Error != "" {
-				fmt.Fprintf(os.Stderr, "\n❌ Error: %s\n", chunk.Error)
+				fmt.Fprintf(os.Stderr, "\n"+style.Error("%s\n"), chunk.Error)
 				return fmt.Errorf("AI response error: %s", chunk.Error)
 			}
 
-			fmt.Print(chunk.Content)
+			answer.WriteString(chunk.Content)
+			if show {
+				fmt.Print(chunk.Content)
+			}
+			if chunk.Usage != nil {
+				usage = chunk.Usage
+			}
 
 			if chunk.Done {
-				fmt.Println() // Add newline after completion
+				if show {
+					fmt.Println() // Add newline after completion
+				}
 				break
 			}
 		}
+		printTokenUsage(usage, ctx.Int("token-limit"))
+
+		if err := writeOutputFile(ctx, &models.AskResponse{
+			Answer:   answer.String(),
+			Question: question,
+			Usage:    usage,
+		}); err != nil {
+			return err
+		}
 	} else {
 		// Non-streaming response
 		response, err := services.SearchService.AskSimple(ctx.Context, question, options)
 		if err != nil {
-			return errors.APIError("Failed to get AI response",
+			return errors.WrapAPIError(err, "Failed to get AI response",
 				"Check API connection and model availability")
 		}
 
-		fmt.Println(response.Answer)
+		if printsResults(ctx) {
+			fmt.Println(response.Answer)
+		}
+		printTokenUsage(response.Usage, ctx.Int("token-limit"))
+		if err := writeOutputFile(ctx, response); err != nil {
+			return err
+		}
 	}
 
-	fmt.Println()
-	fmt.Println("─" + strings.Repeat("─", 50))
+	utils.Status()
+	utils.Status("─" + strings.Repeat("─", 50))
 	return nil
 }
 
@@ -210,20 +637,30 @@ func handleSearchAskSimple(ctx *cli.Context) error {
 			"Use --question flag to specify the question")
 	}
 
+	options, err := resolveAskModels(ctx, services, &models.AskOptions{})
+	if err != nil {
+		return err
+	}
+
 	services.Logger.Info("Starting simple AI ask", "question", question)
 
-	fmt.Printf("🤖 Asking (simple): %s\n", question)
-	fmt.Println("─" + strings.Repeat("─", len(question)+18))
-	fmt.Println()
+	utils.Statusf(style.Icon("🤖", "Asking (simple): %s\n"), question)
+	utils.Status("─" + strings.Repeat("─", len(question)+18))
+	utils.Status()
 
-	response, err := services.SearchService.AskSimple(ctx.Context, question, nil)
+	response, err := services.SearchService.AskSimple(ctx.Context, question, options)
 	if err != nil {
-		return errors.APIError("Failed to get AI response",
+		return errors.WrapAPIError(err, "Failed to get AI response",
 			"Check API connection and model availability")
 	}
 
-	fmt.Println(response.Answer)
-	fmt.Println()
-	fmt.Println("─" + strings.Repeat("─", 50))
+	if printsResults(ctx) {
+		fmt.Println(response.Answer)
+	}
+	if err := writeOutputFile(ctx, response); err != nil {
+		return err
+	}
+	utils.Status()
+	utils.Status("─" + strings.Repeat("─", 50))
 	return nil
 }
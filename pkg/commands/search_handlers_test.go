@@ -0,0 +1,24 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterSearchResults(t *testing.T) {
+	results := []models.SearchResult{
+		{ID: "source_embedding:1", ParentID: "source:in"},
+		{ID: "source_embedding:2", ParentID: "source:out"},
+		{ID: "note:in"},
+		{ID: "note:out"},
+		{ID: "source_embedding:3"},
+		{ID: "source_insight:4", ParentID: "source:in"},
+	}
+	members := map[string]bool{"source:in": true, "note:in": true}
+
+	kept, unresolved := filterSearchResults(results, members)
+	assert.Equal(t, []models.SearchResult{results[0], results[2], results[5]}, kept)
+	assert.Equal(t, 1, unresolved)
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/denkhaus/open-notebook-cli/pkg/errors"
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
 	"github.com/samber/do/v2"
 	"github.com/urfave/cli/v2"
 )
@@ -104,11 +106,11 @@ func handleSettingsGet(ctx *cli.Context) error {
 
 	settings, err := services.SettingsService.Get(ctx.Context)
 	if err != nil {
-		return errors.APIError("Failed to get settings",
+		return errors.WrapAPIError(err, "Failed to get settings",
 			"Check API connection and permissions")
 	}
 
-	fmt.Println("⚙️  Application Settings:")
+	fmt.Println(style.Icon("⚙️", "Application Settings:"))
 
 	switch format {
 	case "json":
@@ -175,14 +177,14 @@ func handleSettingsSet(ctx *cli.Context) error {
 	// Update settings
 	updatedSettings, err := services.SettingsService.Update(ctx.Context, update)
 	if err != nil {
-		return errors.APIError("Failed to update settings",
+		return errors.WrapAPIError(err, "Failed to update settings",
 			"Check input parameters and API permissions")
 	}
 
-	fmt.Println("✅ Settings updated successfully!")
+	utils.Status(style.OK("Settings updated successfully!"))
 
 	// Show updated settings
-	fmt.Println("\nUpdated Settings:")
+	utils.Status("\nUpdated Settings:")
 	if update.DefaultContentProcessingEngineDoc != nil {
 		fmt.Printf("  Document Engine: %s\n", string(updatedSettings.DefaultContentProcessingEngineDoc))
 	}
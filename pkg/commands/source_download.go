@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// errOutputExists is returned when a download would overwrite a file without
+// --force
+var errOutputExists = fmt.Errorf("file already exists (use --force to overwrite)")
+
+// saveDownload writes a download to path through write. The content goes to a
+// temporary file next to path that is renamed once complete, so a failed
+// download leaves no partial file behind. Existing files are only replaced
+// with force.
+func saveDownload(path string, force bool, write func(io.Writer) error) error {
+	if !force {
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s: %w", path, errOutputExists)
+		}
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.part")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op after the rename
+
+	if err := write(tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// downloadNames hands out distinct file names to concurrent downloads,
+// suffixing repeated names: report.pdf, report-2.pdf, report-3.pdf
+type downloadNames struct {
+	mu    sync.Mutex
+	taken map[string]bool
+}
+
+func newDownloadNames() *downloadNames {
+	return &downloadNames{taken: make(map[string]bool)}
+}
+
+// reserve returns name, or name with a numeric suffix when it was reserved
+// before
+func (n *downloadNames) reserve(name string) string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	extension := filepath.Ext(name)
+	base := strings.TrimSuffix(name, extension)
+	unique := name
+	for i := 2; n.taken[unique]; i++ {
+		unique = fmt.Sprintf("%s-%d%s", base, i, extension)
+	}
+	n.taken[unique] = true
+	return unique
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveDownload(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "report.pdf")
+	write := func(content string) func(io.Writer) error {
+		return func(w io.Writer) error {
+			_, err := io.WriteString(w, content)
+			return err
+		}
+	}
+
+	require.NoError(t, saveDownload(path, false, write("first")))
+
+	err := saveDownload(path, false, write("second"))
+	assert.ErrorIs(t, err, errOutputExists)
+
+	require.NoError(t, saveDownload(path, true, write("second")))
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(content))
+
+	// A failed download keeps the previous file and leaves no partial one
+	err = saveDownload(path, true, func(w io.Writer) error {
+		io.WriteString(w, "partial")
+		return fmt.Errorf("connection reset")
+	})
+	assert.Error(t, err)
+	content, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "second", string(content))
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestDownloadNamesReserve(t *testing.T) {
+	names := newDownloadNames()
+	assert.Equal(t, "report.pdf", names.reserve("report.pdf"))
+	assert.Equal(t, "report-2.pdf", names.reserve("report.pdf"))
+	assert.Equal(t, "report-3.pdf", names.reserve("report.pdf"))
+	assert.Equal(t, "notes", names.reserve("notes"))
+	assert.Equal(t, "notes-2", names.reserve("notes"))
+}
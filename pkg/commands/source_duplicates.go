@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+)
+
+// defaultDuplicateScanLimit bounds how many existing sources --skip-duplicates
+// compares against
+const defaultDuplicateScanLimit = 500
+
+// sourceIndex finds existing sources with the same URL or text content as a
+// source about to be created. URLs are indexed from the source list; text
+// sources are fetched and hashed the first time text is looked up.
+type sourceIndex struct {
+	services *SourcesServices
+	urls     map[string]string // normalized URL -> source ID
+	textIDs  []string          // sources without a URL or file
+
+	once   sync.Once
+	hashes map[string]string // content hash -> source ID
+	err    error
+}
+
+// loadSourceIndex indexes up to limit of the most recent sources
+func loadSourceIndex(ctx context.Context, services *SourcesServices, limit int) (*sourceIndex, error) {
+	if limit <= 0 {
+		limit = defaultDuplicateScanLimit
+	}
+
+	sources, err := services.SourceService.List(ctx, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	index := &sourceIndex{services: services, urls: make(map[string]string)}
+	for _, source := range sources {
+		id := utils.SafeDereferenceString(source.ID)
+		switch {
+		case source.Asset != nil && source.Asset.URL != nil:
+			index.urls[normalizeSourceURL(*source.Asset.URL)] = id
+		case source.Asset == nil || source.Asset.FilePath == nil:
+			index.textIDs = append(index.textIDs, id)
+		}
+	}
+	services.Logger.Debug("Indexed sources for duplicate detection",
+		"sources", len(sources), "urls", len(index.urls), "texts", len(index.textIDs))
+	return index, nil
+}
+
+// Find returns the ID of an existing source with the same URL, or with the
+// same content when url is empty and text is set
+func (x *sourceIndex) Find(ctx context.Context, url, text string) (string, bool, error) {
+	if url != "" {
+		id, ok := x.urls[normalizeSourceURL(url)]
+		return id, ok, nil
+	}
+	if text == "" {
+		return "", false, nil
+	}
+
+	x.once.Do(func() { x.err = x.hashTexts(ctx) })
+	if x.err != nil {
+		return "", false, x.err
+	}
+	id, ok := x.hashes[contentHash(text)]
+	return id, ok, nil
+}
+
+// hashTexts fetches the full text of every indexed text source
+func (x *sourceIndex) hashTexts(ctx context.Context) error {
+	hashes := make([]string, len(x.textIDs))
+	errs := utils.ForEach(ctx, utils.DefaultParallelism, len(x.textIDs), func(c context.Context, i int) error {
+		source, err := x.services.SourceService.Get(c, x.textIDs[i])
+		if err != nil {
+			return err
+		}
+		if source.FullText != nil {
+			hashes[i] = contentHash(*source.FullText)
+		}
+		return nil
+	})
+
+	x.hashes = make(map[string]string, len(x.textIDs))
+	for i, err := range errs {
+		if err != nil {
+			return err
+		}
+		if hashes[i] != "" {
+			x.hashes[hashes[i]] = x.textIDs[i]
+		}
+	}
+	return nil
+}
+
+// normalizeSourceURL makes URLs that differ only in surrounding whitespace
+// or a trailing slash compare equal
+func normalizeSourceURL(url string) string {
+	return strings.TrimSuffix(strings.TrimSpace(url), "/")
+}
+
+// contentHash returns the hex SHA-256 of text with surrounding whitespace
+// removed
+func contentHash(text string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(text)))
+	return hex.EncodeToString(sum[:])
+}
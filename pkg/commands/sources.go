@@ -1,6 +1,9 @@
 package commands
 
 import (
+	"time"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
 	"github.com/urfave/cli/v2"
 )
 
@@ -17,20 +20,32 @@ func SourcesCommand() *cli.Command {
 			"• Track processing status and insights\n\n" +
 			"Examples:\n" +
 			"  onb sources list                          # List all sources\n" +
+			"  onb sources search kubernetes             # Match titles and topics\n" +
 			"  onb sources add --text \"My note\"         # Add text content\n" +
 			"  onb sources add --link https://example.com # Add web link\n" +
 			"  onb sources add --file document.pdf      # Upload file\n" +
+			"  onb sources add --json-file sources.json # Bulk import\n" +
 			"  onb sources show <source-id>              # Show source details\n" +
-			"  onb sources status <source-id>            # Check processing status",
+			"  onb sources diff <old-id> <new-id>        # Compare the text of two sources\n" +
+			"  onb sources move --from <nb> --to <nb> <source-id> # Move to another notebook\n" +
+			"  onb sources status <source-id>            # Check processing status\n" +
+			"  onb sources wait --timeout 10m <source-id> # Wait for processing to finish\n\n" +
+			"Flags go before the source ID: flags after it are not parsed.",
 		Subcommands: []*cli.Command{
 			sourcesListCommand(),
+			sourcesSearchCommand(),
 			sourcesAddCommand(),
 			sourcesShowCommand(),
+			sourcesDiffCommand(),
 			sourcesUpdateCommand(),
+			sourcesMoveCommand(),
+			sourcesCopyCommand(),
 			sourcesDeleteCommand(),
 			sourcesDownloadCommand(),
 			sourcesStatusCommand(),
+			sourcesWaitCommand(),
 			sourcesRetryCommand(),
+			sourcesReembedCommand(),
 			sourcesInsightsCommand(),
 		},
 	}
@@ -41,7 +56,7 @@ func sourcesListCommand() *cli.Command {
 	return &cli.Command{
 		Name:  "list",
 		Usage: "List sources with optional filtering",
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			&cli.StringFlag{
 				Name:    "notebook",
 				Aliases: []string{"n"},
@@ -69,6 +84,7 @@ func sourcesListCommand() *cli.Command {
 				Usage:   "Number of sources to skip",
 				Value:   0,
 			},
+			allFlag(),
 			&cli.StringFlag{
 				Name:  "sort",
 				Usage: "Sort field (created, updated)",
@@ -79,11 +95,46 @@ func sourcesListCommand() *cli.Command {
 				Usage: "Sort order (asc, desc)",
 				Value: "desc",
 			},
-		},
+			fieldsFlag(),
+			noHeaderFlag(),
+			countFlag(),
+			filterFlag(),
+		}, dateFilterFlags()...),
 		Action: handleSourcesList,
 	}
 }
 
+// sourcesSearchCommand matches source titles and topics locally
+func sourcesSearchCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "search",
+		Usage:     "Find sources by title or topic",
+		ArgsUsage: "<term>",
+		Description: "Lists sources whose title or topics contain the term, ignoring case.\n" +
+			"Matching is done locally on the listed metadata, unlike the semantic\n" +
+			"'onb search' command which queries the content index.\n\n" +
+			"Examples:\n" +
+			"  onb sources search kubernetes\n" +
+			"  onb sources search --regex '^(go|rust)\\b'",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:    "regex",
+				Aliases: []string{"r"},
+				Usage:   "Treat the term as a regular expression (matched case-insensitively)",
+			},
+			&cli.IntFlag{
+				Name:    "limit",
+				Aliases: []string{"l"},
+				Usage:   "Maximum number of recent sources to search",
+				Value:   500,
+			},
+			fieldsFlag(),
+			noHeaderFlag(),
+		},
+		Action: handleSourcesSearch,
+	}
+}
+
 // sourcesAddCommand adds a new source
 func sourcesAddCommand() *cli.Command {
 	return &cli.Command{
@@ -104,6 +155,14 @@ func sourcesAddCommand() *cli.Command {
 				Aliases: []string{"f"},
 				Usage:   "Local file path to upload as source",
 			},
+			&cli.BoolFlag{
+				Name:  "auto-detect",
+				Usage: "Add --text content that is a single URL as link instead of warning about it",
+			},
+			&cli.StringFlag{
+				Name:  "source-type",
+				Usage: "Source type (text, link, upload) overriding the one implied by --text, --link or --file, e.g. to add a URL given with --text as link",
+			},
 			&cli.StringFlag{
 				Name:    "title",
 				Aliases: []string{"t"},
@@ -117,13 +176,70 @@ func sourcesAddCommand() *cli.Command {
 			&cli.StringSliceFlag{
 				Name:    "notebook",
 				Aliases: []string{"notebooks", "n"},
-				Usage:   "Notebook IDs to associate with (can be specified multiple times)",
+				Usage:   "Notebook IDs or names to associate with (can be specified multiple times)",
+			},
+			&cli.StringSliceFlag{
+				Name:  "notebook-id",
+				Usage: "Notebook ID to associate with, used as is without matching notebook names (can be specified multiple times)",
 			},
 			&cli.BoolFlag{
 				Name:  "async",
 				Usage: "Process source asynchronously (default: true)",
 				Value: true,
 			},
+			&cli.IntFlag{
+				Name:  "chunk-size",
+				Usage: "Upload files larger than this many MB in chunks that are retried individually (0 disables chunking)",
+				Value: 0,
+			},
+			&cli.BoolFlag{
+				Name:  "embed",
+				Usage: "Embed the source for search (default from settings)",
+			},
+			&cli.BoolFlag{
+				Name:  "no-embed",
+				Usage: "Do not embed the source",
+			},
+			&cli.StringSliceFlag{
+				Name:    "transformation",
+				Aliases: []string{"transformations"},
+				Usage:   "Transformation ID to apply on creation (can be specified multiple times)",
+			},
+			&cli.BoolFlag{
+				Name:  "delete-source",
+				Usage: "Delete the uploaded file after processing",
+			},
+			&cli.StringFlag{
+				Name:  "json-file",
+				Usage: "Import sources in bulk from a JSON array of {title, url|text|file, notebooks} items",
+			},
+			&cli.IntFlag{
+				Name:  "parallel",
+				Usage: "Number of sources from --json-file to process concurrently",
+				Value: utils.DefaultParallelism,
+			},
+			&cli.BoolFlag{
+				Name:  "skip-duplicates",
+				Usage: "Skip links and text that match an existing source by URL or content, printing the existing source ID",
+			},
+			&cli.IntFlag{
+				Name:    "limit",
+				Aliases: []string{"l"},
+				Usage:   "Maximum number of recent sources --skip-duplicates compares against",
+				Value:   defaultDuplicateScanLimit,
+			},
+			&cli.BoolFlag{
+				Name:    "watch",
+				Aliases: []string{"w"},
+				Usage:   "Wait for the new source to finish processing, within --timeout, and fail if processing fails",
+			},
+			waitTimeoutFlag(),
+			&cli.DurationFlag{
+				Name:  "interval",
+				Usage: "Polling interval with --watch",
+				Value: 2 * time.Second,
+			},
+			stallTimeoutFlag(),
 		},
 		Action: handleSourcesAdd,
 	}
@@ -139,6 +255,39 @@ func sourcesShowCommand() *cli.Command {
 	}
 }
 
+// sourcesDiffCommand compares the full text of two sources
+func sourcesDiffCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "diff",
+		Usage:     "Compare the full text of two sources",
+		ArgsUsage: "<old-source-id> <new-source-id>",
+		Description: "Print the changes between the extracted text of two sources, for\n" +
+			"example an old and a re-added version of a web page.\n\n" +
+			"Examples:\n" +
+			"  onb sources diff source:old source:new\n" +
+			"  onb sources diff --format side-by-side source:old source:new",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "format",
+				Aliases: []string{"f"},
+				Usage:   "Diff format (unified, side-by-side)",
+				Value:   "unified",
+			},
+			&cli.IntFlag{
+				Name:    "context",
+				Aliases: []string{"c"},
+				Usage:   "Number of unchanged lines shown around each change",
+				Value:   3,
+			},
+			&cli.IntFlag{
+				Name:  "width",
+				Usage: "Total width of side-by-side output (default: terminal width)",
+			},
+		},
+		Action: handleSourcesDiff,
+	}
+}
+
 // sourcesUpdateCommand updates a source
 func sourcesUpdateCommand() *cli.Command {
 	return &cli.Command{
@@ -161,12 +310,57 @@ func sourcesUpdateCommand() *cli.Command {
 	}
 }
 
+// sourcesMoveCommand moves a source from one notebook to another
+func sourcesMoveCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "move",
+		Usage:     "Move a source from one notebook to another",
+		ArgsUsage: "<source-id>",
+		Description: "Add the source to the target notebook, then remove it from the original\n" +
+			"notebook. If the removal fails the source is removed from the target again,\n" +
+			"so it is never left in both notebooks.",
+		Args: true,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "from",
+				Usage:    "Notebook ID the source is moved out of",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     "to",
+				Usage:    "Notebook ID the source is moved into",
+				Required: true,
+			},
+		},
+		Action: handleSourcesMove,
+	}
+}
+
+// sourcesCopyCommand adds a source to another notebook, keeping existing links
+func sourcesCopyCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "copy",
+		Usage:     "Add a source to another notebook without removing it from its current ones",
+		ArgsUsage: "<source-id>",
+		Args:      true,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "to",
+				Usage:    "Notebook ID the source is added to",
+				Required: true,
+			},
+		},
+		Action: handleSourcesCopy,
+	}
+}
+
 // sourcesDeleteCommand deletes a source
 func sourcesDeleteCommand() *cli.Command {
 	return &cli.Command{
-		Name:  "delete",
-		Usage: "Delete a source",
-		Args:  true,
+		Name:      "delete",
+		Usage:     "Delete one or more sources",
+		ArgsUsage: "<source-id> [source-id...]",
+		Args:      true,
 		Flags: []cli.Flag{
 			&cli.BoolFlag{
 				Name:    "force",
@@ -174,6 +368,15 @@ func sourcesDeleteCommand() *cli.Command {
 				Usage:   "Force deletion without confirmation",
 				Value:   false,
 			},
+			&cli.BoolFlag{
+				Name:  "confirm-each",
+				Usage: "Ask for every source: y(es), N(o), a(ll remaining) or q(uit)",
+			},
+			&cli.IntFlag{
+				Name:  "parallel",
+				Usage: "Number of deletions to process concurrently",
+				Value: utils.DefaultParallelism,
+			},
 		},
 		Action: handleSourcesDelete,
 	}
@@ -182,14 +385,29 @@ func sourcesDeleteCommand() *cli.Command {
 // sourcesDownloadCommand downloads source file
 func sourcesDownloadCommand() *cli.Command {
 	return &cli.Command{
-		Name:  "download",
-		Usage: "Download source file to local system",
-		Args:  true,
+		Name:      "download",
+		Usage:     "Download source files to local system",
+		ArgsUsage: "<source-id> [source-id...]",
+		Args:      true,
 		Flags: []cli.Flag{
 			&cli.StringFlag{
 				Name:    "output",
 				Aliases: []string{"o"},
-				Usage:   "Output file path (default: current directory with original filename)",
+				Usage:   "Output file path, or directory when downloading several sources (default: current directory with original filename)",
+			},
+			&cli.BoolFlag{
+				Name:  "all",
+				Usage: "Download every source that has a file available",
+			},
+			&cli.BoolFlag{
+				Name:    "force",
+				Aliases: []string{"f"},
+				Usage:   "Overwrite existing files",
+			},
+			&cli.IntFlag{
+				Name:  "parallel",
+				Usage: "Number of downloads to process concurrently",
+				Value: utils.DefaultParallelism,
 			},
 		},
 		Action: handleSourcesDownload,
@@ -206,14 +424,71 @@ func sourcesStatusCommand() *cli.Command {
 			&cli.BoolFlag{
 				Name:    "watch",
 				Aliases: []string{"w"},
-				Usage:   "Watch status updates continuously",
+				Usage:   "Watch status updates until processing finishes",
 				Value:   false,
 			},
+			&cli.DurationFlag{
+				Name:  "interval",
+				Usage: "Polling interval when watching",
+				Value: 2 * time.Second,
+			},
+			stallTimeoutFlag(),
 		},
 		Action: handleSourcesStatus,
 	}
 }
 
+// waitTimeoutFlag returns the --timeout flag bounding how long 'sources wait'
+// and 'sources add --watch' wait for processing
+func waitTimeoutFlag() cli.Flag {
+	return &cli.DurationFlag{
+		Name:  "timeout",
+		Usage: "Maximum time to wait, e.g. 90s or 10m (0 waits indefinitely)",
+		Value: 5 * time.Minute,
+	}
+}
+
+// sourcesWaitCommand blocks until source processing finishes
+func sourcesWaitCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "wait",
+		Usage:     "Wait until source processing completes",
+		ArgsUsage: "[--timeout <duration>] <source-id>",
+		Description: "Poll the source status until it is completed or failed.\n\n" +
+			"Exits with status 0 when processing completed and non-zero when it\n" +
+			"failed or the timeout expired, so it can be chained in scripts:\n" +
+			"  onb sources wait <source-id> && onb search query \"...\"\n" +
+			"  onb sources wait --timeout 5m <source-id>\n\n" +
+			"Flags go before the source ID.\n\n" +
+			"With --ids or --notebook several sources are polled concurrently and a\n" +
+			"table of their final status is printed; the exit status is non-zero\n" +
+			"unless all of them completed:\n" +
+			"  onb sources wait --ids source:a,source:b\n" +
+			"  onb sources wait --notebook Research",
+		Args: true,
+		Flags: []cli.Flag{
+			&cli.StringSliceFlag{
+				Name:  "ids",
+				Usage: "Source IDs to wait for, comma separated or repeated",
+			},
+			&cli.StringFlag{
+				Name:    "notebook",
+				Aliases: []string{"n"},
+				Usage:   "Wait for every source of this notebook (ID or name)",
+			},
+			notebookIDFlag(),
+			waitTimeoutFlag(),
+			&cli.DurationFlag{
+				Name:  "interval",
+				Usage: "Polling interval",
+				Value: 2 * time.Second,
+			},
+			stallTimeoutFlag(),
+		},
+		Action: handleSourcesWait,
+	}
+}
+
 // sourcesRetryCommand retries source processing
 func sourcesRetryCommand() *cli.Command {
 	return &cli.Command{
@@ -231,6 +506,47 @@ func sourcesRetryCommand() *cli.Command {
 	}
 }
 
+// sourcesReembedCommand re-embeds sources, e.g. after changing the embedding model
+func sourcesReembedCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "reembed",
+		Usage:     "Re-embed sources, e.g. after changing the embedding model",
+		ArgsUsage: "[source-id...]",
+		Description: "Queue new embeddings for the given sources, or with --notebook for every\n" +
+			"source of a notebook. Sources that are already embedded are skipped unless\n" +
+			"--force is set. Asynchronous embeddings run as background jobs; follow them\n" +
+			"with --wait or 'onb jobs status <command-id>'.",
+		Args: true,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "notebook",
+				Aliases: []string{"n"},
+				Usage:   "Re-embed every source of this notebook",
+			},
+			&cli.BoolFlag{
+				Name:    "force",
+				Aliases: []string{"f"},
+				Usage:   "Re-embed sources that are already embedded",
+			},
+			&cli.BoolFlag{
+				Name:  "async",
+				Usage: "Queue embeddings as background jobs instead of waiting for each request",
+				Value: true,
+			},
+			&cli.BoolFlag{
+				Name:  "wait",
+				Usage: "Wait until the queued embedding jobs finish",
+			},
+			&cli.IntFlag{
+				Name:  "parallel",
+				Usage: "Number of sources to submit concurrently",
+				Value: utils.DefaultParallelism,
+			},
+		},
+		Action: handleSourcesReembed,
+	}
+}
+
 // sourcesInsightsCommand manages source insights
 func sourcesInsightsCommand() *cli.Command {
 	return &cli.Command{
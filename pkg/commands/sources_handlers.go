@@ -1,9 +1,20 @@
 package commands
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"io"
+	"mime"
+	"net/url"
 	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -12,15 +23,22 @@ import (
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
 	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/diff"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/render"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
 	"github.com/samber/do/v2"
 	"github.com/urfave/cli/v2"
 )
 
 // SourcesServices holds all the services needed for source commands
 type SourcesServices struct {
-	SourceService shared.SourceService
-	Config        config.Service
-	Logger        shared.Logger
+	SourceService      shared.SourceService
+	NotebookService    shared.NotebookService
+	EmbeddingService   shared.EmbeddingService
+	JobService         shared.JobService
+	SettingsRepository shared.SettingsRepository
+	Config             config.Service
+	Logger             shared.Logger
 }
 
 // getSourcesServices retrieves all required services via dependency injection
@@ -32,14 +50,21 @@ func getSourcesServices(ctx *cli.Context) (*SourcesServices, error) {
 	}
 
 	return &SourcesServices{
-		SourceService: do.MustInvoke[shared.SourceService](injector),
-		Config:        do.MustInvoke[config.Service](injector),
-		Logger:        do.MustInvoke[shared.Logger](injector),
+		SourceService:      do.MustInvoke[shared.SourceService](injector),
+		NotebookService:    do.MustInvoke[shared.NotebookService](injector),
+		EmbeddingService:   do.MustInvoke[shared.EmbeddingService](injector),
+		JobService:         do.MustInvoke[shared.JobService](injector),
+		SettingsRepository: do.MustInvoke[shared.SettingsRepository](injector),
+		Config:             do.MustInvoke[config.Service](injector),
+		Logger:             do.MustInvoke[shared.Logger](injector),
 	}, nil
 }
 
 // validateSourceArgs validates common argument patterns for source commands
 func validateSourceArgs(ctx *cli.Context, requireSourceID bool) (string, error) {
+	if err := rejectTrailingFlags(ctx, "source ID"); err != nil {
+		return "", err
+	}
 	if requireSourceID {
 		if ctx.NArg() < 1 {
 			return "", errors.MissingArgument("source ID", ctx.Command.Name)
@@ -58,9 +83,25 @@ func validateSourceArgs(ctx *cli.Context, requireSourceID bool) (string, error)
 	return sourceID, nil
 }
 
+// rejectTrailingFlags reports a flag given after the positional arguments.
+// urfave/cli stops parsing flags at the first argument and passes the rest on
+// as arguments, so 'sources wait <id> --timeout 5m' would ignore --timeout.
+func rejectTrailingFlags(ctx *cli.Context, argName string) error {
+	args := ctx.Args().Slice()
+	for i, arg := range args {
+		if i == 0 || len(arg) < 2 || !strings.HasPrefix(arg, "-") {
+			continue
+		}
+		reordered := append(slices.Clone(args[i:]), args[:i]...)
+		return errors.UsageError(fmt.Sprintf("Flag %s must come before the %s", arg, argName),
+			fmt.Sprintf("Use: onb sources %s %s", ctx.Command.Name, strings.Join(reordered, " ")))
+	}
+	return nil
+}
+
 // printSourceSuccess prints standardized success messages for source operations
 func printSourceSuccess(operation string, source *models.Source) {
-	fmt.Printf("✅ Source %s successfully!\n", operation)
+	utils.Statusf(style.OK("Source %s successfully!\n"), operation)
 	fmt.Printf("  ID:     %s\n", utils.SafeDereferenceString(source.ID))
 	fmt.Printf("  Title:  %s\n", utils.SafeDereferenceString(source.Title))
 	if source.Status != nil {
@@ -110,31 +151,41 @@ func handleSourcesList(ctx *cli.Context) error {
 
 	services.Logger.Info("Listing sources...")
 
-	// Parse pagination parameters
-	limit := 20
-	offset := 0
-	if ctx.IsSet("limit") {
-		limit = ctx.Int("limit")
-	}
-	if ctx.IsSet("offset") {
-		offset = ctx.Int("offset")
+	sources, streamed, err := listPages(ctx,
+		func(limit, offset int) ([]*models.SourceListResponse, error) {
+			sources, err := services.SourceService.List(ctx.Context, limit, offset)
+			if err != nil {
+				return nil, errors.WrapAPIError(err, "Failed to list sources",
+					"Check API connection and permissions")
+			}
+			return sources, nil
+		},
+		func(sources []*models.SourceListResponse) ([]*models.SourceListResponse, error) {
+			sources, err := filterItems(ctx, sources)
+			if err != nil {
+				return nil, err
+			}
+			return filterByDate(ctx, sources)
+		})
+	if err != nil || streamed {
+		return err
 	}
-
-	sources, err := services.SourceService.List(ctx.Context, limit, offset)
-	if err != nil {
-		return errors.APIError("Failed to list sources",
-			"Check API connection and permissions")
+	if printCount(ctx, len(sources)) {
+		return nil
 	}
 
-	if len(sources) == 0 {
-		fmt.Println("No sources found.")
+	if len(sources) == 0 && !isStructuredOutput(ctx) {
+		utils.Status("No sources found.")
 		return nil
 	}
 
 	// Display sources in a table
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "ID\tTITLE\tTYPE\tSTATUS\tCREATED")
-
+	wide := isWideOutput(ctx)
+	headers := []string{"ID", "TITLE", "TYPE", "STATUS", "CREATED"}
+	if wide {
+		headers = append(headers, "EMBEDDED", "CHUNKS", "INSIGHTS")
+	}
+	table := render.NewTable(headers...)
 	for _, source := range sources {
 		title := utils.SafeDereferenceString(source.Title)
 		status := "N/A"
@@ -142,20 +193,184 @@ func handleSourcesList(ctx *cli.Context) error {
 			status = string(*source.Status)
 		}
 
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+		row := []string{
 			utils.SafeDereferenceString(source.ID),
-			utils.TruncateString(title, 30),
+			title,
 			"source", // Type field doesn't exist in list response
 			status,
-			utils.FormatTimestamp(source.Created))
+			utils.FormatTimestamp(source.Created),
+		}
+		if wide {
+			row = append(row, formatYesNo(source.Embedded),
+				strconv.Itoa(source.EmbeddedChunks), strconv.Itoa(source.InsightsCount))
+		}
+		table.AddRow(row...)
 	}
 
-	w.Flush()
+	if err := renderList(ctx, sources, table); err != nil {
+		return err
+	}
+
+	if !isStructuredOutput(ctx) {
+		if ctx.Bool("all") {
+			utils.Statusf("\nShowing all %d sources\n", len(sources))
+		} else {
+			utils.Statusf("\nShowing %d sources (use --limit and --offset for pagination, or --all)\n", len(sources))
+		}
+	}
+	return nil
+}
+
+// handleSourcesSearch handles matching sources by title and topics
+func handleSourcesSearch(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.UsageError("Exactly one search term is required",
+			"Usage: onb sources search <term>")
+	}
+
+	pattern := ctx.Args().First()
+	if !ctx.Bool("regex") {
+		pattern = regexp.QuoteMeta(pattern)
+	}
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return errors.UsageError("Invalid regular expression",
+			err.Error())
+	}
+
+	services, err := getSourcesServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	services.Logger.Info("Searching sources", "pattern", re.String())
+
+	sources, err := services.SourceService.List(ctx.Context, ctx.Int("limit"), 0)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to list sources",
+			"Check API connection and permissions")
+	}
+
+	var matches []*models.SourceListResponse
+	for _, source := range sources {
+		if re.MatchString(utils.SafeDereferenceString(source.Title)) ||
+			slices.ContainsFunc(source.Topics, re.MatchString) {
+			matches = append(matches, source)
+		}
+	}
+
+	if len(matches) == 0 && !isStructuredOutput(ctx) {
+		utils.Statusf("No sources match '%s'.\n", ctx.Args().First())
+		return nil
+	}
 
-	fmt.Printf("\nShowing %d sources (use --limit and --offset for pagination)\n", len(sources))
+	table := render.NewTable("ID", "TITLE", "TOPICS", "STATUS", "CREATED")
+	for _, source := range matches {
+		status := "N/A"
+		if source.Status != nil {
+			status = string(*source.Status)
+		}
+		table.AddRow(
+			utils.SafeDereferenceString(source.ID),
+			utils.SafeDereferenceString(source.Title),
+			strings.Join(source.Topics, ", "),
+			status,
+			utils.FormatTimestamp(source.Created),
+		)
+	}
+	if !style.IsPlain() && utils.IsTerminal(os.Stdout) {
+		table.DecorateRows(func(line string) string {
+			return re.ReplaceAllStringFunc(line, style.Highlight)
+		})
+	}
+
+	if err := renderList(ctx, matches, table); err != nil {
+		return err
+	}
+
+	if !isStructuredOutput(ctx) {
+		utils.Statusf("\n%d of %d sources match\n", len(matches), len(sources))
+	}
 	return nil
 }
 
+// handleSourcesDiff handles the sources diff command
+func handleSourcesDiff(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return errors.UsageError("Expected two source IDs",
+			"Usage: onb sources diff <old-source-id> <new-source-id>")
+	}
+	format := ctx.String("format")
+	if format != "unified" && format != "side-by-side" {
+		return errors.UsageError(fmt.Sprintf("Invalid diff format '%s'", format),
+			"Supported formats are: unified, side-by-side")
+	}
+
+	services, err := getSourcesServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	oldID, newID := ctx.Args().Get(0), ctx.Args().Get(1)
+	services.Logger.Info("Comparing sources", "old", oldID, "new", newID)
+
+	oldText, err := sourceFullText(ctx, services, oldID)
+	if err != nil {
+		return err
+	}
+	newText, err := sourceFullText(ctx, services, newID)
+	if err != nil {
+		return err
+	}
+
+	lines := diff.Lines(diff.SplitLines(oldText), diff.SplitLines(newText))
+	var out string
+	if format == "side-by-side" {
+		width := ctx.Int("width")
+		if width <= 0 {
+			width = defaultSideBySideWidth
+			if w, _, ok := utils.TerminalSize(os.Stdout); ok {
+				width = w
+			}
+		}
+		out = diff.SideBySide(lines, ctx.Int("context"), max((width-3)/2, 10))
+	} else {
+		out = diff.Unified(oldID, newID, lines, ctx.Int("context"))
+	}
+
+	if out == "" {
+		utils.Status(style.OK("The full text of both sources is identical"))
+		return nil
+	}
+	fmt.Print(out)
+	return nil
+}
+
+// defaultSideBySideWidth is the width of side-by-side diffs when it is not
+// given and stdout is not a terminal
+const defaultSideBySideWidth = 160
+
+// sourceFullText returns the extracted text of a source, failing with a
+// clear message while the source is still being processed
+func sourceFullText(ctx *cli.Context, services *SourcesServices, sourceID string) (string, error) {
+	source, err := services.SourceService.Get(ctx.Context, sourceID)
+	if err != nil {
+		return "", errors.WrapAPIError(err, fmt.Sprintf("Failed to get source %s", sourceID),
+			"Check source ID and permissions")
+	}
+	if source.FullText != nil {
+		return *source.FullText, nil
+	}
+
+	if status := source.Status; status != nil && *status != models.SourceStatusCompleted && *status != models.SourceStatusFailed {
+		return "", errors.ValidationError(
+			fmt.Sprintf("Source %s has no text yet: it is still %s", sourceID, *source.Status),
+			fmt.Sprintf("Wait for processing with 'onb sources wait %s'", sourceID))
+	}
+	return "", errors.ValidationError(fmt.Sprintf("Source %s has no extracted text", sourceID),
+		fmt.Sprintf("Check its processing status with 'onb sources status %s'", sourceID))
+}
+
 // handleSourcesShow handles source details display
 func handleSourcesShow(ctx *cli.Context) error {
 	sourceID, err := validateSourceArgs(ctx, true)
@@ -172,10 +387,14 @@ func handleSourcesShow(ctx *cli.Context) error {
 
 	source, err := services.SourceService.Get(ctx.Context, sourceID)
 	if err != nil {
-		return errors.APIError("Failed to get source details",
+		return errors.WrapAPIError(err, "Failed to get source details",
 			"Check source ID and permissions")
 	}
 
+	if handled, err := renderObject(ctx, source); handled {
+		return err
+	}
+
 	// Display source details
 	fmt.Printf("Source Details:\n")
 	fmt.Printf("  ID:           %s\n", utils.SafeDereferenceString(source.ID))
@@ -215,6 +434,18 @@ func handleSourcesAdd(ctx *cli.Context) error {
 	link := ctx.String("link")
 	filePath := ctx.String("file")
 
+	if jsonFile := ctx.String("json-file"); jsonFile != "" {
+		if text != "" || link != "" || filePath != "" {
+			return errors.UsageError("--json-file cannot be combined with --text, --link or --file",
+				"Put every source into the import file instead")
+		}
+		if ctx.Bool("watch") {
+			return errors.UsageError("--watch cannot be combined with --json-file",
+				"Wait for the imported sources with 'onb sources wait --ids <id>,<id>'")
+		}
+		return handleSourcesImport(ctx, services, jsonFile)
+	}
+
 	// Validate required fields
 	if title == "" {
 		return errors.UsageError("Title is required",
@@ -222,95 +453,447 @@ func handleSourcesAdd(ctx *cli.Context) error {
 	}
 
 	// Determine source type based on provided flags - fail loud if ambiguous
-	var sourceType string
-	var source *models.SourceCreate
-
-	if text != "" {
-		sourceType = "text"
-	} else if link != "" {
-		sourceType = "link"
-	} else if filePath != "" {
-		sourceType = "file"
-	} else {
-		return errors.UsageError("One of --text, --link, or --file is required",
-			"Use --text for text content, --link for URLs, or --file for file uploads")
+	sourceType, value, err := resolveSourceType(ctx.String("source-type"), text, link, filePath)
+	if err != nil {
+		return err
+	}
+	if sourceType == models.SourceTypeText && !ctx.IsSet("source-type") && looksLikeURL(value) {
+		if ctx.Bool("auto-detect") {
+			sourceType = models.SourceTypeLink
+			utils.Statusf(style.Icon("🔗", "Adding '%s' as link since it is a URL\n"), value)
+		} else {
+			fmt.Fprintln(os.Stderr, style.Warn(fmt.Sprintf("The --text content '%s' is a URL and will be stored as literal text", value)))
+			utils.Status("   Use --link (or --auto-detect) to fetch the page instead")
+		}
 	}
+	text, link = "", ""
 
+	var source *models.SourceCreate
 	switch sourceType {
-	case "text":
+	case models.SourceTypeText:
+		text = value
 		source = &models.SourceCreate{
 			Type:    models.SourceTypeText,
 			Title:   &title,
 			Content: &text,
 		}
 
-	case "link":
+	case models.SourceTypeLink:
+		link = value
 		source = &models.SourceCreate{
 			Type:  models.SourceTypeLink,
 			Title: &title,
 			URL:   &link,
 		}
 
-	case "file":
-		if filePath == "" {
-			return errors.UsageError("File path is required for file sources",
-				"Use --file flag to specify the file path")
-		}
+	case models.SourceTypeUpload:
 		// File upload is handled separately
-		return handleFileUpload(ctx, services, title, filePath)
-
-	default:
-		return errors.UsageError("Invalid source type",
-			"Supported types are: text, link, file")
+		return handleFileUpload(ctx, services, title, value)
 	}
 
 	// Add optional parameters
-	if ctx.IsSet("notebook") {
-		notebookID := ctx.String("notebook")
-		source.Notebooks = []string{notebookID}
+	if ctx.IsSet("notebook") || ctx.IsSet("notebook-id") {
+		notebooks, err := resolveNotebookFlags(ctx, services.NotebookService)
+		if err != nil {
+			return err
+		}
+		source.Notebooks = notebooks
+	}
+
+	embed, err := resolveEmbedOption(ctx, services)
+	if err != nil {
+		return err
+	}
+	source.Embed = embed
+	source.Transformations = ctx.StringSlice("transformation")
+	source.DeleteSource = ctx.Bool("delete-source")
+
+	if ctx.Bool("skip-duplicates") {
+		index, err := loadSourceIndex(ctx.Context, services, ctx.Int("limit"))
+		if err != nil {
+			return errors.WrapAPIError(err, "Failed to list existing sources",
+				"Retry without --skip-duplicates")
+		}
+		existingID, found, err := index.Find(ctx.Context, link, text)
+		if err != nil {
+			return errors.WrapAPIError(err, "Failed to compare with existing sources",
+				"Retry without --skip-duplicates")
+		}
+		if found {
+			utils.Status(style.Warn("Skipped: an identical source already exists"))
+			fmt.Printf("  ID:     %s\n", existingID)
+			return nil
+		}
 	}
 
 	services.Logger.Info("Creating source", "type", sourceType, "title", title)
 
 	createdSource, err := services.SourceService.Create(ctx.Context, source)
 	if err != nil {
-		return errors.APIError("Failed to create source",
+		return errors.WrapAPIError(err, "Failed to create source",
 			"Check input parameters and API permissions")
 	}
 
 	printSourceSuccess("created", createdSource)
+	if ctx.Bool("watch") {
+		return awaitSource(ctx, services, utils.SafeDereferenceString(createdSource.ID))
+	}
+	return nil
+}
+
+// resolveSourceType returns the type of the source to create and its text,
+// URL or file path. The type is inferred from which of --text, --link and
+// --file is set unless --source-type names it: then a value given with --text
+// may be a URL to add as link, and a --link value may be added as plain text.
+// Several content flags, or a --file with another type than upload, are
+// rejected instead of picking one.
+func resolveSourceType(explicit, text, link, filePath string) (models.SourceType, string, error) {
+	var given []string
+	for _, content := range []struct{ flag, value string }{{"--text", text}, {"--link", link}, {"--file", filePath}} {
+		if content.value != "" {
+			given = append(given, content.flag)
+		}
+	}
+	if len(given) > 1 {
+		return "", "", errors.UsageError(fmt.Sprintf("Only one of --text, --link or --file can be used, got %s", strings.Join(given, " and ")),
+			"Use --source-type to say how a single value should be added")
+	}
+	if len(given) == 0 {
+		return "", "", errors.UsageError("One of --text, --link, or --file is required",
+			"Use --text for text content, --link for URLs, or --file for file uploads")
+	}
+
+	switch models.SourceType(explicit) {
+	case "":
+		switch {
+		case text != "":
+			return models.SourceTypeText, text, nil
+		case link != "":
+			return models.SourceTypeLink, link, nil
+		default:
+			return models.SourceTypeUpload, filePath, nil
+		}
+	case models.SourceTypeText, models.SourceTypeLink:
+		if filePath != "" {
+			return "", "", errors.UsageError(fmt.Sprintf("--source-type %s conflicts with --file", explicit),
+				"Use --source-type upload for files, or pass the content with --text or --link")
+		}
+		return models.SourceType(explicit), text + link, nil
+	case models.SourceTypeUpload:
+		if filePath == "" {
+			return "", "", errors.UsageError(fmt.Sprintf("--source-type upload conflicts with %s", given[0]),
+				"Use --file to specify the file to upload")
+		}
+		return models.SourceTypeUpload, filePath, nil
+	default:
+		return "", "", errors.UsageError(fmt.Sprintf("Invalid source type '%s'", explicit),
+			"Supported types are: text, link, upload")
+	}
+}
+
+// looksLikeURL reports whether text is nothing but a single http(s) URL
+func looksLikeURL(text string) bool {
+	text = strings.TrimSpace(text)
+	if text == "" || strings.ContainsAny(text, " \t\n") {
+		return false
+	}
+	u, err := url.Parse(text)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// handleSourcesImport validates every item of a bulk import file before
+// creating any of the sources it describes
+func handleSourcesImport(ctx *cli.Context, services *SourcesServices, path string) error {
+	items, err := loadSourceImportFile(path)
+	if err != nil {
+		return err
+	}
+
+	embed, err := resolveEmbedOption(ctx, services)
+	if err != nil {
+		return err
+	}
+
+	var index *sourceIndex
+	if ctx.Bool("skip-duplicates") {
+		if index, err = loadSourceIndex(ctx.Context, services, ctx.Int("limit")); err != nil {
+			return errors.WrapAPIError(err, "Failed to list existing sources",
+				"Retry without --skip-duplicates")
+		}
+	}
+
+	parallel := ctx.Int("parallel")
+	utils.Statusf(style.Icon("📥", "Importing %d sources from %s\n"), len(items), path)
+
+	created := make([]*models.Source, len(items))
+	duplicates := make([]string, len(items))
+	errs := utils.ForEach(ctx.Context, parallel, len(items), func(c context.Context, i int) error {
+		if index != nil {
+			existingID, found, err := index.Find(c, items[i].URL, items[i].Text)
+			if err != nil {
+				return fmt.Errorf("failed to compare with existing sources: %w", err)
+			}
+			if found {
+				duplicates[i] = existingID
+				return nil
+			}
+		}
+		source, err := importSource(c, services, items[i], embed)
+		created[i] = source
+		return err
+	})
+
+	failed, skipped := 0, 0
+	for i, item := range items {
+		if errs[i] != nil {
+			fmt.Printf(style.Error("[%d] Failed to import %q: %v\n"), i, item.Title, errs[i])
+			failed++
+			continue
+		}
+		if duplicates[i] != "" {
+			fmt.Printf(style.Warn("[%d] Skipped %q: already exists as %s\n"), i, item.Title, duplicates[i])
+			skipped++
+			continue
+		}
+		utils.Statusf(style.OK("[%d] Imported %q as %s\n"), i, item.Title, utils.SafeDereferenceString(created[i].ID))
+	}
+
+	if failed > 0 {
+		return errors.APIError(fmt.Sprintf("Failed to import %d of %d sources", failed, len(items)),
+			"Check the errors above and re-run with the failed items")
+	}
+
+	if skipped > 0 {
+		utils.Status(style.OK(fmt.Sprintf("Imported %d sources, skipped %d duplicates", len(items)-skipped, skipped)))
+		return nil
+	}
+	utils.Status(style.OK(fmt.Sprintf("Imported %d sources", len(items))))
 	return nil
 }
 
+// importSource creates a single source from a validated import item
+func importSource(ctx context.Context, services *SourcesServices, item *models.SourceImportItem, defaultEmbed bool) (*models.Source, error) {
+	options := &models.SourceOptions{
+		Title:           item.Title,
+		Notebooks:       item.Notebooks,
+		Transformations: item.Transformations,
+		Embed:           defaultEmbed,
+		DeleteSource:    item.DeleteSource,
+	}
+	if item.Embed != nil {
+		options.Embed = *item.Embed
+	}
+
+	services.Logger.Info("Importing source", "type", item.Type(), "title", item.Title)
+
+	switch item.Type() {
+	case models.SourceTypeLink:
+		return services.SourceService.AddSourceFromLink(ctx, item.URL, options)
+	case models.SourceTypeUpload:
+		file, err := os.Open(item.File)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		return services.SourceService.AddSourceFromUpload(ctx, filepath.Base(item.File), file, options)
+	default:
+		return services.SourceService.AddSourceFromText(ctx, item.Text, item.Title, options)
+	}
+}
+
+// loadSourceImportFile parses a bulk import file and validates every item.
+// All problems are reported together, each prefixed with the item index and
+// the line it starts on, so the file can be fixed in one pass.
+func loadSourceImportFile(path string) ([]*models.SourceImportItem, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.UsageError("Failed to read import file",
+			fmt.Sprintf("Could not read '%s': %v", path, err))
+	}
+
+	items, err := parseSourceImport(data)
+	if err != nil {
+		return nil, errors.ValidationError(fmt.Sprintf("Invalid import file %s", path), err.Error())
+	}
+
+	var problems []string
+	for i, item := range items {
+		itemProblems := item.Validate()
+		if item.File != "" {
+			if info, err := os.Stat(item.File); err != nil || info.IsDir() {
+				itemProblems = append(itemProblems, fmt.Errorf("file %q is not a readable file", item.File))
+			}
+		}
+		for _, problem := range itemProblems {
+			problems = append(problems, fmt.Sprintf("item %d (line %d): %v", i, item.line, problem))
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, errors.ValidationError(
+			fmt.Sprintf("Import file %s has %d problem(s); no sources were created", path, len(problems)),
+			problems...)
+	}
+
+	result := make([]*models.SourceImportItem, len(items))
+	for i, item := range items {
+		result[i] = &item.SourceImportItem
+	}
+	return result, nil
+}
+
+// sourceImportEntry pairs an import item with the line it starts on
+type sourceImportEntry struct {
+	models.SourceImportItem
+	line int
+}
+
+// parseSourceImport decodes a JSON array of import items, rejecting unknown
+// fields and reporting decode errors with their line number
+func parseSourceImport(data []byte) ([]*sourceImportEntry, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	lineAt := func(offset int64) int {
+		return bytes.Count(data[:offset], []byte("\n")) + 1
+	}
+
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %v", lineAt(dec.InputOffset()), err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return nil, fmt.Errorf("line %d: expected a JSON array of sources", lineAt(dec.InputOffset()))
+	}
+
+	var entries []*sourceImportEntry
+	for dec.More() {
+		start := dec.InputOffset()
+		// Skip separators and whitespace so the line points at the item itself
+		for start < int64(len(data)) && strings.ContainsRune(", \t\r\n", rune(data[start])) {
+			start++
+		}
+
+		entry := &sourceImportEntry{line: lineAt(start)}
+		if err := dec.Decode(&entry.SourceImportItem); err != nil {
+			line := entry.line
+			if syntaxErr, ok := err.(*json.SyntaxError); ok {
+				line = lineAt(syntaxErr.Offset)
+			}
+			return nil, fmt.Errorf("item %d (line %d): %v", len(entries), line, err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("line %d: %v", lineAt(dec.InputOffset()), err)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("the import file contains no sources")
+	}
+
+	return entries, nil
+}
+
+// resolveEmbedOption determines whether a new source should be embedded.
+// Explicit --embed/--no-embed flags win; otherwise the server's default
+// embedding option is used, where only "always" enables embedding.
+func resolveEmbedOption(ctx *cli.Context, services *SourcesServices) (bool, error) {
+	if ctx.Bool("embed") && ctx.Bool("no-embed") {
+		return false, errors.UsageError("Conflicting embed flags",
+			"Use either --embed or --no-embed, not both")
+	}
+	if ctx.Bool("no-embed") {
+		return false, nil
+	}
+	if ctx.Bool("embed") {
+		return true, nil
+	}
+
+	settings, err := services.SettingsRepository.Get(ctx.Context)
+	if err != nil {
+		services.Logger.Warn("Failed to load default embedding option, not embedding", "error", err)
+		return false, nil
+	}
+	return settings.DefaultEmbeddingOption == models.EmbeddingOptionAlways, nil
+}
+
+// uploadProgressThreshold is the file size above which upload progress is shown
+const uploadProgressThreshold = 10 * 1024 * 1024
+
 // handleFileUpload handles file source uploads
 func handleFileUpload(ctx *cli.Context, services *SourcesServices, title, filePath string) error {
 	// Check if file exists
-	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+	fileInfo, err := os.Stat(filePath)
+	if os.IsNotExist(err) {
 		return errors.UsageError("File not found",
 			fmt.Sprintf("The file '%s' does not exist", filePath))
 	}
+	if err != nil || fileInfo.IsDir() {
+		return errors.UsageError("Invalid file",
+			fmt.Sprintf("The path '%s' is not a readable file", filePath))
+	}
 
-	source := &models.SourceCreate{
-		Type:     models.SourceTypeUpload,
-		Title:    &title,
-		FilePath: &filePath,
+	file, err := os.Open(filePath)
+	if err != nil {
+		return errors.UsageError("Failed to open file",
+			fmt.Sprintf("Could not open '%s': %v", filePath, err))
+	}
+	defer file.Close()
+
+	options := &models.SourceOptions{
+		Title: title,
 	}
 
 	// Add optional parameters
-	if ctx.IsSet("notebook") {
-		notebookID := ctx.String("notebook")
-		source.Notebooks = []string{notebookID}
+	if ctx.IsSet("notebook") || ctx.IsSet("notebook-id") {
+		notebooks, err := resolveNotebookFlags(ctx, services.NotebookService)
+		if err != nil {
+			return err
+		}
+		options.Notebooks = notebooks
 	}
 
-	services.Logger.Info("Uploading file source", "file", filePath, "title", title)
+	embed, err := resolveEmbedOption(ctx, services)
+	if err != nil {
+		return err
+	}
+	options.Embed = embed
+	options.Transformations = ctx.StringSlice("transformation")
+	options.DeleteSource = ctx.Bool("delete-source")
 
-	createdSource, err := services.SourceService.Create(ctx.Context, source)
+	chunkSizeMB := ctx.Int("chunk-size")
+	if chunkSizeMB < 0 {
+		return errors.ValidationError("Invalid chunk size",
+			"--chunk-size must be a positive number of megabytes")
+	}
+	chunkSize := int64(chunkSizeMB) * 1024 * 1024
+	if chunkSize > 0 && fileInfo.Size() > chunkSize {
+		options.ChunkSize = chunkSize
+	}
+
+	services.Logger.Info("Uploading file source", "file", filePath, "title", title, "size", fileInfo.Size())
+
+	var reader io.Reader = file
+	var progress *utils.ProgressReader
+	if fileInfo.Size() > uploadProgressThreshold {
+		progress = utils.NewProgressReader(file, fileInfo.Size(), style.Icon("📤", "Uploading"))
+		reader = progress
+	}
+
+	createdSource, err := services.SourceService.AddSourceFromUpload(ctx.Context, filepath.Base(filePath), reader, options)
+	if progress != nil {
+		progress.Finish()
+	}
 	if err != nil {
-		return errors.APIError("Failed to upload file source",
+		return errors.WrapAPIError(err, "Failed to upload file source",
 			"Check file path and API permissions")
 	}
 
 	printSourceSuccess("uploaded", createdSource)
+	if ctx.Bool("watch") {
+		return awaitSource(ctx, services, utils.SafeDereferenceString(createdSource.ID))
+	}
 	return nil
 }
 
@@ -359,7 +942,7 @@ func handleSourcesUpdate(ctx *cli.Context) error {
 
 	updatedSource, err := services.SourceService.Update(ctx.Context, sourceID, updateTitle, topics)
 	if err != nil {
-		return errors.APIError("Failed to update source",
+		return errors.WrapAPIError(err, "Failed to update source",
 			"Check source ID and permissions")
 	}
 
@@ -367,86 +950,402 @@ func handleSourcesUpdate(ctx *cli.Context) error {
 	return nil
 }
 
-// handleSourcesDelete handles source deletion
-func handleSourcesDelete(ctx *cli.Context) error {
+// handleSourcesMove adds a source to the --to notebook and removes it from
+// the --from notebook, undoing the add when the removal fails
+func handleSourcesMove(ctx *cli.Context) error {
+	return transferSource(ctx, ctx.String("from"), ctx.String("to"))
+}
+
+// handleSourcesCopy adds a source to the --to notebook
+func handleSourcesCopy(ctx *cli.Context) error {
+	return transferSource(ctx, "", ctx.String("to"))
+}
+
+// transferSource links a source to toID and, when fromID is set, unlinks it
+// from fromID. Both notebooks are checked before anything is changed.
+func transferSource(ctx *cli.Context, fromID, toID string) error {
 	sourceID, err := validateSourceArgs(ctx, true)
 	if err != nil {
 		return err
 	}
+	if fromID != "" && fromID == toID {
+		return errors.ValidationError("Source and target notebook are the same",
+			"Use different notebook IDs for --from and --to")
+	}
+
+	services, err := getSourcesServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, notebookID := range []string{fromID, toID} {
+		if notebookID == "" {
+			continue
+		}
+		if _, err := services.NotebookService.GetNotebook(ctx.Context, notebookID); err != nil {
+			return notebookError(err, notebookID, "Failed to get notebook")
+		}
+	}
+
+	services.Logger.Info("Adding source to notebook", "source_id", sourceID, "notebook_id", toID)
+	if err := services.NotebookService.AddSourceToNotebook(ctx.Context, toID, sourceID); err != nil {
+		return errors.WrapAPIError(err, fmt.Sprintf("Failed to add source %s to notebook %s", sourceID, toID),
+			"Check that the source exists")
+	}
+
+	if fromID == "" {
+		utils.Statusf(style.OK("Copied source %s to notebook %s\n"), sourceID, toID)
+		return nil
+	}
+
+	services.Logger.Info("Removing source from notebook", "source_id", sourceID, "notebook_id", fromID)
+	if err := services.NotebookService.RemoveSourceFromNotebook(ctx.Context, fromID, sourceID); err != nil {
+		services.Logger.Warn("Rolling back source move", "source_id", sourceID, "notebook_id", toID)
+		if rollbackErr := services.NotebookService.RemoveSourceFromNotebook(ctx.Context, toID, sourceID); rollbackErr != nil {
+			return errors.WrapAPIError(err, fmt.Sprintf("Failed to remove source %s from notebook %s", sourceID, fromID),
+				fmt.Sprintf("The source is now in both notebooks; rollback failed: %v", rollbackErr),
+				fmt.Sprintf("Remove it manually with 'onb notebooks remove-source %s %s'", toID, sourceID))
+		}
+		return errors.WrapAPIError(err, fmt.Sprintf("Failed to remove source %s from notebook %s", sourceID, fromID),
+			"The source was removed from the target notebook again; nothing changed",
+			"Check that the source belongs to the --from notebook")
+	}
+
+	utils.Statusf(style.OK("Moved source %s from notebook %s to %s\n"), sourceID, fromID, toID)
+	return nil
+}
+
+// handleSourcesDelete handles source deletion
+func handleSourcesDelete(ctx *cli.Context) error {
+	sourceIDs := ctx.Args().Slice()
+	if len(sourceIDs) == 0 {
+		return errors.MissingArgument("source ID", ctx.Command.Name)
+	}
 
 	services, err := getSourcesServices(ctx)
 	if err != nil {
 		return err
 	}
 
-	// Confirm deletion unless force flag is used
-	if !ctx.Bool("force") {
-		fmt.Printf("Are you sure you want to delete source '%s'? (y/N): ", sourceID)
-		var response string
-		fmt.Scanln(&response)
-		if response != "y" && response != "Y" {
+	if ctx.Bool("confirm-each") && ctx.Bool("force") {
+		return errors.UsageError("--confirm-each cannot be combined with --force",
+			"Use --force to delete without asking, or --confirm-each to ask per source")
+	}
+
+	// Fetch the sources to find the ones shared with other notebooks
+	sources, err := getSourcesToDelete(ctx, services, sourceIDs)
+	if err != nil {
+		return err
+	}
+	sharedCount := 0
+	for i, source := range sources {
+		if len(source.Notebooks) > 1 {
+			sharedCount++
+			services.Logger.Warn("Deleting source used by several notebooks",
+				"source_id", sourceIDs[i], "notebooks", source.Notebooks)
+		}
+	}
+
+	// Confirm deletion, per source with --confirm-each, unless force flag is used
+	if ctx.Bool("confirm-each") {
+		sourceIDs, err = confirmEachSource(sourceIDs, sources)
+		if err != nil {
+			return err
+		}
+		if len(sourceIDs) == 0 {
+			fmt.Println("Deletion cancelled.")
+			return nil
+		}
+	} else {
+		prompt := fmt.Sprintf("Are you sure you want to delete source '%s'?", sourceIDs[0])
+		if len(sourceIDs) > 1 {
+			prompt = fmt.Sprintf("Are you sure you want to delete %d sources?", len(sourceIDs))
+		}
+		if sharedCount > 0 && !ctx.Bool("force") {
+			for i, source := range sources {
+				if len(source.Notebooks) > 1 {
+					fmt.Println(style.Warn(sharedSourceWarning(sourceIDs[i], source)))
+				}
+			}
+			if len(sourceIDs) == 1 {
+				prompt = fmt.Sprintf("Delete source '%s' from all %d notebooks?", sourceIDs[0], len(sources[0].Notebooks))
+			} else {
+				prompt = fmt.Sprintf("Are you sure you want to delete %d sources, %d of them shared with other notebooks?",
+					len(sourceIDs), sharedCount)
+			}
+		}
+		ok, err := confirmDestructive(ctx, prompt)
+		if err != nil {
+			return err
+		}
+		if !ok {
 			fmt.Println("Deletion cancelled.")
 			return nil
 		}
 	}
 
-	services.Logger.Info("Deleting source", "source_id", sourceID)
+	if len(sourceIDs) == 1 {
+		services.Logger.Info("Deleting source", "source_id", sourceIDs[0])
 
-	err = services.SourceService.Delete(ctx.Context, sourceID)
-	if err != nil {
-		return errors.APIError("Failed to delete source",
-			"Check source ID and permissions")
+		err = services.SourceService.Delete(ctx.Context, sourceIDs[0])
+		if err != nil {
+			return errors.WrapAPIError(err, "Failed to delete source",
+				"Check source ID and permissions")
+		}
+
+		utils.Statusf(style.OK("Source '%s' deleted successfully!\n"), sourceIDs[0])
+		return nil
+	}
+
+	errs := utils.ForEach(ctx.Context, ctx.Int("parallel"), len(sourceIDs), func(c context.Context, i int) error {
+		services.Logger.Info("Deleting source", "source_id", sourceIDs[i])
+		return services.SourceService.Delete(c, sourceIDs[i])
+	})
+
+	failed := 0
+	for i, sourceID := range sourceIDs {
+		if errs[i] != nil {
+			fmt.Printf(style.Error("Failed to delete source %s: %v\n"), sourceID, errs[i])
+			failed++
+			continue
+		}
+		utils.Statusf(style.OK("Deleted source %s\n"), sourceID)
 	}
 
-	fmt.Printf("✅ Source '%s' deleted successfully!\n", sourceID)
+	if failed > 0 {
+		return errors.APIError(fmt.Sprintf("Failed to delete %d of %d sources", failed, len(sourceIDs)),
+			"Check source IDs and permissions")
+	}
 	return nil
 }
 
-// handleSourcesDownload handles source file downloads
-func handleSourcesDownload(ctx *cli.Context) error {
-	sourceID, err := validateSourceArgs(ctx, true)
+// confirmEachSource asks whether to delete each source, showing its title
+// and the notebooks sharing it, and returns the IDs the user accepted
+func confirmEachSource(sourceIDs []string, sources []*models.Source) ([]string, error) {
+	confirmer, err := newItemConfirmer()
 	if err != nil {
+		return nil, err
+	}
+
+	var selected []string
+	for i, sourceID := range sourceIDs {
+		label := sourceID
+		if sources[i].Title != nil {
+			label = fmt.Sprintf("'%s' (%s)", *sources[i].Title, sourceID)
+		}
+		if len(sources[i].Notebooks) > 1 {
+			label += fmt.Sprintf(", used by %d notebooks: %s", len(sources[i].Notebooks),
+				strings.Join(sources[i].Notebooks, ", "))
+		}
+
+		if confirmer.Confirm(fmt.Sprintf("Delete source %s?", label)) {
+			selected = append(selected, sourceID)
+		}
+		if confirmer.Quit() {
+			utils.Statusf("Skipping the remaining %d sources\n", len(sourceIDs)-i-1)
+			break
+		}
+	}
+	return selected, nil
+}
+
+// getSourcesToDelete fetches the sources about to be deleted, in the order
+// of sourceIDs
+func getSourcesToDelete(ctx *cli.Context, services *SourcesServices, sourceIDs []string) ([]*models.Source, error) {
+	sources := make([]*models.Source, len(sourceIDs))
+	errs := utils.ForEach(ctx.Context, ctx.Int("parallel"), len(sourceIDs), func(c context.Context, i int) error {
+		source, err := services.SourceService.Get(c, sourceIDs[i])
+		sources[i] = source
 		return err
+	})
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundError(fmt.Sprintf("Source '%s' not found", sourceIDs[i]),
+				"List sources with 'onb sources list'")
+		}
+		return nil, errors.WrapAPIError(err, fmt.Sprintf("Failed to get source %s", sourceIDs[i]),
+			"Check source ID and permissions")
 	}
+	return sources, nil
+}
+
+// sharedSourceWarning names the notebooks that lose a source used by
+// several of them
+func sharedSourceWarning(sourceID string, source *models.Source) string {
+	return fmt.Sprintf("Source '%s' is used by %d notebooks: %s",
+		sourceID, len(source.Notebooks), strings.Join(source.Notebooks, ", "))
+}
+
+// preferredExtensions overrides the alphabetical choice of mime.ExtensionsByType for common types
+var preferredExtensions = map[string]string{
+	"text/plain":    ".txt",
+	"text/html":     ".html",
+	"text/markdown": ".md",
+}
 
+// defaultDownloadName picks an output file name from the download metadata,
+// falling back to the source ID with an extension derived from the content type
+func defaultDownloadName(sourceID string, metadata *models.DownloadMetadata) string {
+	if metadata.Filename != "" && metadata.Filename != "." && metadata.Filename != "/" {
+		return metadata.Filename
+	}
+
+	name := strings.ReplaceAll(sourceID, ":", "_")
+	if extension, ok := preferredExtensions[metadata.ContentType]; ok {
+		return name + extension
+	}
+	if metadata.ContentType != "" {
+		if extensions, err := mime.ExtensionsByType(metadata.ContentType); err == nil && len(extensions) > 0 {
+			return name + extensions[0]
+		}
+	}
+	return name + "_downloaded_file"
+}
+
+// handleSourcesDownload handles source file downloads
+func handleSourcesDownload(ctx *cli.Context) error {
 	services, err := getSourcesServices(ctx)
 	if err != nil {
 		return err
 	}
 
-	outputPath := ctx.String("output")
-	if outputPath == "" {
-		outputPath = sourceID + "_downloaded_file"
+	sourceIDs := ctx.Args().Slice()
+	if ctx.Bool("all") {
+		if len(sourceIDs) > 0 {
+			return errors.UsageError("--all cannot be combined with source IDs")
+		}
+		sourceIDs, err = listDownloadableSourceIDs(ctx.Context, services)
+		if err != nil {
+			return errors.WrapAPIError(err, "Failed to list sources",
+				"Check API connection and permissions")
+		}
+		if len(sourceIDs) == 0 {
+			utils.Status("No sources with downloadable files found.")
+			return nil
+		}
+	}
+
+	switch len(sourceIDs) {
+	case 0:
+		return errors.MissingArgument("source ID", ctx.Command.Name)
+	case 1:
+		if !ctx.Bool("all") {
+			return downloadSingleSource(ctx, services, sourceIDs[0])
+		}
 	}
 
+	return downloadSources(ctx, services, sourceIDs)
+}
+
+// downloadSingleSource downloads one source file with a progress display
+func downloadSingleSource(ctx *cli.Context, services *SourcesServices, sourceID string) error {
 	services.Logger.Info("Downloading source file", "source_id", sourceID)
 
-	reader, err := services.SourceService.Download(ctx.Context, sourceID)
+	reader, metadata, err := services.SourceService.Download(ctx.Context, sourceID)
 	if err != nil {
-		return errors.APIError("Failed to download source file",
+		return errors.WrapAPIError(err, "Failed to download source file",
 			"Check source ID and permissions")
 	}
 	defer reader.Close()
 
-	// Create output file
-	file, err := os.Create(outputPath)
-	if err != nil {
-		return errors.ValidationError("Failed to create output file",
-			fmt.Sprintf("Could not create file '%s': %v", outputPath, err))
+	outputPath := ctx.String("output")
+	if outputPath == "" {
+		outputPath = defaultDownloadName(sourceID, metadata)
 	}
-	defer file.Close()
 
-	// Copy downloaded content to file
-	_, err = io.Copy(file, reader)
+	err = saveDownload(outputPath, ctx.Bool("force"), func(file io.Writer) error {
+		progress := utils.NewProgressWriter(file, metadata.Size, style.Icon("📥", "Downloading"))
+		_, err := io.Copy(progress, reader)
+		progress.Finish()
+		return err
+	})
+	if stderrors.Is(err, errOutputExists) {
+		return errors.ValidationError("Output file already exists",
+			fmt.Sprintf("Use --force to overwrite '%s' or choose another path with --output", outputPath))
+	}
 	if err != nil {
 		return errors.ValidationError("Failed to save downloaded content",
-			fmt.Sprintf("Error writing to file: %v", err))
+			fmt.Sprintf("Error writing to file '%s': %v", outputPath, err))
 	}
 
-	fmt.Printf("✅ File downloaded successfully to: %s\n", outputPath)
+	utils.Statusf(style.OK("File downloaded successfully to: %s\n"), outputPath)
 	return nil
 }
 
+// downloadSources downloads several source files concurrently into the
+// directory given by --output (default: current directory)
+func downloadSources(ctx *cli.Context, services *SourcesServices, sourceIDs []string) error {
+	dir := ctx.String("output")
+	if dir == "" {
+		dir = "."
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.ValidationError("Failed to create output directory",
+			fmt.Sprintf("Could not create directory '%s': %v", dir, err))
+	}
+
+	utils.Statusf(style.Icon("📥", "Downloading %d source files to %s\n"), len(sourceIDs), dir)
+
+	names := newDownloadNames()
+	paths := make([]string, len(sourceIDs))
+	errs := utils.ForEach(ctx.Context, ctx.Int("parallel"), len(sourceIDs), func(c context.Context, i int) error {
+		services.Logger.Info("Downloading source file", "source_id", sourceIDs[i])
+
+		reader, metadata, err := services.SourceService.Download(c, sourceIDs[i])
+		if err != nil {
+			return err
+		}
+		defer reader.Close()
+
+		paths[i] = filepath.Join(dir, names.reserve(defaultDownloadName(sourceIDs[i], metadata)))
+		return saveDownload(paths[i], ctx.Bool("force"), func(file io.Writer) error {
+			_, err := io.Copy(file, reader)
+			return err
+		})
+	})
+
+	failed := 0
+	for i, sourceID := range sourceIDs {
+		if errs[i] != nil {
+			fmt.Printf(style.Error("Failed to download source %s: %v\n"), sourceID, errs[i])
+			failed++
+			continue
+		}
+		utils.Statusf(style.OK("Downloaded %s to %s\n"), sourceID, paths[i])
+	}
+
+	if failed > 0 {
+		return errors.APIError(fmt.Sprintf("Failed to download %d of %d sources", failed, len(sourceIDs)),
+			"Check source IDs and permissions")
+	}
+	return nil
+}
+
+// listDownloadableSourceIDs pages through all sources and returns the IDs of
+// those that have a file available for download
+func listDownloadableSourceIDs(ctx context.Context, services *SourcesServices) ([]string, error) {
+	const pageSize = 100
+
+	var ids []string
+	for offset := 0; ; offset += pageSize {
+		sources, err := services.SourceService.List(ctx, pageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		for _, source := range sources {
+			if source.ID != nil && source.FileAvailable != nil && *source.FileAvailable {
+				ids = append(ids, *source.ID)
+			}
+		}
+		if len(sources) < pageSize {
+			return ids, nil
+		}
+	}
+}
+
 // handleSourcesStatus handles source status checking
 func handleSourcesStatus(ctx *cli.Context) error {
 	sourceID, err := validateSourceArgs(ctx, true)
@@ -460,14 +1359,17 @@ func handleSourcesStatus(ctx *cli.Context) error {
 	}
 
 	watch := ctx.Bool("watch")
+	if watch {
+		stopPager()
+	}
 
-	fmt.Printf("📊 Getting source status: %s\n", sourceID)
+	utils.Statusf(style.Icon("📊", "Getting source status: %s\n"), sourceID)
 
 	services.Logger.Info("Checking source status", "source_id", sourceID)
 
 	status, err := services.SourceService.GetStatus(ctx.Context, sourceID)
 	if err != nil {
-		return errors.APIError("Failed to get source status",
+		return errors.WrapAPIError(err, "Failed to get source status",
 			"Check source ID and permissions")
 	}
 
@@ -484,20 +1386,283 @@ func handleSourcesStatus(ctx *cli.Context) error {
 		displayProcessingInfo(convertProcessingInfo(status.ProcessingInfo))
 	}
 
-	if watch {
-		fmt.Println("   Watching for status updates... (Press Ctrl+C to stop)")
-		// Simple polling implementation
-		for i := 0; i < 10; i++ { // Watch for 10 iterations
-			time.Sleep(2 * time.Second)
-			fmt.Printf("   Checking status... (%d/10)\n", i+1)
-			// In a real implementation, this would poll the API
+	if watch && !isSourceTerminal(status) {
+		utils.Status("   " + style.Icon("🔄", "Watching for status updates... (Press Ctrl+C to stop)"))
+		status, err = pollSourceStatus(ctx.Context, services, sourceID, status, ctx.Duration("interval"),
+			newStallDetector(ctx.Duration("stall-timeout")))
+		if err != nil {
+			if ctx.Context.Err() != nil {
+				utils.Status("   Watch stopped")
+				return nil
+			}
+			if stderrors.Is(err, errStalled) {
+				return sourceStalledError(sourceID, ctx.Duration("stall-timeout"))
+			}
+			return errors.WrapAPIError(err, "Failed to get source status",
+				"Check source ID and permissions")
+		}
+		utils.Statusf("   Source finished with status: %s\n", sourceStatusString(status))
+	}
+
+	if watch && status.Status != nil && *status.Status == models.SourceStatusFailed {
+		return sourceFailedError(sourceID, status)
+	}
+
+	return nil
+}
+
+// handleSourcesWait blocks until source processing completes or fails
+func handleSourcesWait(ctx *cli.Context) error {
+	if ctx.IsSet("ids") || ctx.IsSet("notebook") || ctx.IsSet("notebook-id") {
+		return handleSourcesWaitMany(ctx)
+	}
+
+	sourceID, err := validateSourceArgs(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	services, err := getSourcesServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	return awaitSource(ctx, services, sourceID)
+}
+
+// awaitSource waits for sourceID to finish processing within --timeout and
+// fails unless it completed
+func awaitSource(ctx *cli.Context, services *SourcesServices, sourceID string) error {
+	stopPager()
+	waitCtx, cancel := sourceWaitContext(ctx)
+	defer cancel()
+
+	services.Logger.Info("Waiting for source processing", "source_id", sourceID)
+	utils.Statusf(style.Icon("⏳", "Waiting for source %s to finish processing...\n"), sourceID)
+
+	status, err := waitForSource(waitCtx, ctx, services, sourceID)
+	if stderrors.Is(err, errStalled) {
+		return sourceStalledError(sourceID, ctx.Duration("stall-timeout"))
+	}
+	if err != nil {
+		if waitCtx.Err() == context.DeadlineExceeded {
+			return errors.NetworkError("Timed out waiting for source processing",
+				fmt.Sprintf("The source is still processing. Use 'onb sources status %s' to check progress", sourceID))
+		}
+		return errors.WrapAPIError(err, "Failed to get source status",
+			"Check source ID and permissions")
+	}
+
+	if *status.Status == models.SourceStatusFailed {
+		return sourceFailedError(sourceID, status)
+	}
+
+	utils.Status(style.OK(fmt.Sprintf("Source %s processed successfully", sourceID)))
+	return nil
+}
+
+// sourceWaitResult is the final state of one source in 'sources wait'
+type sourceWaitResult struct {
+	ID      string `json:"id"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// handleSourcesWaitMany waits for several sources, given by --ids or by
+// notebook, concurrently and prints the final status of each
+func handleSourcesWaitMany(ctx *cli.Context) error {
+	stopPager()
+	services, err := getSourcesServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := rejectTrailingFlags(ctx, "source IDs"); err != nil {
+		return err
+	}
+
+	sourceIDs := append(ctx.Args().Slice(), ctx.StringSlice("ids")...)
+	notebookID, err := resolveNotebookFlag(ctx, services.NotebookService)
+	if err != nil {
+		return err
+	}
+	if notebookID != "" {
+		sources, err := services.SourceService.ListAllByNotebook(ctx.Context, notebookID)
+		if err != nil {
+			return errors.WrapAPIError(err, "Failed to list notebook sources",
+				"Check the notebook ID and API connection")
+		}
+		for _, source := range sources {
+			sourceIDs = append(sourceIDs, utils.SafeDereferenceString(source.ID))
+		}
+	}
+	seen := make(map[string]bool, len(sourceIDs))
+	sourceIDs = slices.DeleteFunc(sourceIDs, func(id string) bool {
+		duplicate := seen[id]
+		seen[id] = true
+		return id == "" || duplicate
+	})
+	if len(sourceIDs) == 0 {
+		utils.Status("No sources to wait for.")
+		return nil
+	}
+
+	waitCtx, cancel := sourceWaitContext(ctx)
+	defer cancel()
+
+	services.Logger.Info("Waiting for source processing", "sources", len(sourceIDs))
+	utils.Statusf(style.Icon("⏳", "Waiting for %d sources to finish processing...\n"), len(sourceIDs))
+
+	results := make([]sourceWaitResult, len(sourceIDs))
+	for i, id := range sourceIDs {
+		// Sources not polled before the timeout keep this result
+		results[i] = sourceWaitResult{ID: id, Status: "timeout", Message: "not checked"}
+	}
+	utils.ForEach(waitCtx, utils.DefaultParallelism, len(sourceIDs), func(c context.Context, i int) error {
+		status, err := waitForSource(c, ctx, services, sourceIDs[i])
+		results[i] = sourceWaitResult{ID: sourceIDs[i], Status: sourceStatusString(status)}
+		switch {
+		case err == nil:
+			results[i].Message = status.Message
+		case stderrors.Is(err, errStalled):
+			results[i].Status = "stalled"
+			results[i].Message = fmt.Sprintf("no progress for %s", ctx.Duration("stall-timeout"))
+		case waitCtx.Err() == context.DeadlineExceeded:
+			results[i].Status = "timeout"
+			results[i].Message = "still processing"
+		default:
+			results[i].Status = "error"
+			results[i].Message = err.Error()
+		}
+		return err
+	})
+
+	table := render.NewTable("ID", "STATUS", "MESSAGE")
+	failed := 0
+	for _, result := range results {
+		if result.Status != string(models.SourceStatusCompleted) {
+			failed++
+		}
+		table.AddRow(result.ID, result.Status, result.Message)
+	}
+	if err := renderList(ctx, results, table); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		if waitCtx.Err() == context.DeadlineExceeded {
+			return errors.NetworkError(fmt.Sprintf("%d of %d sources did not finish processing successfully", failed, len(results)),
+				"Some sources are still processing; raise --timeout or check them with 'onb sources status <id>'")
 		}
-		fmt.Println("   Watch completed")
+		return errors.APIError(fmt.Sprintf("%d of %d sources did not finish processing successfully", failed, len(results)),
+			"Use 'onb sources retry <id>' to reprocess failed sources")
 	}
 
+	utils.Status(style.OK(fmt.Sprintf("All %d sources processed successfully", len(results))))
 	return nil
 }
 
+// sourceWaitContext bounds ctx by the --timeout of 'sources wait' and
+// 'sources add --watch'
+func sourceWaitContext(ctx *cli.Context) (context.Context, context.CancelFunc) {
+	if timeout := ctx.Duration("timeout"); timeout > 0 {
+		return context.WithTimeout(ctx.Context, timeout)
+	}
+	return context.WithCancel(ctx.Context)
+}
+
+// waitForSource polls the status of sourceID with the --interval and
+// --stall-timeout of c until processing finishes
+func waitForSource(ctx context.Context, c *cli.Context, services *SourcesServices, sourceID string) (*models.SourceStatusResponse, error) {
+	status, err := services.SourceService.GetStatus(ctx, sourceID)
+	if err != nil || isSourceTerminal(status) {
+		return status, err
+	}
+	return pollSourceStatus(ctx, services, sourceID, status, c.Duration("interval"),
+		newStallDetector(c.Duration("stall-timeout")))
+}
+
+// isSourceTerminal reports whether source processing has finished
+func isSourceTerminal(status *models.SourceStatusResponse) bool {
+	if status == nil || status.Status == nil {
+		return false
+	}
+	return *status.Status == models.SourceStatusCompleted || *status.Status == models.SourceStatusFailed
+}
+
+// sourceStatusString returns the status value or "unknown" when not reported
+func sourceStatusString(status *models.SourceStatusResponse) string {
+	if status == nil || status.Status == nil {
+		return "unknown"
+	}
+	return string(*status.Status)
+}
+
+// pollSourceStatus polls GetStatus at the given interval until processing
+// finishes, printing a line whenever the status changes. It returns the
+// final status, or an error if polling fails, ctx is done or stall fires
+// (errStalled).
+func pollSourceStatus(ctx context.Context, services *SourcesServices, sourceID string, current *models.SourceStatusResponse, interval time.Duration, stall *stallDetector) (*models.SourceStatusResponse, error) {
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	stall.Observe(sourceProgressKey(current))
+
+	for !isSourceTerminal(current) {
+		select {
+		case <-ctx.Done():
+			return current, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		updated, err := services.SourceService.GetStatus(ctx, sourceID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return current, ctx.Err()
+			}
+			return current, err
+		}
+
+		if sourceStatusString(updated) != sourceStatusString(current) {
+			utils.Statusf("   Status of %s changed: %s %s %s\n", sourceID,
+				sourceStatusString(current), style.Symbol("→", "->"), sourceStatusString(updated))
+		}
+		current = updated
+
+		if !isSourceTerminal(current) && stall.Observe(sourceProgressKey(current)) {
+			return current, errStalled
+		}
+	}
+
+	return current, nil
+}
+
+// sourceProgressKey captures the parts of a source status that change as
+// processing advances
+func sourceProgressKey(status *models.SourceStatusResponse) string {
+	if status == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s|%s|%v", sourceStatusString(status), status.Message, status.ProcessingInfo)
+}
+
+// sourceStalledError builds the error returned when source processing made
+// no progress within the stall timeout
+func sourceStalledError(sourceID string, timeout time.Duration) error {
+	return errors.APIError(fmt.Sprintf("Processing of source '%s' made no progress for %s", sourceID, timeout),
+		fmt.Sprintf("Use 'onb sources retry %s' to reprocess it", sourceID),
+		"Raise --stall-timeout if processing legitimately pauses between updates")
+}
+
+// sourceFailedError builds the error returned when source processing failed
+func sourceFailedError(sourceID string, status *models.SourceStatusResponse) error {
+	if status.Message != "" {
+		return errors.APIError(fmt.Sprintf("Processing of source '%s' failed", sourceID), status.Message)
+	}
+	return errors.APIError(fmt.Sprintf("Processing of source '%s' failed", sourceID),
+		fmt.Sprintf("Use 'onb sources retry %s' to reprocess it", sourceID))
+}
+
 // handleSourcesRetry handles source processing retry
 func handleSourcesRetry(ctx *cli.Context) error {
 	sourceID, err := validateSourceArgs(ctx, true)
@@ -515,18 +1680,18 @@ func handleSourcesRetry(ctx *cli.Context) error {
 	services.Logger.Info("Retrying source processing", "source_id", sourceID)
 
 	if force {
-		fmt.Println("   Force reprocessing enabled")
+		utils.Status("   Force reprocessing enabled")
 	}
 
 	// Get current source to check status
 	source, err := services.SourceService.Get(ctx.Context, sourceID)
 	if err != nil {
-		return errors.APIError("Failed to get source details",
+		return errors.WrapAPIError(err, "Failed to get source details",
 			"Check source ID and permissions")
 	}
 
 	if source.Status != nil && *source.Status == models.SourceStatusCompleted {
-		fmt.Printf("Source '%s' is already completed. No retry needed.\n", sourceID)
+		utils.Statusf("Source '%s' is already completed. No retry needed.\n", sourceID)
 		return nil
 	}
 
@@ -564,11 +1729,11 @@ func handleSourcesRetry(ctx *cli.Context) error {
 
 	retriedSource, err := services.SourceService.Create(ctx.Context, retrySource)
 	if err != nil {
-		return errors.APIError("Failed to retry source processing",
+		return errors.WrapAPIError(err, "Failed to retry source processing",
 			"Check API permissions")
 	}
 
-	fmt.Printf("✅ Source processing retry initiated!\n")
+	utils.Status(style.OK("Source processing retry initiated!"))
 	fmt.Printf("  New ID:  %s\n", utils.SafeDereferenceString(retriedSource.ID))
 	if retriedSource.Status != nil {
 		fmt.Printf("  Status:  %s\n", string(*retriedSource.Status))
@@ -577,6 +1742,165 @@ func handleSourcesRetry(ctx *cli.Context) error {
 	return nil
 }
 
+// reembedTarget is a source considered for re-embedding
+type reembedTarget struct {
+	ID       string
+	Embedded bool
+}
+
+// handleSourcesReembed queues new embeddings for sources and reports the
+// resulting command IDs
+func handleSourcesReembed(ctx *cli.Context) error {
+	notebookID := ctx.String("notebook")
+	sourceIDs := ctx.Args().Slice()
+	if notebookID == "" && len(sourceIDs) == 0 {
+		return errors.UsageError("Missing source ID or --notebook",
+			"Usage: onb sources reembed <source-id> [source-id...] or onb sources reembed --notebook <id>")
+	}
+	if notebookID != "" && len(sourceIDs) > 0 {
+		return errors.UsageError("Source IDs and --notebook cannot be combined")
+	}
+
+	services, err := getSourcesServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	force := ctx.Bool("force")
+	async := ctx.Bool("async")
+
+	var targets []reembedTarget
+	if notebookID != "" {
+		sources, err := services.SourceService.ListAllByNotebook(ctx.Context, notebookID)
+		if err != nil {
+			return errors.WrapAPIError(err, "Failed to list notebook sources",
+				"Check notebook ID and permissions")
+		}
+		for _, source := range sources {
+			targets = append(targets, reembedTarget{ID: utils.SafeDereferenceString(source.ID), Embedded: source.Embedded})
+		}
+	} else {
+		for _, sourceID := range sourceIDs {
+			target := reembedTarget{ID: sourceID}
+			if !force {
+				source, err := services.SourceService.Get(ctx.Context, sourceID)
+				if err != nil {
+					return errors.WrapAPIError(err, fmt.Sprintf("Failed to get source '%s'", sourceID),
+						"Check source ID and permissions")
+				}
+				target.Embedded = source.Embedded
+			}
+			targets = append(targets, target)
+		}
+	}
+
+	var pending []reembedTarget
+	for _, target := range targets {
+		if target.Embedded && !force {
+			utils.Statusf(style.Warn("Skipped %s (already embedded, use --force to re-embed)\n"), target.ID)
+			continue
+		}
+		pending = append(pending, target)
+	}
+
+	if len(pending) == 0 {
+		utils.Status("No sources to re-embed.")
+		return nil
+	}
+
+	services.Logger.Info("Re-embedding sources", "count", len(pending), "async", async)
+	utils.Statusf(style.Icon("🧬", "Re-embedding %d sources...\n"), len(pending))
+
+	responses := make([]*models.EmbedResponse, len(pending))
+	errs := utils.ForEach(ctx.Context, ctx.Int("parallel"), len(pending), func(c context.Context, i int) error {
+		response, err := services.EmbeddingService.EmbedItem(c, pending[i].ID, string(models.ItemTypeSource), async)
+		responses[i] = response
+		return err
+	})
+
+	var commandIDs []string
+	failed := 0
+	for i, target := range pending {
+		if errs[i] != nil {
+			fmt.Printf(style.Error("Failed to re-embed %s: %v\n"), target.ID, errs[i])
+			failed++
+			continue
+		}
+		if commandID := utils.SafeDereferenceString(responses[i].CommandID); commandID != "" {
+			utils.Statusf(style.OK("Queued %s (command: %s)\n"), target.ID, commandID)
+			commandIDs = append(commandIDs, commandID)
+		} else {
+			utils.Statusf(style.OK("Re-embedded %s\n"), target.ID)
+		}
+	}
+
+	if len(commandIDs) > 0 {
+		if ctx.Bool("wait") {
+			if err := waitForJobs(ctx.Context, services.JobService, commandIDs); err != nil {
+				return err
+			}
+		} else {
+			utils.Statusf("\nFollow progress with 'onb jobs status <command-id>' or 'onb jobs list --watch'\n")
+		}
+	}
+
+	if failed > 0 {
+		return errors.APIError(fmt.Sprintf("Failed to re-embed %d of %d sources", failed, len(pending)),
+			"Check source IDs and the embedding model configuration")
+	}
+	return nil
+}
+
+// waitForJobs polls the job status of commandIDs until every job finished,
+// returning an error when any of them failed
+func waitForJobs(ctx context.Context, jobService shared.JobService, commandIDs []string) error {
+	stopPager()
+	spinner := utils.NewSpinner(fmt.Sprintf("Waiting for jobs (0/%d done)", len(commandIDs)))
+	defer spinner.Stop()
+
+	final := make(map[string]string, len(commandIDs))
+	for len(final) < len(commandIDs) {
+		for _, commandID := range commandIDs {
+			if _, done := final[commandID]; done {
+				continue
+			}
+			job, err := jobService.GetJobStatus(ctx, commandID)
+			if err != nil {
+				return errors.WrapAPIError(err, fmt.Sprintf("Failed to get status of job '%s'", commandID),
+					"Check the job with 'onb jobs status'")
+			}
+			if isJobTerminal(job.Status) {
+				final[commandID] = job.Status
+			}
+		}
+		spinner.SetLabel(fmt.Sprintf("Waiting for jobs (%d/%d done)", len(final), len(commandIDs)))
+		if len(final) == len(commandIDs) {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.NetworkError("Stopped waiting for jobs", "Check them with 'onb jobs list'")
+		case <-time.After(2 * time.Second):
+		}
+	}
+	spinner.Stop()
+
+	failed := 0
+	for _, commandID := range commandIDs {
+		if final[commandID] != "completed" {
+			fmt.Printf(style.Error("Job %s %s\n"), commandID, final[commandID])
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.APIError(fmt.Sprintf("%d of %d jobs did not complete", failed, len(commandIDs)),
+			"Inspect them with 'onb jobs status <command-id>'")
+	}
+	utils.Statusf(style.OK("All %d jobs completed\n"), len(commandIDs))
+	return nil
+}
+
 // handleSourcesInsightsList handles listing insights for a source
 func handleSourcesInsightsList(ctx *cli.Context) error {
 	sourceID, err := validateSourceArgs(ctx, true)
@@ -589,18 +1913,18 @@ func handleSourcesInsightsList(ctx *cli.Context) error {
 		return err
 	}
 
-	fmt.Printf("💡 Listing insights for source: %s\n", sourceID)
+	utils.Statusf(style.Icon("💡", "Listing insights for source: %s\n"), sourceID)
 
 	services.Logger.Info("Listing source insights", "source_id", sourceID)
 
 	insights, err := services.SourceService.GetInsights(ctx.Context, sourceID)
 	if err != nil {
-		return errors.APIError("Failed to list source insights",
+		return errors.WrapAPIError(err, "Failed to list source insights",
 			"Check source ID and permissions")
 	}
 
 	if len(insights) == 0 {
-		fmt.Printf("No insights found for source '%s'.\n", sourceID)
+		utils.Statusf("No insights found for source '%s'.\n", sourceID)
 		return nil
 	}
 
@@ -619,7 +1943,7 @@ func handleSourcesInsightsList(ctx *cli.Context) error {
 
 	w.Flush()
 
-	fmt.Printf("\nFound %d insights for source '%s'\n", len(insights), sourceID)
+	utils.Statusf("\nFound %d insights for source '%s'\n", len(insights), sourceID)
 	return nil
 }
 
@@ -641,8 +1965,8 @@ func handleSourcesInsightsCreate(ctx *cli.Context) error {
 			"Use --content flag to specify the insight content")
 	}
 
-	fmt.Printf("💭 Creating insight for source: %s\n", sourceID)
-	fmt.Printf("   Content: %s\n", content)
+	utils.Statusf(style.Icon("💭", "Creating insight for source: %s\n"), sourceID)
+	utils.Statusf("   Content: %s\n", content)
 
 	services.Logger.Info("Creating source insight", "source_id", sourceID)
 
@@ -655,11 +1979,11 @@ func handleSourcesInsightsCreate(ctx *cli.Context) error {
 
 	createdInsight, err := services.SourceService.CreateInsight(ctx.Context, sourceID, request)
 	if err != nil {
-		return errors.APIError("Failed to create source insight",
+		return errors.WrapAPIError(err, "Failed to create source insight",
 			"Check source ID, transformation ID and permissions")
 	}
 
-	fmt.Printf("✅ Insight created successfully!\n")
+	utils.Status(style.OK("Insight created successfully!"))
 	fmt.Printf("  ID:      %s\n", createdInsight.ID)
 	fmt.Printf("  Type:    %s\n", string(createdInsight.InsightType))
 	fmt.Printf("  Content: %s\n", utils.TruncateString(createdInsight.Content, 100))
@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/mocks"
+	"github.com/denkhaus/open-notebook-cli/pkg/services"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+// newSourcesTestServices wires the source service against client
+func newSourcesTestServices(t *testing.T, client *mocks.MockHTTPClient) *SourcesServices {
+	t.Helper()
+
+	injector := do.New()
+	do.ProvideValue[shared.HTTPClient](injector, client)
+	do.ProvideValue[shared.Logger](injector, mocks.NewMockLogger(false))
+	do.Provide(injector, services.NewSourceRepository)
+	do.Provide(injector, services.NewSourceService)
+
+	return &SourcesServices{
+		SourceService: do.MustInvoke[shared.SourceService](injector),
+		Logger:        do.MustInvoke[shared.Logger](injector),
+	}
+}
+
+func TestGetSourcesToDelete(t *testing.T) {
+	client := mocks.NewMockHTTPClient()
+	client.SetJSONResponse("GET", "/sources/source:a", `{"id":"source:a","notebooks":["notebook:1","notebook:2"]}`)
+	svc := newSourcesTestServices(t, client)
+
+	sources, err := getSourcesToDelete(newTestContext(t, sourcesDeleteCommand().Flags), svc, []string{"source:a"})
+	require.NoError(t, err)
+	require.Len(t, sources, 1)
+	assert.Equal(t, []string{"notebook:1", "notebook:2"}, sources[0].Notebooks)
+}
+
+func TestGetSourcesToDelete_NotFound(t *testing.T) {
+	client := mocks.NewMockHTTPClient()
+	client.SetJSONResponse("GET", "/sources/source:a", `{"id":"source:a"}`)
+	svc := newSourcesTestServices(t, client)
+
+	// The mock answers source:missing with a 404
+	_, err := getSourcesToDelete(newTestContext(t, sourcesDeleteCommand().Flags), svc, []string{"source:a", "source:missing"})
+	require.Error(t, err)
+
+	cliErr, ok := err.(*errors.CLIError)
+	require.True(t, ok, "got %T", err)
+	assert.Equal(t, errors.ErrorTypeNotFound, cliErr.Type)
+	assert.Contains(t, cliErr.Message, "source:missing")
+}
+
+// runSourcesWait runs 'sources wait' with args, calling check with the parsed
+// context instead of waiting
+func runSourcesWait(t *testing.T, check func(ctx *cli.Context) error, args ...string) error {
+	t.Helper()
+
+	command := sourcesWaitCommand()
+	command.Action = check
+	app := &cli.App{Commands: []*cli.Command{command}}
+	return app.Run(append([]string{"onb", "wait"}, args...))
+}
+
+func TestSourcesWait_DocumentedFlagOrder(t *testing.T) {
+	err := runSourcesWait(t, func(ctx *cli.Context) error {
+		sourceID, err := validateSourceArgs(ctx, true)
+		require.NoError(t, err)
+		assert.Equal(t, "source:1", sourceID)
+		assert.Equal(t, 5*time.Minute, ctx.Duration("timeout"))
+		return nil
+	}, "--timeout", "5m", "source:1")
+	require.NoError(t, err)
+}
+
+func TestSourcesWait_RejectsTrailingFlags(t *testing.T) {
+	err := runSourcesWait(t, func(ctx *cli.Context) error {
+		_, err := validateSourceArgs(ctx, true)
+		return err
+	}, "source:1", "--timeout", "5m")
+
+	cliErr, ok := err.(*errors.CLIError)
+	require.True(t, ok, "got %T", err)
+	assert.Contains(t, cliErr.Message, "--timeout must come before the source ID")
+	assert.Equal(t, []string{"Use: onb sources wait --timeout 5m source:1"}, cliErr.Suggestions)
+}
+
+func TestSourceWaitContext_AddWatchTimeout(t *testing.T) {
+	tests := map[string]struct {
+		args []string
+		want time.Duration
+	}{
+		"default":     {[]string{"--watch"}, 5 * time.Minute},
+		"as duration": {[]string{"--watch", "--timeout", "90s"}, 90 * time.Second},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			waitCtx, cancel := sourceWaitContext(newTestContext(t, sourcesAddCommand().Flags, tt.args...))
+			defer cancel()
+
+			deadline, ok := waitCtx.Deadline()
+			require.True(t, ok)
+			assert.InDelta(t, tt.want.Seconds(), time.Until(deadline).Seconds(), 1)
+		})
+	}
+
+	waitCtx, cancel := sourceWaitContext(newTestContext(t, sourcesAddCommand().Flags, "--watch", "--timeout", "0"))
+	defer cancel()
+	_, ok := waitCtx.Deadline()
+	assert.False(t, ok, "--timeout 0 waits indefinitely")
+}
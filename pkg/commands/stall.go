@@ -0,0 +1,73 @@
+package commands
+
+import (
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// errStalled is returned by watch loops whose stallDetector fired
+var errStalled = stderrors.New("no progress within the stall timeout")
+
+// stallTimeoutFlag returns the --stall-timeout flag of watch loops
+func stallTimeoutFlag() cli.Flag {
+	return &cli.DurationFlag{
+		Name:  "stall-timeout",
+		Usage: "Fail when progress does not advance for this long while watching, independent of the overall timeout (0 disables)",
+	}
+}
+
+// stallDetector notices a watched operation that stopped making progress.
+// Unlike an overall timeout it fires only when nothing changed for the whole
+// window, so long but steadily advancing operations are not cut short.
+type stallDetector struct {
+	timeout  time.Duration
+	progress string
+	since    time.Time
+}
+
+// newStallDetector returns a detector for timeout, or nil when timeout is not
+// positive. A nil detector never reports a stall.
+func newStallDetector(timeout time.Duration) *stallDetector {
+	if timeout <= 0 {
+		return nil
+	}
+	return &stallDetector{timeout: timeout, since: time.Now()}
+}
+
+// Observe records progress, any value that changes whenever the operation
+// advances, and reports whether it has been unchanged for longer than the
+// timeout
+func (d *stallDetector) Observe(progress string) bool {
+	if d == nil {
+		return false
+	}
+	if progress != d.progress {
+		d.progress = progress
+		d.since = time.Now()
+		return false
+	}
+	return time.Since(d.since) > d.timeout
+}
+
+// Timeout returns the stall window for messages
+func (d *stallDetector) Timeout() time.Duration {
+	if d == nil {
+		return 0
+	}
+	return d.timeout
+}
+
+// jobProgressKey captures the parts of a job status that change as it advances
+func jobProgressKey(status string, progress *float64, message *string) string {
+	key := status
+	if progress != nil {
+		key += fmt.Sprintf("|%f", *progress)
+	}
+	if message != nil {
+		key += "|" + *message
+	}
+	return key
+}
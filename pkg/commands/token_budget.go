@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
+)
+
+// tokenWarnRatio is the share of a token limit above which a warning is shown
+const tokenWarnRatio = 0.8
+
+// contextItem is one source or note of an assembled context with its size
+type contextItem struct {
+	ID     string
+	Title  string
+	Tokens int
+}
+
+// label returns the title and ID of the item for display
+func (c contextItem) label() string {
+	if c.Title == "" {
+		return c.ID
+	}
+	return fmt.Sprintf("%s (%s)", c.Title, c.ID)
+}
+
+// contextItems converts the loosely typed context entries of the API. Token
+// counts reported by the server are used when present, otherwise they are
+// estimated from the content.
+func contextItems(entries []map[string]any) []contextItem {
+	items := make([]contextItem, 0, len(entries))
+	for _, entry := range entries {
+		item := contextItem{}
+		item.ID, _ = entry["id"].(string)
+		item.Title, _ = entry["title"].(string)
+
+		for _, key := range []string{"token_count", "tokens"} {
+			if n, ok := entry[key].(float64); ok {
+				item.Tokens = int(n)
+				break
+			}
+		}
+		if item.Tokens == 0 {
+			for _, key := range []string{"content", "full_text", "text"} {
+				if text, ok := entry[key].(string); ok && text != "" {
+					item.Tokens = utils.EstimateTokens(text)
+					break
+				}
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// contextTotalTokens returns the token total the server reported for a
+// context, or the sum of the item sizes when it did not report one
+func contextTotalTokens(resp *models.ContextResponse, items []contextItem) int {
+	if resp.TotalTokens != nil {
+		return *resp.TotalTokens
+	}
+	total := 0
+	for _, item := range items {
+		total += item.Tokens
+	}
+	return total
+}
+
+// droppedContextItems returns the items that no longer fit once maxTokens is
+// used up, keeping items in the order the server assembled them
+func droppedContextItems(items []contextItem, maxTokens int) []contextItem {
+	var dropped []contextItem
+	used := 0
+	for _, item := range items {
+		if used+item.Tokens > maxTokens {
+			dropped = append(dropped, item)
+			continue
+		}
+		used += item.Tokens
+	}
+	return dropped
+}
+
+// printTokenBudget prints a token count against an optional limit, warning
+// when the count approaches or exceeds it
+func printTokenBudget(label string, tokens, limit int) {
+	if limit <= 0 {
+		utils.Statusf("  %s: ~%d tokens\n", label, tokens)
+		return
+	}
+
+	line := fmt.Sprintf("%s: ~%d of %d tokens (%.0f%%)", label, tokens, limit, 100*float64(tokens)/float64(limit))
+	switch {
+	case tokens > limit:
+		utils.Status("  " + style.Warn(line+" - over the limit"))
+	case float64(tokens) >= tokenWarnRatio*float64(limit):
+		utils.Status("  " + style.Warn(line+" - approaching the limit"))
+	default:
+		utils.Status("  " + line)
+	}
+}
+
+// printTokenUsage prints the token usage a server reported for a response
+func printTokenUsage(usage *models.TokenUsage, limit int) {
+	if usage == nil {
+		return
+	}
+	if usage.ContextTokens > 0 {
+		printTokenBudget("Context used", usage.ContextTokens, limit)
+	}
+	if usage.InputTokens > 0 || usage.OutputTokens > 0 {
+		utils.Statusf("  Tokens: %d in, %d out\n", usage.InputTokens, usage.OutputTokens)
+	}
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
 	"github.com/urfave/cli/v2"
 )
 
@@ -19,14 +20,26 @@ func TransformationsCommand() *cli.Command {
 			"  onb transformations list                     # List all transformations\n" +
 			"  onb transformations create --name summary   # Create new transformation\n" +
 			"  onb transformations execute <id> --text \"sample text\" # Execute transformation\n" +
-			"  onb transformations show <id>               # Show transformation details",
+			"  onb transformations apply <id> --source <source-id> --save-as-note --notebook <id> # Apply to a source\n" +
+			"  onb transformations apply <id> --notebook <id> --parallel 4 # Apply to every source in a notebook\n" +
+			"  onb transformations show <id>               # Show transformation details\n" +
+			"  onb transformations list --defaults-only    # List default transformations\n" +
+			"  onb transformations set-default <id> --off  # Stop applying a transformation by default\n" +
+			"  onb transformations test --prompt-file p.txt --input-file sample.txt --model <id> # Preview a prompt\n" +
+			"  onb transformations export --output transforms.json # Export all transformations\n" +
+			"  onb transformations import transforms.json   # Import transformations from file",
 		Subcommands: []*cli.Command{
 			transformationsListCommand(),
 			transformationsCreateCommand(),
 			transformationsShowCommand(),
 			transformationsUpdateCommand(),
+			transformationsSetDefaultCommand(),
 			transformationsDeleteCommand(),
 			transformationsExecuteCommand(),
+			transformationsApplyCommand(),
+			transformationsTestCommand(),
+			transformationsExportCommand(),
+			transformationsImportCommand(),
 		},
 	}
 }
@@ -49,6 +62,13 @@ func transformationsListCommand() *cli.Command {
 				Usage:   "Number of transformations to skip",
 				Value:   0,
 			},
+			&cli.BoolFlag{
+				Name:  "defaults-only",
+				Usage: "Only list transformations applied by default to new sources",
+			},
+			fieldsFlag(),
+			noHeaderFlag(),
+			countFlag(),
 		},
 		Action: handleTransformationsList,
 	}
@@ -140,6 +160,27 @@ func transformationsUpdateCommand() *cli.Command {
 	}
 }
 
+// transformationsSetDefaultCommand toggles whether a transformation is applied by default
+func transformationsSetDefaultCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "set-default",
+		Usage:     "Turn automatic application to new sources on or off",
+		ArgsUsage: "<transformation-id>",
+		Args:      true,
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "on",
+				Usage: "Apply the transformation to new sources by default",
+			},
+			&cli.BoolFlag{
+				Name:  "off",
+				Usage: "Stop applying the transformation by default",
+			},
+		},
+		Action: handleTransformationsSetDefault,
+	}
+}
+
 // transformationsDeleteCommand deletes a transformation
 func transformationsDeleteCommand() *cli.Command {
 	return &cli.Command{
@@ -184,4 +225,109 @@ func transformationsExecuteCommand() *cli.Command {
 		},
 		Action: handleTransformationsExecute,
 	}
-}
\ No newline at end of file
+}
+
+// transformationsApplyCommand runs a transformation on a source as an insight
+func transformationsApplyCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "apply",
+		Usage:     "Apply a transformation to a source or all sources of a notebook, creating insights",
+		ArgsUsage: "<transformation-id>",
+		Args:      true,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "source",
+				Aliases: []string{"s"},
+				Usage:   "Source ID to apply the transformation to",
+			},
+			&cli.StringFlag{
+				Name:    "model",
+				Aliases: []string{"m"},
+				Usage:   "Model ID to use (optional, uses default if not specified)",
+			},
+			&cli.BoolFlag{
+				Name:  "save-as-note",
+				Usage: "Save the resulting insight as a note (requires --notebook)",
+			},
+			&cli.StringFlag{
+				Name:    "notebook",
+				Aliases: []string{"n"},
+				Usage:   "Notebook ID whose sources to process (without --source) and where notes are saved",
+			},
+			&cli.IntFlag{
+				Name:  "parallel",
+				Usage: "Number of sources to process concurrently when applying to a notebook",
+				Value: utils.DefaultParallelism,
+			},
+			&cli.BoolFlag{
+				Name:    "force",
+				Aliases: []string{"f"},
+				Usage:   "Recreate insights for sources that already have one from this transformation",
+			},
+		},
+		Action: handleTransformationsApply,
+	}
+}
+
+// transformationsTestCommand previews a prompt without saving a transformation
+func transformationsTestCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "test",
+		Usage: "Preview a transformation prompt against sample text without saving it",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "prompt-file",
+				Aliases:  []string{"p"},
+				Usage:    "File containing the transformation prompt template",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     "input-file",
+				Aliases:  []string{"i"},
+				Usage:    "File containing the sample input text",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     "model",
+				Aliases:  []string{"m"},
+				Usage:    "Model ID to run the prompt with",
+				Required: true,
+			},
+		},
+		Action: handleTransformationsTest,
+	}
+}
+
+// transformationsExportCommand exports all transformations to a JSON file
+func transformationsExportCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "export",
+		Usage: "Export all transformations to a JSON file",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "output",
+				Aliases:  []string{"o"},
+				Usage:    "Output file path (use '-' for stdout)",
+				Required: true,
+			},
+		},
+		Action: handleTransformationsExport,
+	}
+}
+
+// transformationsImportCommand imports transformations from a JSON file
+func transformationsImportCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "import",
+		Usage: "Import transformations from a JSON file",
+		Args:  true,
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "overwrite",
+				Usage: "Update transformations whose name already exists instead of skipping them",
+				Value: false,
+			},
+		},
+		Action: handleTransformationsImport,
+	}
+}
@@ -1,9 +1,11 @@
 package commands
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"os"
-	"text/tabwriter"
+	"strings"
 	"time"
 
 	"github.com/denkhaus/open-notebook-cli/pkg/config"
@@ -11,6 +13,8 @@ import (
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
 	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/render"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
 	"github.com/samber/do/v2"
 	"github.com/urfave/cli/v2"
 )
@@ -18,6 +22,8 @@ import (
 // TransformationsServices holds all the services needed for transformation commands
 type TransformationsServices struct {
 	TransformationService shared.TransformationRepository
+	ModelService          shared.ModelService
+	SourceService         shared.SourceService
 	Config                config.Service
 	Logger                shared.Logger
 }
@@ -32,6 +38,8 @@ func getTransformationsServices(ctx *cli.Context) (*TransformationsServices, err
 
 	return &TransformationsServices{
 		TransformationService: do.MustInvoke[shared.TransformationRepository](injector),
+		ModelService:          do.MustInvoke[shared.ModelService](injector),
+		SourceService:         do.MustInvoke[shared.SourceService](injector),
 		Config:                do.MustInvoke[config.Service](injector),
 		Logger:                do.MustInvoke[shared.Logger](injector),
 	}, nil
@@ -41,10 +49,10 @@ func getTransformationsServices(ctx *cli.Context) (*TransformationsServices, err
 func validateTransformationArgs(ctx *cli.Context, requireTransformationID bool) (string, error) {
 	if requireTransformationID {
 		if ctx.NArg() < 1 {
-			return "", fmt.Errorf("❌ Error: Missing transformation ID")
+			return "", errors.UsageError("Missing transformation ID")
 		}
 		if ctx.NArg() > 1 {
-			return "", fmt.Errorf("❌ Error: Too many arguments. Expected only transformation ID")
+			return "", errors.UsageError("Too many arguments. Expected only transformation ID")
 		}
 	}
 
@@ -59,7 +67,7 @@ func validateTransformationArgs(ctx *cli.Context, requireTransformationID bool)
 
 // printTransformationSuccess prints standardized success messages for transformation operations
 func printTransformationSuccess(operation string, transformation *models.Transformation) {
-	fmt.Printf("✅ Transformation %s successfully!\n", operation)
+	utils.Statusf(style.OK("Transformation %s successfully!\n"), operation)
 	fmt.Printf("  ID:          %s\n", transformation.ID)
 	fmt.Printf("  Name:        %s\n", transformation.Name)
 	fmt.Printf("  Title:       %s\n", transformation.Title)
@@ -79,12 +87,29 @@ func handleTransformationsList(ctx *cli.Context) error {
 
 	transformationList, err := services.TransformationService.List(ctx.Context)
 	if err != nil {
-		return errors.APIError("Failed to list transformations",
+		return errors.WrapAPIError(err, "Failed to list transformations",
 			"Check API connection and permissions")
 	}
 
-	if len(transformationList) == 0 {
-		fmt.Println("No transformations found.")
+	if ctx.Bool("defaults-only") {
+		defaults := make([]*models.Transformation, 0, len(transformationList))
+		for _, transformation := range transformationList {
+			if transformation.ApplyDefault {
+				defaults = append(defaults, transformation)
+			}
+		}
+		transformationList = defaults
+	}
+	if printCount(ctx, len(transformationList)) {
+		return nil
+	}
+
+	if len(transformationList) == 0 && !isStructuredOutput(ctx) {
+		if ctx.Bool("defaults-only") {
+			utils.Status("No default transformations found.")
+			return nil
+		}
+		utils.Status("No transformations found.")
 		return nil
 	}
 
@@ -111,26 +136,28 @@ func handleTransformationsList(ctx *cli.Context) error {
 	displayTransformations := transformationList[start:end]
 
 	// Display transformations in a table
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "ID\tNAME\tTITLE\tDEFAULT\tCREATED")
-
+	table := render.NewTable("ID", "NAME", "TITLE", "DEFAULT", "CREATED")
 	for _, transformation := range displayTransformations {
 		defaultFlag := "No"
 		if transformation.ApplyDefault {
 			defaultFlag = "Yes"
 		}
 
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+		table.AddRow(
 			transformation.ID,
 			transformation.Name,
-			utils.TruncateString(transformation.Title, 25),
+			transformation.Title,
 			defaultFlag,
 			utils.FormatTimestamp(transformation.Created))
 	}
 
-	w.Flush()
+	if err := renderList(ctx, displayTransformations, table); err != nil {
+		return err
+	}
 
-	fmt.Printf("\nShowing %d transformations (use --limit and --offset for pagination)\n", len(displayTransformations))
+	if !isStructuredOutput(ctx) {
+		utils.Statusf("\nShowing %d transformations (use --limit and --offset for pagination)\n", len(displayTransformations))
+	}
 	return nil
 }
 
@@ -151,7 +178,7 @@ func handleTransformationsShow(ctx *cli.Context) error {
 	// Get all transformations and find the one with matching ID
 	transformationList, err := services.TransformationService.List(ctx.Context)
 	if err != nil {
-		return errors.APIError("Failed to get transformation details",
+		return errors.WrapAPIError(err, "Failed to get transformation details",
 			"Check API connection and permissions")
 	}
 
@@ -168,6 +195,10 @@ func handleTransformationsShow(ctx *cli.Context) error {
 			fmt.Sprintf("Transformation with ID '%s' does not exist", transformationID))
 	}
 
+	if handled, err := renderObject(ctx, targetTransformation); handled {
+		return err
+	}
+
 	// Display transformation details
 	fmt.Printf("Transformation Details:\n")
 	fmt.Printf("  ID:           %s\n", targetTransformation.ID)
@@ -210,7 +241,7 @@ func handleTransformationsCreate(ctx *cli.Context) error {
 
 	createdTransformation, err := services.TransformationService.Create(ctx.Context, transformation)
 	if err != nil {
-		return errors.APIError("Failed to create transformation",
+		return errors.WrapAPIError(err, "Failed to create transformation",
 			"Check input parameters and API permissions")
 	}
 
@@ -264,7 +295,7 @@ func handleTransformationsUpdate(ctx *cli.Context) error {
 
 	updatedTransformation, err := services.TransformationService.Update(ctx.Context, transformationID, transformation)
 	if err != nil {
-		return errors.APIError("Failed to update transformation",
+		return errors.WrapAPIError(err, "Failed to update transformation",
 			"Check transformation ID and permissions")
 	}
 
@@ -272,6 +303,45 @@ func handleTransformationsUpdate(ctx *cli.Context) error {
 	return nil
 }
 
+// handleTransformationsSetDefault flips the apply-default flag of a transformation
+func handleTransformationsSetDefault(ctx *cli.Context) error {
+	transformationID, err := validateTransformationArgs(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	on, off := ctx.Bool("on"), ctx.Bool("off")
+	if on == off {
+		return errors.UsageError("Specify exactly one of --on or --off",
+			"Example: onb transformations set-default <id> --on")
+	}
+
+	services, err := getTransformationsServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	services.Logger.Info("Setting transformation default", "transformation_id", transformationID, "apply_default", on)
+
+	updated, err := services.TransformationService.Update(ctx.Context, transformationID,
+		&models.TransformationUpdate{ApplyDefault: &on})
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to update transformation default",
+			"Check transformation ID and permissions")
+	}
+
+	if handled, err := renderObject(ctx, updated); handled {
+		return err
+	}
+
+	if updated.ApplyDefault {
+		utils.Statusf(style.OK("Transformation '%s' will be applied to new sources by default\n"), updated.Name)
+	} else {
+		utils.Statusf(style.OK("Transformation '%s' is no longer applied by default\n"), updated.Name)
+	}
+	return nil
+}
+
 // handleTransformationsDelete handles transformation deletion
 func handleTransformationsDelete(ctx *cli.Context) error {
 	transformationID, err := validateTransformationArgs(ctx, true)
@@ -285,25 +355,24 @@ func handleTransformationsDelete(ctx *cli.Context) error {
 	}
 
 	// Confirm deletion unless force flag is used
-	if !ctx.Bool("force") {
-		fmt.Printf("⚠️  Are you sure you want to delete transformation '%s'? [y/N]: ", transformationID)
-		var response string
-		fmt.Scanln(&response)
-		if response != "y" && response != "Y" {
-			fmt.Println("❌ Deletion cancelled")
-			return nil
-		}
+	ok, err := confirmDestructive(ctx, style.Warn(fmt.Sprintf("Are you sure you want to delete transformation '%s'?", transformationID)))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Println(style.Error("Deletion cancelled"))
+		return nil
 	}
 
 	services.Logger.Info("Deleting transformation", "transformation_id", transformationID)
 
 	err = services.TransformationService.Delete(ctx.Context, transformationID)
 	if err != nil {
-		return errors.APIError("Failed to delete transformation",
+		return errors.WrapAPIError(err, "Failed to delete transformation",
 			"Check transformation ID and permissions")
 	}
 
-	fmt.Printf("✅ Transformation '%s' deleted successfully!\n", transformationID)
+	utils.Statusf(style.OK("Transformation '%s' deleted successfully!\n"), transformationID)
 	return nil
 }
 
@@ -340,20 +409,20 @@ func handleTransformationsExecute(ctx *cli.Context) error {
 		request.ModelID = modelID
 	}
 
-	fmt.Printf("🔄 Executing transformation: %s\n", transformationID)
+	utils.Statusf(style.Icon("🔄", "Executing transformation: %s\n"), transformationID)
 	if modelID != "" {
-		fmt.Printf("  Using model: %s\n", modelID)
+		utils.Statusf("  Using model: %s\n", modelID)
 	}
-	fmt.Printf("  Input text: %s\n", utils.TruncateString(inputText, 50))
+	utils.Statusf("  Input text: %s\n", utils.TruncateString(inputText, 50))
 
 	response, err := services.TransformationService.Execute(ctx.Context, request)
 	if err != nil {
-		return errors.APIError("Failed to execute transformation",
+		return errors.WrapAPIError(err, "Failed to execute transformation",
 			"Check transformation ID, model ID and permissions")
 	}
 
 	if stream {
-		fmt.Println("  🔄 Streaming output:")
+		utils.Status("  " + style.Icon("🔄", "Streaming output:"))
 		// Simulate streaming by printing chunks
 		output := response.Output
 		chunkSize := 20
@@ -368,7 +437,7 @@ func handleTransformationsExecute(ctx *cli.Context) error {
 		return nil
 	}
 
-	fmt.Printf("✅ Transformation executed successfully!\n")
+	utils.Status(style.OK("Transformation executed successfully!"))
 	fmt.Printf("  Output: %s\n", response.Output)
 	if response.TransformationID != "" {
 		fmt.Printf("  Used transformation: %s\n", response.TransformationID)
@@ -379,3 +448,413 @@ func handleTransformationsExecute(ctx *cli.Context) error {
 
 	return nil
 }
+
+// handleTransformationsApply runs a transformation on a source and optionally
+// saves the resulting insight as a note
+func handleTransformationsApply(ctx *cli.Context) error {
+	transformationID, err := validateTransformationArgs(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	sourceID := ctx.String("source")
+	modelID := ctx.String("model")
+	saveAsNote := ctx.Bool("save-as-note")
+	notebookID := ctx.String("notebook")
+
+	if sourceID == "" && notebookID == "" {
+		return errors.UsageError("Missing target for the transformation",
+			"Use --source <id> for one source or --notebook <id> for all sources of a notebook")
+	}
+	if saveAsNote && notebookID == "" {
+		return errors.UsageError("--save-as-note requires --notebook",
+			"Use --notebook to choose where the note is saved")
+	}
+
+	services, err := getTransformationsServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	if sourceID == "" {
+		return applyTransformationToNotebook(ctx, services, transformationID, notebookID)
+	}
+
+	services.Logger.Info("Applying transformation to source",
+		"transformation_id", transformationID, "source_id", sourceID)
+
+	request := &models.CreateSourceInsightRequest{
+		TransformationID: transformationID,
+	}
+	if modelID != "" {
+		request.ModelID = &modelID
+	}
+
+	utils.Statusf(style.Icon("🔄", "Applying transformation %s to source %s\n"), transformationID, sourceID)
+	if modelID != "" {
+		utils.Statusf("  Using model: %s\n", modelID)
+	}
+
+	insight, err := services.SourceService.CreateInsight(ctx.Context, sourceID, request)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to apply transformation",
+			"Check transformation ID, source ID and model ID")
+	}
+
+	var note *models.Note
+	if saveAsNote {
+		note, err = services.SourceService.SaveInsightAsNote(ctx.Context, insight.ID, notebookID)
+		if err != nil {
+			return errors.WrapAPIError(err, "Failed to save insight as note",
+				fmt.Sprintf("The insight was created as '%s'; check notebook ID and permissions", insight.ID))
+		}
+	}
+
+	var result any = insight
+	if note != nil {
+		result = note
+	}
+	if handled, err := renderObject(ctx, result); handled {
+		return err
+	}
+
+	utils.Status(style.OK("Transformation applied successfully!"))
+	fmt.Printf("  Insight ID: %s\n", insight.ID)
+	fmt.Printf("  Source:     %s\n", insight.SourceID)
+	fmt.Printf("  Type:       %s\n", insight.InsightType)
+	fmt.Printf("  Created:    %s\n", utils.FormatTimestamp(insight.Created))
+	fmt.Printf("\n%s\n", insight.Content)
+
+	if note != nil {
+		utils.Status("\n" + style.OK("Insight saved as note!"))
+		fmt.Printf("  Note ID:    %s\n", utils.SafeDereferenceString(note.ID))
+		fmt.Printf("  Notebook:   %s\n", notebookID)
+	}
+
+	return nil
+}
+
+// applyTransformationToNotebook creates an insight for every source of a
+// notebook, skipping sources that already have one from the transformation
+// unless --force is set
+func applyTransformationToNotebook(ctx *cli.Context, services *TransformationsServices, transformationID, notebookID string) error {
+	modelID := ctx.String("model")
+	saveAsNote := ctx.Bool("save-as-note")
+	force := ctx.Bool("force")
+
+	transformation, err := services.TransformationService.Get(ctx.Context, transformationID)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to get transformation",
+			"Check transformation ID and permissions")
+	}
+
+	sources, err := services.SourceService.ListAllByNotebook(ctx.Context, notebookID)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to list notebook sources",
+			"Check notebook ID and permissions")
+	}
+	if len(sources) == 0 {
+		utils.Statusf("No sources found in notebook '%s'.\n", notebookID)
+		return nil
+	}
+
+	request := &models.CreateSourceInsightRequest{
+		TransformationID: transformationID,
+	}
+	if modelID != "" {
+		request.ModelID = &modelID
+	}
+
+	utils.Statusf(style.Icon("🔄", "Applying transformation %s to %d sources in notebook %s\n"),
+		transformationID, len(sources), notebookID)
+	if modelID != "" {
+		utils.Statusf("  Using model: %s\n", modelID)
+	}
+
+	skipped := make([]bool, len(sources))
+	errs := utils.ForEach(ctx.Context, ctx.Int("parallel"), len(sources), func(c context.Context, i int) error {
+		sourceID := utils.SafeDereferenceString(sources[i].ID)
+
+		if !force {
+			insights, err := services.SourceService.GetInsights(c, sourceID)
+			if err != nil {
+				return err
+			}
+			if hasInsightFrom(insights, transformation) {
+				skipped[i] = true
+				return nil
+			}
+		}
+
+		services.Logger.Info("Applying transformation to source",
+			"transformation_id", transformationID, "source_id", sourceID)
+		insight, err := services.SourceService.CreateInsight(c, sourceID, request)
+		if err != nil {
+			return err
+		}
+		if saveAsNote {
+			if _, err := services.SourceService.SaveInsightAsNote(c, insight.ID, notebookID); err != nil {
+				return fmt.Errorf("insight %s created but not saved as note: %w", insight.ID, err)
+			}
+		}
+		return nil
+	})
+
+	applied, skippedCount, failed := 0, 0, 0
+	for i, source := range sources {
+		sourceID := utils.SafeDereferenceString(source.ID)
+		switch {
+		case errs[i] != nil:
+			fmt.Printf(style.Error("Failed %s: %v\n"), sourceID, errs[i])
+			failed++
+		case skipped[i]:
+			utils.Statusf(style.Warn("Skipped %s (insight already exists, use --force to recreate)\n"), sourceID)
+			skippedCount++
+		default:
+			utils.Statusf(style.OK("Applied to %s\n"), sourceID)
+			applied++
+		}
+	}
+
+	utils.Statusf("\nApplied: %d, skipped: %d, failed: %d\n", applied, skippedCount, failed)
+	if failed > 0 {
+		return errors.APIError(fmt.Sprintf("Failed to apply transformation to %d of %d sources", failed, len(sources)),
+			"Check source status, model ID and permissions")
+	}
+	return nil
+}
+
+// hasInsightFrom reports whether insights contain one produced by transformation.
+// The API labels insights with the transformation title, so name and title are both matched.
+func hasInsightFrom(insights []*models.SourceInsightResponse, transformation *models.Transformation) bool {
+	for _, insight := range insights {
+		insightType := string(insight.InsightType)
+		if strings.EqualFold(insightType, transformation.Title) || strings.EqualFold(insightType, transformation.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// handleTransformationsTest handles dry-running a prompt against sample text
+func handleTransformationsTest(ctx *cli.Context) error {
+	services, err := getTransformationsServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	promptFile := ctx.String("prompt-file")
+	inputFile := ctx.String("input-file")
+	modelID := ctx.String("model")
+
+	prompt, err := os.ReadFile(promptFile)
+	if err != nil {
+		return errors.ValidationError("Failed to read prompt file",
+			fmt.Sprintf("Check that '%s' exists and is readable", promptFile))
+	}
+	if strings.TrimSpace(string(prompt)) == "" {
+		return errors.ValidationError("Prompt file is empty",
+			fmt.Sprintf("Add a prompt template to '%s'", promptFile))
+	}
+
+	inputText, err := os.ReadFile(inputFile)
+	if err != nil {
+		return errors.ValidationError("Failed to read input file",
+			fmt.Sprintf("Check that '%s' exists and is readable", inputFile))
+	}
+	if strings.TrimSpace(string(inputText)) == "" {
+		return errors.ValidationError("Input file is empty",
+			fmt.Sprintf("Add sample text to '%s'", inputFile))
+	}
+
+	// Validate that the model exists before running the prompt
+	modelList, err := services.ModelService.List(ctx.Context)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to list models",
+			"Check API connection and permissions")
+	}
+
+	modelFound := false
+	for _, model := range modelList {
+		if model.ID == modelID {
+			modelFound = true
+			break
+		}
+	}
+	if !modelFound {
+		return errors.NotFoundError("Model not found",
+			fmt.Sprintf("Model with ID '%s' does not exist", modelID),
+			"Use 'onb models list' to see available models")
+	}
+
+	services.Logger.Info("Testing transformation prompt", "prompt_file", promptFile, "model", modelID)
+
+	// Ephemeral request: no transformation is persisted
+	request := &models.TransformationExecuteRequest{
+		Prompt:    string(prompt),
+		InputText: string(inputText),
+		ModelID:   modelID,
+	}
+
+	utils.Statusf(style.Icon("🔄", "Testing transformation prompt from: %s\n"), promptFile)
+	utils.Statusf("  Using model: %s\n", modelID)
+	utils.Statusf("  Input text: %s\n", utils.TruncateString(string(inputText), 50))
+
+	response, err := services.TransformationService.Execute(ctx.Context, request)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to test transformation prompt",
+			"Check the prompt, model ID and permissions")
+	}
+
+	utils.Status(style.OK("Transformation preview completed (nothing was saved)"))
+	fmt.Printf("  Output: %s\n", response.Output)
+
+	return nil
+}
+
+// handleTransformationsExport handles exporting all transformations to a JSON file
+func handleTransformationsExport(ctx *cli.Context) error {
+	services, err := getTransformationsServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	outputPath := ctx.String("output")
+
+	services.Logger.Info("Exporting transformations", "output", outputPath)
+
+	transformationList, err := services.TransformationService.List(ctx.Context)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to list transformations",
+			"Check API connection and permissions")
+	}
+
+	if transformationList == nil {
+		transformationList = []*models.Transformation{}
+	}
+
+	data, err := json.MarshalIndent(transformationList, "", "  ")
+	if err != nil {
+		return errors.ValidationError("Failed to format transformations as JSON",
+			fmt.Sprintf("JSON marshaling error: %v", err))
+	}
+
+	if outputPath == "-" {
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if err := os.WriteFile(outputPath, append(data, '\n'), 0644); err != nil {
+		return errors.ValidationError("Failed to write export file",
+			fmt.Sprintf("Check that '%s' is writable", outputPath))
+	}
+
+	utils.Statusf(style.OK("Exported %d transformations to %s\n"), len(transformationList), outputPath)
+	return nil
+}
+
+// handleTransformationsImport handles importing transformations from a JSON file
+func handleTransformationsImport(ctx *cli.Context) error {
+	if ctx.NArg() < 1 {
+		return errors.MissingArgument("import file", ctx.Command.Name)
+	}
+	if ctx.NArg() > 1 {
+		return errors.TooManyArguments("import file", ctx.Command.Name)
+	}
+
+	services, err := getTransformationsServices(ctx)
+	if err != nil {
+		return err
+	}
+
+	inputPath := ctx.Args().First()
+	overwrite := ctx.Bool("overwrite")
+
+	data, err := os.ReadFile(inputPath)
+	if err != nil {
+		return errors.ValidationError("Failed to read import file",
+			fmt.Sprintf("Check that '%s' exists and is readable", inputPath))
+	}
+
+	var imported []*models.Transformation
+	if err := json.Unmarshal(data, &imported); err != nil {
+		return errors.ValidationError("Invalid import file",
+			fmt.Sprintf("Expected a JSON array of transformations: %v", err))
+	}
+
+	services.Logger.Info("Importing transformations", "file", inputPath, "count", len(imported), "overwrite", overwrite)
+
+	existingList, err := services.TransformationService.List(ctx.Context)
+	if err != nil {
+		return errors.WrapAPIError(err, "Failed to list transformations",
+			"Check API connection and permissions")
+	}
+
+	existingByName := make(map[string]*models.Transformation, len(existingList))
+	for _, transformation := range existingList {
+		existingByName[transformation.Name] = transformation
+	}
+
+	created, updated, skipped, failed := 0, 0, 0, 0
+
+	for _, transformation := range imported {
+		if transformation == nil || transformation.Name == "" {
+			utils.Status(style.Warn("Skipping transformation without a name"))
+			skipped++
+			continue
+		}
+
+		if existing, ok := existingByName[transformation.Name]; ok {
+			if !overwrite {
+				utils.Statusf(style.Icon("⏭️", "Skipping '%s' (already exists, use --overwrite to update)\n"), transformation.Name)
+				skipped++
+				continue
+			}
+
+			update := &models.TransformationUpdate{
+				Title:        &transformation.Title,
+				Description:  &transformation.Description,
+				Prompt:       &transformation.Prompt,
+				ApplyDefault: &transformation.ApplyDefault,
+			}
+			if _, err := services.TransformationService.Update(ctx.Context, existing.ID, update); err != nil {
+				fmt.Printf(style.Error("Failed to update '%s': %v\n"), transformation.Name, err)
+				failed++
+				continue
+			}
+			utils.Statusf(style.Icon("🔄", "Updated '%s'\n"), transformation.Name)
+			updated++
+			continue
+		}
+
+		create := &models.TransformationCreate{
+			Name:         transformation.Name,
+			Title:        transformation.Title,
+			Description:  transformation.Description,
+			Prompt:       transformation.Prompt,
+			ApplyDefault: transformation.ApplyDefault,
+		}
+		createdTransformation, err := services.TransformationService.Create(ctx.Context, create)
+		if err != nil {
+			fmt.Printf(style.Error("Failed to create '%s': %v\n"), transformation.Name, err)
+			failed++
+			continue
+		}
+		existingByName[createdTransformation.Name] = createdTransformation
+		utils.Statusf(style.OK("Created '%s'\n"), transformation.Name)
+		created++
+	}
+
+	utils.Statusf("\n"+style.Icon("📊", "Import summary: %d created, %d updated, %d skipped"), created, updated, skipped)
+	if failed > 0 {
+		utils.Statusf(", %d failed", failed)
+	}
+	utils.Status()
+
+	if failed > 0 {
+		return errors.APIError(fmt.Sprintf("Failed to import %d transformations", failed),
+			"Check the import file contents and API permissions")
+	}
+
+	return nil
+}
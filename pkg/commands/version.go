@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+// BuildInfo describes the running binary. The values are injected by the
+// Makefile via -ldflags.
+type BuildInfo struct {
+	Version   string `json:"version"`
+	BuildTime string `json:"build_time"`
+	GoVersion string `json:"go_version"`
+	Commit    string `json:"commit"`
+}
+
+// VersionCommand returns the version command
+func VersionCommand(info BuildInfo) *cli.Command {
+	return &cli.Command{
+		Name:  "version",
+		Usage: "Show version and build information",
+		Description: "Print the CLI version, build time, Go version and git commit.\n\n" +
+			"Examples:\n" +
+			"  onb version          # Human readable\n" +
+			"  onb version --json   # Machine readable, e.g. for CI checks\n" +
+			"  onb version --check  # Compare against the server version",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "json",
+				Usage: "Output build information as JSON",
+				Value: false,
+			},
+			&cli.BoolFlag{
+				Name:  "check",
+				Usage: "Compare the CLI version with the API server version",
+				Value: false,
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			return handleVersion(ctx, info)
+		},
+	}
+}
@@ -0,0 +1,178 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"runtime"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/config"
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
+	"github.com/samber/do/v2"
+	"github.com/urfave/cli/v2"
+)
+
+// SystemServices holds all the services needed for version, health and diagnostics commands
+type SystemServices struct {
+	SystemRepository shared.SystemRepository
+	HTTPClient       shared.HTTPClient
+	Auth             shared.Auth
+	CircuitBreaker   shared.CircuitBreaker
+	Config           config.Service
+	Logger           shared.Logger
+}
+
+// getSystemServices retrieves all required services via dependency injection
+func getSystemServices(ctx *cli.Context) (*SystemServices, error) {
+	injector, ok := ctx.App.Metadata["injector"].(do.Injector)
+	if !ok {
+		return nil, errors.UsageError("Dependency injector not found",
+			"This command requires proper DI setup")
+	}
+
+	return &SystemServices{
+		SystemRepository: do.MustInvoke[shared.SystemRepository](injector),
+		HTTPClient:       do.MustInvoke[shared.HTTPClient](injector),
+		Auth:             do.MustInvoke[shared.Auth](injector),
+		CircuitBreaker:   do.MustInvoke[shared.CircuitBreaker](injector),
+		Config:           do.MustInvoke[config.Service](injector),
+		Logger:           do.MustInvoke[shared.Logger](injector),
+	}, nil
+}
+
+// Version check outcomes
+const (
+	versionStatusOK           = "ok"
+	versionStatusBehind       = "behind"
+	versionStatusAhead        = "ahead"
+	versionStatusIncompatible = "incompatible"
+	versionStatusUnknown      = "unknown"
+)
+
+// Server versions this CLI supports: from minServerVersion up to, but not
+// including, maxServerVersion
+const (
+	minServerVersion = "1.0.0"
+	maxServerVersion = "2.0.0"
+)
+
+// versionCheck is the result of comparing the CLI and server versions
+type versionCheck struct {
+	CLIVersion    string `json:"cli_version"`
+	ServerVersion string `json:"server_version"`
+	Status        string `json:"status"`
+	Message       string `json:"message"`
+}
+
+// checkServerVersion compares cliVersion with the version reported by the
+// server. A server outside the supported range is "incompatible"; one that
+// does not expose its version yields "unknown".
+func checkServerVersion(ctx context.Context, services *SystemServices, cliVersion string) *versionCheck {
+	check := &versionCheck{
+		CLIVersion:    cliVersion,
+		ServerVersion: versionStatusUnknown,
+		Status:        versionStatusUnknown,
+	}
+
+	info, err := services.SystemRepository.GetServerInfo(ctx)
+	if err != nil || info.Version == "" {
+		services.Logger.Debug("Server version not available", "error", err)
+		check.Message = "The server does not report its version"
+		return check
+	}
+	check.ServerVersion = info.Version
+
+	supported, ok := serverVersionSupported(info.Version)
+	if ok && !supported {
+		check.Status = versionStatusIncompatible
+		check.Message = fmt.Sprintf("Server version %s is not supported; this CLI supports servers >= %s and < %s",
+			info.Version, minServerVersion, maxServerVersion)
+		return check
+	}
+
+	cmp, ok := utils.CompareVersions(cliVersion, info.Version)
+	if !ok {
+		check.Message = fmt.Sprintf("Cannot compare CLI version %q with server version %q", cliVersion, info.Version)
+		return check
+	}
+
+	switch {
+	case cmp < 0:
+		check.Status = versionStatusBehind
+		check.Message = "The CLI is older than the server; consider upgrading"
+	case cmp > 0:
+		check.Status = versionStatusAhead
+		check.Message = "The CLI is newer than the server; some commands may not be supported"
+	default:
+		check.Status = versionStatusOK
+		check.Message = "CLI and server versions match"
+	}
+	return check
+}
+
+// serverVersionSupported reports whether version lies within the supported
+// server range. ok is false when version is not a release version.
+func serverVersionSupported(version string) (supported, ok bool) {
+	lower, ok := utils.CompareVersions(version, minServerVersion)
+	if !ok {
+		return false, false
+	}
+	upper, _ := utils.CompareVersions(version, maxServerVersion)
+	return lower >= 0 && upper < 0, true
+}
+
+// printVersionCheck prints a version comparison in human readable form
+func printVersionCheck(check *versionCheck) {
+	fmt.Printf("  Server:     %s\n", check.ServerVersion)
+	switch check.Status {
+	case versionStatusOK:
+		fmt.Println("  " + style.OK(check.Message))
+	case versionStatusBehind, versionStatusAhead, versionStatusIncompatible:
+		fmt.Println("  " + style.Warn(check.Message))
+	default:
+		fmt.Printf("  Status:     unknown (%s)\n", check.Message)
+	}
+}
+
+// handleVersion prints build information
+func handleVersion(ctx *cli.Context, info BuildInfo) error {
+	if info.GoVersion == "" {
+		info.GoVersion = runtime.Version()
+	}
+
+	var check *versionCheck
+	if ctx.Bool("check") {
+		services, err := getSystemServices(ctx)
+		if err != nil {
+			return err
+		}
+		check = checkServerVersion(ctx.Context, services, info.Version)
+	}
+
+	if ctx.Bool("json") || ctx.String("output") == "json" {
+		output := struct {
+			BuildInfo
+			ServerCheck *versionCheck `json:"server_check,omitempty"`
+		}{info, check}
+
+		data, err := json.MarshalIndent(output, "", "  ")
+		if err != nil {
+			return errors.ValidationError("Failed to format version as JSON",
+				fmt.Sprintf("JSON marshaling error: %v", err))
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Printf("onb %s\n", info.Version)
+	fmt.Printf("  Commit:     %s\n", info.Commit)
+	fmt.Printf("  Built:      %s\n", info.BuildTime)
+	fmt.Printf("  Go version: %s\n", info.GoVersion)
+	if check != nil {
+		printVersionCheck(check)
+	}
+	return nil
+}
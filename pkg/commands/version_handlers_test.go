@@ -0,0 +1,29 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerVersionSupported(t *testing.T) {
+	tests := map[string]struct {
+		version       string
+		wantSupported bool
+		wantOK        bool
+	}{
+		"lower bound":   {"1.0.0", true, true},
+		"within range":  {"v1.4.2", true, true},
+		"below range":   {"0.9.9", false, true},
+		"upper bound":   {"2.0.0", false, true},
+		"above range":   {"3.1.0", false, true},
+		"not a release": {"dev", false, false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			supported, ok := serverVersionSupported(tt.version)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantSupported, supported)
+		})
+	}
+}
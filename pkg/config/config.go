@@ -3,7 +3,9 @@ package config
 import (
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
 	"github.com/samber/do/v2"
 	"github.com/urfave/cli/v2"
 )
@@ -14,22 +16,74 @@ type Service interface {
 	GetPassword() string
 	GetTimeout() int
 	GetRetryCount() int
+	GetRetryStatus() string
+	SkipRetry5xx() bool
 	IsVerbose() bool
+	GetLogLevel() string
+	GetLogFormat() string
 	GetOutput() string
 	GetConfigDir() string
+	UseTokenCache() bool
+	IsQuiet() bool
+	UseColor() bool
+	UsePager() bool
+	UseIdempotencyKeys() bool
+	GetRequestID() string
+	GetAPIPrefix() string
+	UseCompression() bool
+	UseResponseCache() bool
+	UseRelativeTime() bool
+	ForceAuth() bool
+	IsInsecure() bool
+	GetCACert() string
+	GetClientCert() string
+	GetClientKey() string
+	GetProxy() string
+	GetCircuitThreshold() int
+	GetCircuitWindow() int
+	GetCircuitCooldown() int
+	PersistCircuit() bool
+	GetRateLimit() float64
 	IsAuthenticated() bool
 	Validate() error
 }
 
 // Config implements the configuration service
 type Config struct {
-	apiURL     string
-	password   string
-	timeout    int
-	retryCount int
-	verbose    bool
-	output     string
-	configDir  string
+	apiURL       string
+	password     string
+	timeout      int
+	retryCount   int
+	retryStatus  string
+	noRetry5xx   bool
+	verbose      bool
+	logLevel     string
+	logFormat    string
+	output       string
+	configDir    string
+	noTokenCache bool
+	quiet        bool
+	noColor      bool
+	noPager      bool
+	idempotent   bool
+	requestID    string
+	apiPrefix    string
+	compress     bool
+	cache        bool
+	relativeTime bool
+	forceAuth    bool
+	insecure     bool
+	caCert       string
+	clientCert   string
+	clientKey    string
+	proxy        string
+
+	circuitThreshold int
+	circuitWindow    int
+	circuitCooldown  int
+	circuitPersist   bool
+
+	rateLimit float64
 }
 
 // NewConfig creates a new configuration service by injecting the CLI context
@@ -43,9 +97,34 @@ func NewConfig(injector do.Injector) (Service, error) {
 	password := cliContext.String("password")
 	timeout := cliContext.Int("timeout")
 	retryCount := cliContext.Int("retry-count")
+	retryStatus := cliContext.String("retry-status")
+	noRetry5xx := cliContext.Bool("no-retry-5xx")
 	verbose := cliContext.Bool("verbose")
+	logLevel := strings.ToLower(cliContext.String("log-level"))
+	logFormat := cliContext.String("log-format")
 	output := cliContext.String("output")
 	configDir := cliContext.String("config-dir")
+	noTokenCache := cliContext.Bool("no-token-cache")
+	quiet := cliContext.Bool("quiet")
+	noColor := cliContext.Bool("no-color")
+	noPager := cliContext.Bool("no-pager")
+	idempotent := cliContext.Bool("idempotent")
+	requestID := cliContext.String("request-id")
+	apiPrefix := cliContext.String("api-prefix")
+	compress := cliContext.Bool("compress")
+	cache := cliContext.Bool("cache")
+	relativeTime := cliContext.Bool("relative-time")
+	forceAuth := cliContext.Bool("force-auth")
+	insecure := cliContext.Bool("insecure")
+	caCert := cliContext.String("ca-cert")
+	clientCert := cliContext.String("client-cert")
+	clientKey := cliContext.String("client-key")
+	proxy := cliContext.String("proxy")
+	circuitThreshold := cliContext.Int("circuit-threshold")
+	circuitWindow := cliContext.Int("circuit-window")
+	circuitCooldown := cliContext.Int("circuit-cooldown")
+	circuitPersist := cliContext.Bool("circuit-persist")
+	rateLimit := cliContext.Float64("rate-limit")
 
 	// Set defaults if not provided
 	if apiURL == "" {
@@ -60,18 +139,70 @@ func NewConfig(injector do.Injector) (Service, error) {
 	if output == "" {
 		output = "table"
 	}
+	if logLevel == "" {
+		switch {
+		case verbose:
+			logLevel = "debug"
+		case quiet:
+			logLevel = "warn"
+		default:
+			logLevel = "info"
+		}
+	}
+	if logFormat == "" {
+		logFormat = "text"
+	}
 	if configDir == "" {
 		configDir = getDefaultConfigDir()
 	}
+	if requestID == "" {
+		requestID = utils.NewUUID()
+	}
+	if circuitWindow <= 0 {
+		circuitWindow = 60
+	}
+	if circuitCooldown <= 0 {
+		circuitCooldown = 30
+	}
+	if apiPrefix == "" {
+		apiPrefix = "/api" // "/" selects no prefix
+	}
 
 	config := &Config{
-		apiURL:     apiURL,
-		password:   password,
-		timeout:    timeout,
-		retryCount: retryCount,
-		verbose:    verbose,
-		output:     output,
-		configDir:  configDir,
+		apiURL:       apiURL,
+		password:     password,
+		timeout:      timeout,
+		retryCount:   retryCount,
+		retryStatus:  retryStatus,
+		noRetry5xx:   noRetry5xx,
+		verbose:      verbose,
+		logLevel:     logLevel,
+		logFormat:    logFormat,
+		output:       output,
+		configDir:    configDir,
+		noTokenCache: noTokenCache,
+		quiet:        quiet,
+		noColor:      noColor,
+		noPager:      noPager,
+		idempotent:   idempotent,
+		requestID:    requestID,
+		apiPrefix:    normalizeAPIPrefix(apiPrefix),
+		compress:     compress,
+		cache:        cache,
+		relativeTime: relativeTime,
+		forceAuth:    forceAuth,
+		insecure:     insecure,
+		caCert:       caCert,
+		clientCert:   clientCert,
+		clientKey:    clientKey,
+		proxy:        proxy,
+
+		circuitThreshold: circuitThreshold,
+		circuitWindow:    circuitWindow,
+		circuitCooldown:  circuitCooldown,
+		circuitPersist:   circuitPersist,
+
+		rateLimit: rateLimit,
 	}
 
 	if err := config.Validate(); err != nil {
@@ -90,6 +221,96 @@ func (c *Config) IsVerbose() bool       { return c.verbose }
 func (c *Config) GetOutput() string     { return c.output }
 func (c *Config) GetConfigDir() string  { return c.configDir }
 func (c *Config) IsAuthenticated() bool { return c.password != "" }
+func (c *Config) UseTokenCache() bool   { return !c.noTokenCache }
+func (c *Config) IsQuiet() bool         { return c.quiet }
+func (c *Config) UseColor() bool        { return !c.noColor }
+
+// GetLogLevel returns the minimum level logged: "debug", "info", "warn" or
+// "error". Without --log-level it is debug under --verbose, warn under
+// --quiet and info otherwise.
+func (c *Config) GetLogLevel() string { return c.logLevel }
+
+// GetLogFormat returns the log encoding, "text" or "json".
+func (c *Config) GetLogFormat() string { return c.logFormat }
+
+// UsePager reports whether output taller than the terminal is shown
+// through $PAGER.
+func (c *Config) UsePager() bool { return !c.noPager }
+
+// UseIdempotencyKeys reports whether POST/PUT requests carry an
+// Idempotency-Key header that stays the same across retries.
+func (c *Config) UseIdempotencyKeys() bool { return c.idempotent }
+
+// GetRequestID returns the ID sent as X-Request-ID with every request of
+// this invocation, either from --request-id or generated at startup.
+func (c *Config) GetRequestID() string { return c.requestID }
+
+// GetAPIPrefix returns the path the API is mounted under, e.g. "/api" or
+// "/notebook/api". It has a leading slash and no trailing slash, and is
+// empty when the API is served at the root of the API URL.
+func (c *Config) GetAPIPrefix() string { return c.apiPrefix }
+
+// UseCompression reports whether large request bodies may be gzipped once
+// the server has advertised support for gzip request bodies.
+func (c *Config) UseCompression() bool { return c.compress }
+
+// UseResponseCache reports whether GET responses are cached on disk and
+// revalidated with If-None-Match.
+func (c *Config) UseResponseCache() bool { return c.cache }
+
+// UseRelativeTime reports whether human-readable output shows recent
+// timestamps as their age ("2h ago"). Structured output is unaffected.
+func (c *Config) UseRelativeTime() bool { return c.relativeTime }
+
+// ForceAuth reports whether to authenticate even when the server reports
+// that authentication is disabled.
+func (c *Config) ForceAuth() bool { return c.forceAuth }
+
+// IsInsecure reports whether TLS certificate verification is disabled.
+func (c *Config) IsInsecure() bool { return c.insecure }
+
+// GetCACert returns the path of an additional CA certificate (PEM) to
+// trust, or "" for the system pool only.
+func (c *Config) GetCACert() string { return c.caCert }
+
+// GetClientCert returns the path of the PEM client certificate presented
+// to servers that require mutual TLS, or "" for none.
+func (c *Config) GetClientCert() string { return c.clientCert }
+
+// GetClientKey returns the path of the PEM private key matching
+// GetClientCert, or "" for none.
+func (c *Config) GetClientKey() string { return c.clientKey }
+
+// GetProxy returns the proxy URL from --proxy, or "" to use the
+// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+func (c *Config) GetProxy() string { return c.proxy }
+
+// GetRetryStatus returns the comma-separated HTTP status codes from
+// --retry-status, or "" for the default list.
+func (c *Config) GetRetryStatus() string { return c.retryStatus }
+
+// SkipRetry5xx reports whether 500, 502 and 504 responses are not retried.
+func (c *Config) SkipRetry5xx() bool { return c.noRetry5xx }
+
+// GetCircuitThreshold returns the number of consecutive failed requests
+// after which the circuit breaker opens; 0 disables it.
+func (c *Config) GetCircuitThreshold() int { return c.circuitThreshold }
+
+// GetCircuitWindow returns, in seconds, how close together failures must be
+// to count as consecutive.
+func (c *Config) GetCircuitWindow() int { return c.circuitWindow }
+
+// GetCircuitCooldown returns, in seconds, how long an open circuit fails
+// requests fast before a trial request is sent.
+func (c *Config) GetCircuitCooldown() int { return c.circuitCooldown }
+
+// PersistCircuit reports whether the circuit breaker state is kept in the
+// config dir, so that later commands fail fast as well.
+func (c *Config) PersistCircuit() bool { return c.circuitPersist }
+
+// GetRateLimit returns the maximum number of requests per second sent to
+// the API; 0 means unlimited.
+func (c *Config) GetRateLimit() float64 { return c.rateLimit }
 
 func (c *Config) Validate() error {
 	if c.apiURL == "" {
@@ -104,13 +325,33 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("retry count cannot be negative")
 	}
 
+	if c.rateLimit < 0 {
+		return fmt.Errorf("rate limit cannot be negative")
+	}
+
+	switch c.logLevel {
+	case "", "debug", "info", "warn", "error":
+	default:
+		return fmt.Errorf("invalid log level: %s (must be debug, info, warn or error)", c.logLevel)
+	}
+
+	switch c.logFormat {
+	case "", "text", "json":
+	default:
+		return fmt.Errorf("invalid log format: %s (must be text or json)", c.logFormat)
+	}
+
 	validOutputs := map[string]bool{
-		"json":  true,
-		"table": true,
-		"yaml":  true,
+		"json":     true,
+		"jsonl":    true,
+		"table":    true,
+		"wide":     true,
+		"yaml":     true,
+		"csv":      true,
+		"template": true,
 	}
 	if !validOutputs[c.output] {
-		return fmt.Errorf("invalid output format: %s (must be json, table, or yaml)", c.output)
+		return fmt.Errorf("invalid output format: %s (must be json, jsonl, table, wide, yaml, csv, or template)", c.output)
 	}
 
 	return nil
@@ -122,3 +363,12 @@ func getDefaultConfigDir() string {
 	}
 	return "/tmp/open-notebook-cli"
 }
+
+// normalizeAPIPrefix gives prefix a single leading slash and no trailing slash
+func normalizeAPIPrefix(prefix string) string {
+	prefix = strings.Trim(strings.TrimSpace(prefix), "/")
+	if prefix == "" {
+		return ""
+	}
+	return "/" + prefix
+}
@@ -104,6 +104,26 @@ func TestConfigValidate(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "invalid log level",
+			cfg: &Config{
+				apiURL:   "http://localhost:5055",
+				timeout:  30,
+				output:   "table",
+				logLevel: "trace",
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid log format",
+			cfg: &Config{
+				apiURL:    "http://localhost:5055",
+				timeout:   30,
+				output:    "table",
+				logFormat: "xml",
+			},
+			expectErr: true,
+		},
 		{
 			name: "valid output format yaml",
 			cfg: &Config{
@@ -1,8 +1,17 @@
 package di
 
 import (
+	stderrors "errors"
+	"fmt"
+	"os"
+	"time"
+
 	"github.com/denkhaus/open-notebook-cli/pkg/config"
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/services"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
 	"github.com/samber/do/v2"
 )
 
@@ -80,3 +89,95 @@ func GetPodcastRepository(injector do.Injector) shared.PodcastRepository {
 func GetPodcastService(injector do.Injector) shared.PodcastService {
 	return do.MustInvoke[shared.PodcastService](injector)
 }
+
+// ConfigureOutput applies the global output flags to the shared status printer,
+// timestamp formatting, error display and output paging. Invalid global flags
+// are reported as a usage error.
+func ConfigureOutput(injector do.Injector) error {
+	cfg, err := do.Invoke[config.Service](injector)
+	if err != nil {
+		// Drop the "invalid configuration" wrapping of config.NewConfig
+		if cause := stderrors.Unwrap(err); cause != nil {
+			err = cause
+		}
+		return errors.UsageError("Invalid configuration: "+err.Error(),
+			"Run 'onb --help' to see the valid values of the global flags")
+	}
+
+	utils.ConfigureOutput(cfg.IsQuiet(), GetLogger(injector).Debug)
+	utils.ConfigureTimestamps(cfg.UseRelativeTime())
+	style.Configure(!cfg.UseColor())
+	errors.SetRequestID(cfg.GetRequestID())
+	if cfg.UsePager() {
+		utils.StartPager()
+	}
+	return nil
+}
+
+// ClosePager shows any output held back for paging and waits until the
+// user quits the pager
+func ClosePager() {
+	utils.StopPager()
+}
+
+// transportHints are the suggestions for a transport flag with an invalid
+// value, by the flag named in services.FlagError
+var transportHints = map[string][]string{
+	"--ca-cert": {"Check that --ca-cert names a readable PEM file"},
+	"--client-cert": {
+		"Check that --client-cert and --client-key name readable PEM files",
+		"The --client-key must be the private key of the --client-cert certificate",
+	},
+	"--proxy":        {"Check that --proxy is a URL such as http://proxy.example.com:3128"},
+	"--retry-status": {"Check that --retry-status lists HTTP status codes such as 408,429,503"},
+}
+
+// ConfigureTransport builds the HTTP client so that TLS settings from
+// --insecure, --ca-cert and the client certificate flags and the --proxy URL
+// are validated before any command runs, and warns once when certificate verification is
+// disabled
+func ConfigureTransport(injector do.Injector) error {
+	cfg, err := do.Invoke[config.Service](injector)
+	if err != nil {
+		return nil
+	}
+
+	if _, err := do.Invoke[shared.HTTPClient](injector); err != nil {
+		var hints []string
+		var flagErr *services.FlagError
+		if stderrors.As(err, &flagErr) {
+			hints = transportHints[flagErr.Flag]
+		}
+		return errors.ConfigError("Failed to set up the HTTP client: "+err.Error(), hints...)
+	}
+
+	if cfg.IsInsecure() {
+		fmt.Fprintln(os.Stderr, style.Warn("TLS certificate verification is disabled (--insecure); use --ca-cert to trust a self-signed certificate instead"))
+	}
+	return nil
+}
+
+// PrintMetricsSummary writes the request statistics of this run to stderr
+// when --verbose is set
+func PrintMetricsSummary(injector do.Injector) {
+	cfg, err := do.Invoke[config.Service](injector)
+	if err != nil || !cfg.IsVerbose() {
+		return
+	}
+
+	metrics, err := do.Invoke[shared.Metrics](injector)
+	if err != nil {
+		return
+	}
+
+	stats := metrics.Stats()
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, style.Icon("📊", "Request summary:"))
+	fmt.Fprintf(os.Stderr, "   Requests:  %d (%d retries)\n", stats.Requests, stats.Retries)
+	fmt.Fprintf(os.Stderr, "   Latency:   total %s, avg %s, max %s\n",
+		stats.TotalLatency.Round(time.Millisecond),
+		stats.AvgLatency().Round(time.Millisecond),
+		stats.MaxLatency.Round(time.Millisecond))
+	fmt.Fprintf(os.Stderr, "   Transfer:  %s sent, %s received\n",
+		utils.FormatBytes(stats.BytesSent), utils.FormatBytes(stats.BytesReceived))
+}
@@ -17,8 +17,15 @@ func Bootstrap(cliCtx *cli.Context) do.Injector {
 	// Core infrastructure services
 	do.Provide(injector, config.NewConfig)
 	do.Provide(injector, services.NewLogger)
-	do.Provide(injector, services.NewRetryableHTTPClient)
+	do.Provide(injector, services.NewMetrics)
+	do.Provide(injector, services.NewCircuitBreaker)
+	do.ProvideNamed(injector, services.BaseHTTPClientName, services.NewRetryableHTTPClient)
+	do.Provide(injector, services.NewAuthenticatedHTTPService)
 	do.Provide(injector, services.NewAuth)
+	do.Provide(injector, services.NewTokenStore)
+	do.Provide(injector, services.NewResponseCache)
+	do.Provide(injector, services.NewSessionStore)
+	do.Provide(injector, services.NewSavedSearchStore)
 
 	// Repository layer (only implemented ones)
 	do.Provide(injector, services.NewSourceRepository)
@@ -29,6 +36,11 @@ func Bootstrap(cliCtx *cli.Context) do.Injector {
 	do.Provide(injector, services.NewPodcastRepository)
 	do.Provide(injector, services.NewNoteRepository)
 	do.Provide(injector, services.NewSearchRepository)
+	do.Provide(injector, services.NewTransformationRepository)
+	do.Provide(injector, services.NewSettingsRepository)
+	do.Provide(injector, services.NewSystemRepository)
+	do.Provide(injector, services.NewContextRepository)
+	do.Provide(injector, services.NewEmbeddingRepository)
 
 	// Service layer (only implemented ones)
 	do.Provide(injector, services.NewNotebookService)
@@ -37,6 +49,8 @@ func Bootstrap(cliCtx *cli.Context) do.Injector {
 	do.Provide(injector, services.NewSourceService)
 	do.Provide(injector, services.NewPodcastService)
 	do.Provide(injector, services.NewJobService)
+	do.Provide(injector, services.NewEmbeddingService)
+	do.Provide(injector, services.NewContextService)
 
 	return injector
 }
@@ -0,0 +1,19 @@
+package errors
+
+import (
+	"fmt"
+	"time"
+)
+
+// CircuitOpenError is returned without contacting the server while the
+// circuit breaker is open after repeated failures
+type CircuitOpenError struct {
+	Failures int
+	RetryAt  time.Time
+}
+
+// Error implements the error interface
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("server appears down, circuit open after %d consecutive failures (next attempt after %s)",
+		e.Failures, e.RetryAt.Format("15:04:05"))
+}
@@ -1,10 +1,14 @@
 package errors
 
 import (
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
+	"github.com/denkhaus/open-notebook-cli/pkg/utils/style"
 	"github.com/urfave/cli/v2"
 )
 
@@ -23,6 +27,58 @@ const (
 	ErrorTypeUsage
 )
 
+// Process exit codes, one per error category, so scripts can react to
+// failures without parsing the message
+const (
+	ExitCodeGeneral = 1
+	ExitCodeUsage   = 2
+	ExitCodeNetwork = 3
+	ExitCodeAuth    = 4
+	ExitCodeAPI     = 5
+)
+
+// ExitCode returns the process exit code for the error type
+func (t ErrorType) ExitCode() int {
+	switch t {
+	case ErrorTypeUsage, ErrorTypeValidation, ErrorTypeConfig:
+		return ExitCodeUsage
+	case ErrorTypeNetwork:
+		return ExitCodeNetwork
+	case ErrorTypeAuth, ErrorTypePermission:
+		return ExitCodeAuth
+	case ErrorTypeAPI, ErrorTypeNotFound, ErrorTypeServer:
+		return ExitCodeAPI
+	default:
+		return ExitCodeGeneral
+	}
+}
+
+// String returns the machine-readable name of the error type
+func (t ErrorType) String() string {
+	switch t {
+	case ErrorTypeConfig:
+		return "config"
+	case ErrorTypeAuth:
+		return "auth"
+	case ErrorTypeNetwork:
+		return "network"
+	case ErrorTypeAPI:
+		return "api"
+	case ErrorTypeValidation:
+		return "validation"
+	case ErrorTypePermission:
+		return "permission"
+	case ErrorTypeNotFound:
+		return "not_found"
+	case ErrorTypeServer:
+		return "server"
+	case ErrorTypeUsage:
+		return "usage"
+	default:
+		return "unknown"
+	}
+}
+
 // CLIError represents a structured CLI error with user guidance
 type CLIError struct {
 	Type        ErrorType
@@ -44,7 +100,7 @@ func NewCLIError(errorType ErrorType, message string, suggestions ...string) *CL
 		Type:        errorType,
 		Message:     message,
 		Suggestions: suggestions,
-		ExitCode:    1,
+		ExitCode:    errorType.ExitCode(),
 	}
 
 	// Add type-specific suggestions and next steps
@@ -224,40 +280,92 @@ func (e *CLIError) addTypeSpecificGuidance() {
 	}
 }
 
+// requestID is the X-Request-ID of the current invocation, shown with errors
+// so users can quote it in bug reports
+var requestID string
+
+// SetRequestID sets the request ID included in displayed errors
+func SetRequestID(id string) {
+	requestID = id
+}
+
 // Display formats and prints the error with user guidance
 func (e *CLIError) Display() {
-	fmt.Fprintf(os.Stderr, "\n❌ %s\n\n", e.Message)
+	fmt.Fprintf(os.Stderr, "\n%s\n\n", style.Error(e.Message))
 
 	// Show suggestions
 	if len(e.Suggestions) > 0 {
-		fmt.Fprintf(os.Stderr, "💡 Suggestions:\n")
+		fmt.Fprintln(os.Stderr, style.Icon("💡", "Suggestions:"))
 		for _, suggestion := range e.Suggestions {
-			fmt.Fprintf(os.Stderr, "   • %s\n", suggestion)
+			fmt.Fprintf(os.Stderr, "   %s %s\n", style.Symbol("•", "-"), suggestion)
 		}
 		fmt.Fprintln(os.Stderr)
 	}
 
 	// Show next steps
 	if len(e.NextSteps) > 0 {
-		fmt.Fprintf(os.Stderr, "🎯 Next steps:\n")
+		fmt.Fprintln(os.Stderr, style.Icon("🎯", "Next steps:"))
 		for _, step := range e.NextSteps {
-			fmt.Fprintf(os.Stderr, "   → %s\n", step)
+			fmt.Fprintf(os.Stderr, "   %s %s\n", style.Symbol("→", "->"), step)
 		}
 		fmt.Fprintln(os.Stderr)
 	}
 
 	// Show examples
 	if len(e.Examples) > 0 {
-		fmt.Fprintf(os.Stderr, "📋 Examples:\n")
+		fmt.Fprintln(os.Stderr, style.Icon("📋", "Examples:"))
 		for _, example := range e.Examples {
 			fmt.Fprintf(os.Stderr, "   %s\n", example)
 		}
 		fmt.Fprintln(os.Stderr)
 	}
 
+	if requestID != "" {
+		fmt.Fprintf(os.Stderr, "%s\n\n", style.Icon("🆔", "Request ID: "+requestID))
+	}
+
 	// Add help hint
-	fmt.Fprintf(os.Stderr, "💬 For help getting started with OpenNotebook CLI, run: onb --help\n")
-	fmt.Fprintf(os.Stderr, "🔍 For command-specific help, run: onb <command> --help\n")
+	fmt.Fprintln(os.Stderr, style.Icon("💬", "For help getting started with OpenNotebook CLI, run: onb --help"))
+	fmt.Fprintln(os.Stderr, style.Icon("🔍", "For command-specific help, run: onb <command> --help"))
+}
+
+// jsonError is the structured error emitted under --output json and jsonl
+type jsonError struct {
+	Error     string `json:"error"`
+	Hint      string `json:"hint,omitempty"`
+	Type      string `json:"type"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// DisplayJSON prints the error as a single JSON object on stderr
+func (e *CLIError) DisplayJSON() {
+	e.writeJSON(os.Stderr)
+}
+
+func (e *CLIError) writeJSON(w io.Writer) error {
+	out := jsonError{
+		Error:     e.Message,
+		Type:      e.Type.String(),
+		RequestID: requestID,
+	}
+	if len(e.Suggestions) > 0 {
+		out.Hint = e.Suggestions[0]
+	}
+
+	return json.NewEncoder(w).Encode(out)
+}
+
+// ExitCodeFor resolves the process exit code for any error returned by a command
+func ExitCodeFor(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var cliErr *CLIError
+	if stderrors.As(err, &cliErr) {
+		return cliErr.ExitCode
+	}
+	return CategorizeError(err, nil).ExitCode
 }
 
 // HandleCLIError displays a CLI error and exits with appropriate code
@@ -267,21 +375,42 @@ func HandleCLIError(err error, ctx *cli.Context) {
 	}
 
 	// Check if it's already a CLIError
-	if cliErr, ok := err.(*CLIError); ok {
-		cliErr.Display()
-		os.Exit(cliErr.ExitCode)
+	var cliErr *CLIError
+	if !stderrors.As(err, &cliErr) {
+		// Categorize and wrap standard errors
+		cliErr = CategorizeError(err, ctx)
 	}
 
-	// Categorize and wrap standard errors
-	cliErr := CategorizeError(err, ctx)
-	cliErr.Display()
+	if wantsJSON(ctx) {
+		cliErr.DisplayJSON()
+	} else {
+		cliErr.Display()
+	}
 	os.Exit(cliErr.ExitCode)
 }
 
+// wantsJSON reports whether errors should be rendered as JSON, which they
+// are for the machine readable formats json and jsonl. Without a context
+// (e.g. flag parsing failed) the environment variable is consulted.
+func wantsJSON(ctx *cli.Context) bool {
+	format := os.Getenv("OPEN_NOTEBOOK_OUTPUT")
+	if ctx != nil {
+		format = ctx.String("output")
+	}
+	return format == "json" || format == "jsonl"
+}
+
 // CategorizeError converts standard errors to CLIErrors with appropriate guidance
 func CategorizeError(err error, ctx *cli.Context) *CLIError {
 	errMsg := err.Error()
 
+	// Flag parsing errors reported by the CLI framework
+	if strings.Contains(errMsg, "flag provided but not defined") ||
+		strings.HasPrefix(errMsg, "Required flag") ||
+		strings.HasPrefix(errMsg, "Required flags") {
+		return NewCLIError(ErrorTypeUsage, errMsg)
+	}
+
 	// Network errors
 	if strings.Contains(errMsg, "connection refused") ||
 	   strings.Contains(errMsg, "no such host") ||
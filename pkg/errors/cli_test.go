@@ -1,9 +1,14 @@
 package errors
 
 import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
 )
 
 func TestCLIError_Error(t *testing.T) {
@@ -17,6 +22,39 @@ func TestCLIError_Display(t *testing.T) {
 	assert.NotPanics(t, err.Display)
 }
 
+func TestCLIError_WriteJSON(t *testing.T) {
+	err := UsageError("missing flag", "use --help")
+
+	var buf bytes.Buffer
+	assert.NoError(t, err.writeJSON(&buf))
+
+	var out map[string]string
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	assert.Equal(t, "missing flag", out["error"])
+	assert.Equal(t, "use --help", out["hint"])
+	assert.Equal(t, "usage", out["type"])
+}
+
+func TestWantsJSON(t *testing.T) {
+	tests := map[string]bool{
+		"json":  true,
+		"jsonl": true,
+		"table": false,
+		"yaml":  false,
+		"":      false,
+	}
+	for format, want := range tests {
+		t.Run(format, func(t *testing.T) {
+			flagSet := flag.NewFlagSet("onb-test", flag.ContinueOnError)
+			flagSet.String("output", format, "")
+			assert.Equal(t, want, wantsJSON(cli.NewContext(cli.NewApp(), flagSet, nil)))
+
+			t.Setenv("OPEN_NOTEBOOK_OUTPUT", format)
+			assert.Equal(t, want, wantsJSON(nil))
+		})
+	}
+}
+
 func TestNewCLIError(t *testing.T) {
 	suggestions := []string{"suggestion 1", "suggestion 2"}
 	err := NewCLIError(ErrorTypeAuth, "auth error", suggestions...)
@@ -24,7 +62,7 @@ func TestNewCLIError(t *testing.T) {
 	assert.Equal(t, ErrorTypeAuth, err.Type)
 	assert.Equal(t, "auth error", err.Message)
 	assert.Equal(t, suggestions, err.Suggestions)
-	assert.Equal(t, 1, err.ExitCode)
+	assert.Equal(t, ExitCodeAuth, err.ExitCode)
 	assert.NotEmpty(t, err.NextSteps)
 	assert.NotEmpty(t, err.Examples)
 }
@@ -211,6 +249,38 @@ func TestUsageError(t *testing.T) {
 	assert.Contains(t, err.Suggestions, "use --help")
 }
 
+func TestConstructorExitCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *CLIError
+		code int
+	}{
+		{"usage", UsageError("bad usage"), 2},
+		{"validation", ValidationError("bad input"), 2},
+		{"config", ConfigError("bad config"), 2},
+		{"network", NetworkError("unreachable"), 3},
+		{"auth", AuthError("bad password"), 4},
+		{"api", APIError("server said no"), 5},
+		{"not found", NotFoundError("missing"), 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.code, tt.err.ExitCode)
+			assert.Equal(t, tt.code, ExitCodeFor(tt.err))
+			assert.Equal(t, tt.code, ExitCodeFor(fmt.Errorf("wrapped: %w", tt.err)))
+		})
+	}
+}
+
+func TestExitCodeFor_PlainErrors(t *testing.T) {
+	assert.Equal(t, 0, ExitCodeFor(nil))
+	assert.Equal(t, ExitCodeNetwork, ExitCodeFor(&testError{msg: "dial tcp: connection refused"}))
+	assert.Equal(t, ExitCodeAuth, ExitCodeFor(&testError{msg: "HTTP 401 unauthorized"}))
+	assert.Equal(t, ExitCodeAPI, ExitCodeFor(&testError{msg: "some random error"}))
+	assert.Equal(t, ExitCodeUsage, ExitCodeFor(&testError{msg: `Required flag "name" not set`}))
+}
+
 // testError is a simple error implementation for testing
 type testError struct {
 	msg string
@@ -0,0 +1,63 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// HTTPError is returned by repositories when the API answers with an error
+// status. It keeps the status code, the raw response body and the endpoint
+// so callers can branch on the status instead of parsing messages.
+type HTTPError struct {
+	StatusCode int
+	Body       []byte
+	Endpoint   string
+	Message    string // concise message extracted from Body, if any
+}
+
+// Error implements the error interface
+func (e *HTTPError) Error() string {
+	status := strings.TrimSpace(fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)))
+	if e.Message == "" {
+		return fmt.Sprintf("API error: %s", status)
+	}
+	return fmt.Sprintf("API error: %s: %s", status, e.Message)
+}
+
+// IsNotFound reports whether the API answered 404 Not Found
+func (e *HTTPError) IsNotFound() bool { return e.StatusCode == http.StatusNotFound }
+
+// IsUnauthorized reports whether the API answered 401 Unauthorized
+func (e *HTTPError) IsUnauthorized() bool { return e.StatusCode == http.StatusUnauthorized }
+
+// IsForbidden reports whether the API answered 403 Forbidden
+func (e *HTTPError) IsForbidden() bool { return e.StatusCode == http.StatusForbidden }
+
+// IsConflict reports whether the API answered 409 Conflict
+func (e *HTTPError) IsConflict() bool { return e.StatusCode == http.StatusConflict }
+
+// IsServerError reports whether the API answered with a 5xx status
+func (e *HTTPError) IsServerError() bool { return e.StatusCode >= 500 }
+
+// AsHTTPError returns the HTTPError in err's chain, if any
+func AsHTTPError(err error) (*HTTPError, bool) {
+	var httpErr *HTTPError
+	if stderrors.As(err, &httpErr) {
+		return httpErr, true
+	}
+	return nil, false
+}
+
+// IsNotFound reports whether err carries a 404 response
+func IsNotFound(err error) bool {
+	httpErr, ok := AsHTTPError(err)
+	return ok && httpErr.IsNotFound()
+}
+
+// IsUnauthorized reports whether err carries a 401 response
+func IsUnauthorized(err error) bool {
+	httpErr, ok := AsHTTPError(err)
+	return ok && httpErr.IsUnauthorized()
+}
@@ -0,0 +1,83 @@
+package errors
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPError_Error(t *testing.T) {
+	err := &HTTPError{StatusCode: 404, Endpoint: "/notebooks/nb1", Message: "Notebook not found"}
+	assert.Equal(t, "API error: 404 Not Found: Notebook not found", err.Error())
+
+	bare := &HTTPError{StatusCode: 502}
+	assert.Equal(t, "API error: 502 Bad Gateway", bare.Error())
+}
+
+func TestHTTPError_StatusHelpers(t *testing.T) {
+	tests := []struct {
+		status       int
+		notFound     bool
+		unauthorized bool
+		forbidden    bool
+		conflict     bool
+		serverError  bool
+	}{
+		{404, true, false, false, false, false},
+		{401, false, true, false, false, false},
+		{403, false, false, true, false, false},
+		{409, false, false, false, true, false},
+		{500, false, false, false, false, true},
+		{503, false, false, false, false, true},
+		{400, false, false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.status), func(t *testing.T) {
+			err := &HTTPError{StatusCode: tt.status}
+			assert.Equal(t, tt.notFound, err.IsNotFound())
+			assert.Equal(t, tt.unauthorized, err.IsUnauthorized())
+			assert.Equal(t, tt.forbidden, err.IsForbidden())
+			assert.Equal(t, tt.conflict, err.IsConflict())
+			assert.Equal(t, tt.serverError, err.IsServerError())
+		})
+	}
+}
+
+func TestAsHTTPError_Wrapped(t *testing.T) {
+	wrapped := fmt.Errorf("failed to get notebook: %w", &HTTPError{StatusCode: 404})
+
+	httpErr, ok := AsHTTPError(wrapped)
+	assert.True(t, ok)
+	assert.Equal(t, 404, httpErr.StatusCode)
+	assert.True(t, IsNotFound(wrapped))
+	assert.False(t, IsUnauthorized(wrapped))
+
+	_, ok = AsHTTPError(fmt.Errorf("boom"))
+	assert.False(t, ok)
+	assert.False(t, IsNotFound(fmt.Errorf("boom")))
+}
+
+func TestWrapAPIError_HTTPError(t *testing.T) {
+	tests := []struct {
+		status int
+		want   ErrorType
+	}{
+		{404, ErrorTypeNotFound},
+		{401, ErrorTypeAuth},
+		{403, ErrorTypePermission},
+		{502, ErrorTypeServer},
+		{422, ErrorTypeAPI},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.status), func(t *testing.T) {
+			err := fmt.Errorf("failed: %w", &HTTPError{StatusCode: tt.status, Message: "details"})
+			cliErr := WrapAPIError(err, "Failed to get notebook")
+			assert.Equal(t, tt.want, cliErr.Type)
+			assert.Contains(t, cliErr.Message, "Failed to get notebook: API error:")
+			assert.Contains(t, cliErr.Message, "details")
+		})
+	}
+}
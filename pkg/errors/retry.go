@@ -0,0 +1,71 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+)
+
+// RetryExhaustedError is returned when all retry attempts of a request failed.
+// It keeps the last HTTP status (if any) and the last underlying error so the
+// cause is not lost on the way up to the command handlers.
+type RetryExhaustedError struct {
+	Attempts   int
+	StatusCode int
+	Cause      error
+}
+
+// Error implements the error interface
+func (e *RetryExhaustedError) Error() string {
+	return fmt.Sprintf("Failed after %d attempts: %v", e.Attempts, e.Cause)
+}
+
+// Unwrap returns the underlying error
+func (e *RetryExhaustedError) Unwrap() error {
+	return e.Cause
+}
+
+// WrapAPIError creates an API error for a failed operation. If the cause is
+// an exhausted retry, the attempt count and last status are added to the
+// message, and connection failures are reported as network errors, as is an
+// open circuit breaker. An
+// HTTPError cause adds the server's message and picks the error category
+// from its status (not found, auth, permission or server error).
+func WrapAPIError(err error, message string, suggestions ...string) *CLIError {
+	if httpErr, ok := AsHTTPError(err); ok {
+		return wrapHTTPError(httpErr, message, suggestions...)
+	}
+
+	var circuitErr *CircuitOpenError
+	if stderrors.As(err, &circuitErr) {
+		return NetworkError(fmt.Sprintf("%s: %s", message, circuitErr.Error()),
+			"Check that the API server is running",
+			"Run 'onb diagnose --reset-circuit' to retry immediately")
+	}
+
+	var retryErr *RetryExhaustedError
+	if !stderrors.As(err, &retryErr) {
+		return APIError(message, suggestions...)
+	}
+
+	message = fmt.Sprintf("%s: %s", message, retryErr.Error())
+	if retryErr.StatusCode == 0 {
+		return NetworkError(message, suggestions...)
+	}
+	return APIError(message, suggestions...)
+}
+
+// wrapHTTPError maps an error response to the matching CLI error category
+func wrapHTTPError(httpErr *HTTPError, message string, suggestions ...string) *CLIError {
+	message = fmt.Sprintf("%s: %s", message, httpErr.Error())
+	switch {
+	case httpErr.IsNotFound():
+		return NotFoundError(message, suggestions...)
+	case httpErr.IsUnauthorized():
+		return AuthError(message, suggestions...)
+	case httpErr.IsForbidden():
+		return NewCLIError(ErrorTypePermission, message, suggestions...)
+	case httpErr.IsServerError():
+		return NewCLIError(ErrorTypeServer, message, suggestions...)
+	}
+	return APIError(message, suggestions...)
+}
@@ -0,0 +1,29 @@
+package errors
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryExhaustedError_Error(t *testing.T) {
+	err := &RetryExhaustedError{Attempts: 4, StatusCode: 503, Cause: fmt.Errorf("HTTP 503: retryable status")}
+	assert.Equal(t, "Failed after 4 attempts: HTTP 503: retryable status", err.Error())
+}
+
+func TestWrapAPIError(t *testing.T) {
+	plain := WrapAPIError(fmt.Errorf("boom"), "Failed to list sources", "check api")
+	assert.Equal(t, ErrorTypeAPI, plain.Type)
+	assert.Equal(t, "Failed to list sources", plain.Message)
+
+	status := WrapAPIError(fmt.Errorf("failed to list sources: %w", &RetryExhaustedError{
+		Attempts: 4, StatusCode: 503, Cause: fmt.Errorf("HTTP 503: retryable status"),
+	}), "Failed to list sources")
+	assert.Equal(t, ErrorTypeAPI, status.Type)
+	assert.Equal(t, "Failed to list sources: Failed after 4 attempts: HTTP 503: retryable status", status.Message)
+
+	network := WrapAPIError(&RetryExhaustedError{Attempts: 4, Cause: fmt.Errorf("connection refused")}, "Failed to list sources")
+	assert.Equal(t, ErrorTypeNetwork, network.Type)
+	assert.Contains(t, network.Message, "Failed after 4 attempts: connection refused")
+}
@@ -3,106 +3,207 @@ package mocks
 import (
 	"context"
 	"io"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
 )
 
-// MockHTTPClient is a mock implementation of HTTPClient for testing
+// MockHTTPClient is a mock implementation of HTTPClient for testing. It
+// replays canned responses per endpoint, records every request in order and
+// can fail requests per method, per endpoint or all at once.
 type MockHTTPClient struct {
-	responses map[string]*models.Response
+	*MockBase
+	responses      map[string]*models.Response // by "METHOD endpoint", or endpoint for any method
+	endpointErrors map[string]error            // by "METHOD endpoint"
+	requests       []RecordedRequest
+	authToken      string
+	retryConfig    models.RetryConfig
+}
+
+// RecordedRequest is a request made through MockHTTPClient
+type RecordedRequest struct {
+	Method   string
+	Endpoint string
+	Body     interface{}       // request body of POST, PUT and streaming requests
+	Fields   map[string]string // form fields of multipart requests
+	Files    []string          // file field names of multipart requests
 }
 
 // NewMockHTTPClient creates a new mock HTTP client for testing
-func NewMockHTTPClient() shared.HTTPClient {
+func NewMockHTTPClient() *MockHTTPClient {
 	return &MockHTTPClient{
-		responses: make(map[string]*models.Response),
+		MockBase:       NewMockBase(0),
+		responses:      make(map[string]*models.Response),
+		endpointErrors: make(map[string]error),
 	}
 }
 
-// SetMockResponse sets a mock response for a specific endpoint
+// SetMockResponse sets a mock response for a specific endpoint, returned for
+// any method
 func (m *MockHTTPClient) SetMockResponse(endpoint string, response *models.Response) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.responses[endpoint] = response
 }
 
+// SetResponse sets the response for one method (GET, POST, PUT, DELETE) of
+// an endpoint. It takes precedence over SetMockResponse.
+func (m *MockHTTPClient) SetResponse(method, endpoint string, response *models.Response) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.responses[requestKey(method, endpoint)] = response
+}
+
+// SetJSONResponse sets a 200 response with the given JSON body for one
+// method of an endpoint
+func (m *MockHTTPClient) SetJSONResponse(method, endpoint, body string) {
+	m.SetResponse(method, endpoint, &models.Response{
+		StatusCode: http.StatusOK,
+		Body:       []byte(body),
+		Header:     map[string][]string{"Content-Type": {"application/json"}},
+	})
+}
+
+// SetEndpointError makes every request with method to endpoint fail with err
+func (m *MockHTTPClient) SetEndpointError(method, endpoint string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.endpointErrors[requestKey(method, endpoint)] = err
+}
+
+// Requests returns the requests made so far, in order
+func (m *MockHTTPClient) Requests() []RecordedRequest {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	requests := make([]RecordedRequest, len(m.requests))
+	copy(requests, m.requests)
+	return requests
+}
+
+// AuthToken returns the token last passed to SetAuth
+func (m *MockHTTPClient) AuthToken() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.authToken
+}
+
 // Get performs a mock HTTP GET request
 func (m *MockHTTPClient) Get(ctx context.Context, endpoint string) (*models.Response, error) {
-	if resp, ok := m.responses[endpoint]; ok {
-		return resp, nil
-	}
-	return &models.Response{
-		StatusCode: 404,
-		Body:       []byte(`{"error": "not found"}`),
-	}, nil
+	return m.do("Get", RecordedRequest{Method: http.MethodGet, Endpoint: endpoint})
 }
 
 // Post performs a mock HTTP POST request
 func (m *MockHTTPClient) Post(ctx context.Context, endpoint string, body interface{}) (*models.Response, error) {
-	if resp, ok := m.responses[endpoint]; ok {
-		return resp, nil
-	}
-	return &models.Response{
-		StatusCode: 404,
-		Body:       []byte(`{"error": "not found"}`),
-	}, nil
+	return m.do("Post", RecordedRequest{Method: http.MethodPost, Endpoint: endpoint, Body: body})
 }
 
 // Put performs a mock HTTP PUT request
 func (m *MockHTTPClient) Put(ctx context.Context, endpoint string, body interface{}) (*models.Response, error) {
-	if resp, ok := m.responses[endpoint]; ok {
-		return resp, nil
-	}
-	return &models.Response{
-		StatusCode: 404,
-		Body:       []byte(`{"error": "not found"}`),
-	}, nil
+	return m.do("Put", RecordedRequest{Method: http.MethodPut, Endpoint: endpoint, Body: body})
 }
 
 // Delete performs a mock HTTP DELETE request
 func (m *MockHTTPClient) Delete(ctx context.Context, endpoint string) (*models.Response, error) {
-	if resp, ok := m.responses[endpoint]; ok {
-		return resp, nil
-	}
-	return &models.Response{
-		StatusCode: 404,
-		Body:       []byte(`{"error": "not found"}`),
-	}, nil
+	return m.do("Delete", RecordedRequest{Method: http.MethodDelete, Endpoint: endpoint})
 }
 
-// PostMultipart performs a mock HTTP multipart POST request
+// PostMultipart performs a mock HTTP multipart POST request. The files are
+// recorded by field name; their readers are not consumed.
 func (m *MockHTTPClient) PostMultipart(ctx context.Context, endpoint string, fields map[string]string, files map[string]io.Reader) (*models.Response, error) {
-	if resp, ok := m.responses[endpoint]; ok {
-		return resp, nil
+	request := RecordedRequest{Method: http.MethodPost, Endpoint: endpoint, Fields: fields}
+	for name := range files {
+		request.Files = append(request.Files, name)
 	}
-	return &models.Response{
-		StatusCode: 404,
-		Body:       []byte(`{"error": "not found"}`),
-	}, nil
+	return m.do("PostMultipart", request)
 }
 
-// Stream performs a mock HTTP streaming request
+// Stream performs a mock HTTP streaming request, sending the body of the
+// canned POST response of endpoint as a single chunk
 func (m *MockHTTPClient) Stream(ctx context.Context, endpoint string, body interface{}) (<-chan []byte, error) {
-	ch := make(chan []byte, 1)
-
-	go func() {
-		defer close(ch)
-		if resp, ok := m.responses[endpoint]; ok {
-			ch <- resp.Body
-		} else {
-			ch <- []byte(`{"error": "not found"}`)
-		}
-	}()
+	resp, err := m.do("Stream", RecordedRequest{Method: http.MethodPost, Endpoint: endpoint, Body: body})
+	if err != nil {
+		return nil, err
+	}
 
+	ch := make(chan []byte, 1)
+	ch <- resp.Body
+	close(ch)
 	return ch, nil
 }
 
-// SetAuth mock implementation
+// SetAuth records the token
 func (m *MockHTTPClient) SetAuth(token string) {
-	// Mock implementation - no-op
+	m.mu.Lock()
+	m.authToken = token
+	m.mu.Unlock()
+	m.RecordCall("SetAuth", []interface{}{token}, nil, nil)
 }
 
-// WithTimeout mock implementation
+// WithTimeout returns the same mock, so requests made through the returned
+// client are recorded and answered alike
 func (m *MockHTTPClient) WithTimeout(timeout time.Duration) shared.HTTPClient {
+	m.RecordCall("WithTimeout", []interface{}{timeout}, nil, nil)
 	return m
 }
+
+// GetRetryConfig returns the retry configuration last set
+func (m *MockHTTPClient) GetRetryConfig() models.RetryConfig {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.retryConfig
+}
+
+// SetRetryConfig records the retry configuration; the mock never retries
+func (m *MockHTTPClient) SetRetryConfig(config models.RetryConfig) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.retryConfig = config
+}
+
+// do answers a request: with the failure set by SetFailure, SetError or
+// SetEndpointError, else with its canned response or a 404. Every request
+// is recorded, under method for GetCalls and in order for Requests.
+func (m *MockHTTPClient) do(method string, request RecordedRequest) (*models.Response, error) {
+	m.simulateDelay()
+
+	m.mu.Lock()
+	m.requests = append(m.requests, request)
+	key := requestKey(request.Method, request.Endpoint)
+	endpointErr := m.endpointErrors[key]
+	resp, ok := m.responses[key]
+	if !ok {
+		resp, ok = m.responses[request.Endpoint]
+	}
+	m.mu.Unlock()
+
+	args := []interface{}{request.Endpoint, request.Body}
+	err := m.checkFailure()
+	if err == nil {
+		err = m.GetError(method)
+	}
+	if err == nil {
+		err = endpointErr
+	}
+	if err != nil {
+		m.RecordCall(method, args, nil, err)
+		return nil, err
+	}
+
+	if !ok {
+		resp = &models.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       []byte(`{"error": "not found"}`),
+		}
+	}
+	m.RecordCall(method, args, resp, nil)
+	return resp, nil
+}
+
+// requestKey identifies the canned response of a method and endpoint
+func requestKey(method, endpoint string) string {
+	return strings.ToUpper(method) + " " + endpoint
+}
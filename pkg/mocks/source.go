@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"math"
 	"strings"
 	"time"
 
@@ -104,6 +105,61 @@ func (m *MockSourceRepository) List(ctx context.Context, limit, offset int) ([]*
 	return result, nil
 }
 
+// ListByNotebook implements SourceRepository interface
+func (m *MockSourceRepository) ListByNotebook(ctx context.Context, notebookID string, limit, offset int) ([]*models.SourceListResponse, error) {
+	m.simulateDelay()
+
+	if err := m.checkFailure(); err != nil {
+		m.RecordCall("ListByNotebook", []interface{}{ctx, notebookID, limit, offset}, nil, err)
+		return nil, err
+	}
+
+	if err := m.GetError("ListByNotebook"); err != nil {
+		m.RecordCall("ListByNotebook", []interface{}{ctx, notebookID, limit, offset}, nil, err)
+		return nil, err
+	}
+
+	m.mu.RLock()
+	var matching []*models.Source
+	for _, src := range m.sources {
+		for _, id := range src.Notebooks {
+			if id == notebookID {
+				srcCopy := *src
+				matching = append(matching, &srcCopy)
+				break
+			}
+		}
+	}
+	m.mu.RUnlock()
+
+	start := offset
+	if start > len(matching) {
+		start = len(matching)
+	}
+	end := start + limit
+	if end > len(matching) {
+		end = len(matching)
+	}
+
+	result := make([]*models.SourceListResponse, 0, end-start)
+	for _, src := range matching[start:end] {
+		result = append(result, &models.SourceListResponse{
+			ID:             src.ID,
+			Title:          src.Title,
+			Topics:         src.Topics,
+			Asset:          src.Asset,
+			Embedded:       src.Embedded,
+			EmbeddedChunks: src.EmbeddedChunks,
+			Created:        src.Created,
+			Updated:        src.Updated,
+			Status:         src.Status,
+		})
+	}
+
+	m.RecordCall("ListByNotebook", []interface{}{ctx, notebookID, limit, offset}, result, nil)
+	return result, nil
+}
+
 // Create implements SourceRepository interface
 func (m *MockSourceRepository) Create(ctx context.Context, source *models.SourceCreate) (*models.Source, error) {
 	m.simulateDelay()
@@ -148,6 +204,21 @@ func (m *MockSourceRepository) CreateFromJSON(ctx context.Context, source *model
 	return m.Create(ctx, source)
 }
 
+// Upload implements SourceRepository interface
+func (m *MockSourceRepository) Upload(ctx context.Context, filename string, file io.Reader, source *models.SourceCreate) (*models.Source, error) {
+	// Drain the reader like a real upload would, then create the source
+	if _, err := io.Copy(io.Discard, file); err != nil {
+		m.RecordCall("Upload", []interface{}{ctx, filename, source}, nil, err)
+		return nil, err
+	}
+	return m.Create(ctx, source)
+}
+
+// UploadChunked implements SourceRepository interface
+func (m *MockSourceRepository) UploadChunked(ctx context.Context, filename string, file io.Reader, source *models.SourceCreate, options *models.ChunkedUploadOptions) (*models.Source, error) {
+	return m.Upload(ctx, filename, file, source)
+}
+
 // Get implements SourceRepository interface
 func (m *MockSourceRepository) Get(ctx context.Context, id string) (*models.Source, error) {
 	m.simulateDelay()
@@ -275,23 +346,23 @@ func (m *MockSourceRepository) GetStatus(ctx context.Context, id string) (*model
 }
 
 // Download implements SourceRepository interface
-func (m *MockSourceRepository) Download(ctx context.Context, id string) (io.ReadCloser, error) {
+func (m *MockSourceRepository) Download(ctx context.Context, id string) (io.ReadCloser, *models.DownloadMetadata, error) {
 	m.simulateDelay()
 
 	if err := m.checkFailure(); err != nil {
 		m.RecordCall("Download", []interface{}{ctx, id}, nil, err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	if err := m.GetError("Download"); err != nil {
 		m.RecordCall("Download", []interface{}{ctx, id}, nil, err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	src, err := m.Get(ctx, id)
 	if err != nil {
 		m.RecordCall("Download", []interface{}{ctx, id}, nil, err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Return content as a reader
@@ -307,8 +378,13 @@ func (m *MockSourceRepository) Download(ctx context.Context, id string) (io.Read
 	}
 
 	reader := io.NopCloser(strings.NewReader(content))
+	metadata := &models.DownloadMetadata{
+		Filename:    id + ".txt",
+		ContentType: "text/plain",
+		Size:        int64(len(content)),
+	}
 	m.RecordCall("Download", []interface{}{ctx, id}, reader, nil)
-	return reader, nil
+	return reader, metadata, nil
 }
 
 // GetInsights implements SourceRepository interface
@@ -370,6 +446,52 @@ func (m *MockSourceRepository) CreateInsight(ctx context.Context, sourceID strin
 	return insight, nil
 }
 
+// SaveInsightAsNote implements SourceRepository interface
+func (m *MockSourceRepository) SaveInsightAsNote(ctx context.Context, insightID string, req *models.SaveAsNoteRequest) (*models.Note, error) {
+	m.simulateDelay()
+
+	if err := m.checkFailure(); err != nil {
+		m.RecordCall("SaveInsightAsNote", []interface{}{ctx, insightID, req}, nil, err)
+		return nil, err
+	}
+
+	if err := m.GetError("SaveInsightAsNote"); err != nil {
+		m.RecordCall("SaveInsightAsNote", []interface{}{ctx, insightID, req}, nil, err)
+		return nil, err
+	}
+
+	var insight *models.SourceInsightResponse
+	m.mu.RLock()
+	for _, insights := range m.insights {
+		for _, candidate := range insights {
+			if candidate.ID == insightID {
+				insight = candidate
+			}
+		}
+	}
+	m.mu.RUnlock()
+
+	if insight == nil {
+		err := errors.New("insight not found")
+		m.RecordCall("SaveInsightAsNote", []interface{}{ctx, insightID, req}, nil, err)
+		return nil, err
+	}
+
+	id := "mock-note-" + generateID()
+	noteType := models.NoteTypeAI
+	note := &models.Note{
+		ID:       &id,
+		Title:    stringPtr(string(insight.InsightType)),
+		Content:  stringPtr(insight.Content),
+		NoteType: &noteType,
+		Created:  currentTime().Format(time.RFC3339),
+		Updated:  currentTime().Format(time.RFC3339),
+	}
+
+	m.RecordCall("SaveInsightAsNote", []interface{}{ctx, insightID, req}, note, nil)
+	return note, nil
+}
+
 // AddInsight adds an insight to the mock repository
 func (m *MockSourceRepository) AddInsight(sourceID string, insight *models.SourceInsightResponse) {
 	m.mu.Lock()
@@ -401,6 +523,11 @@ func (m *MockSourceService) List(ctx context.Context, limit, offset int) ([]*mod
 	return m.repository.List(ctx, limit, offset)
 }
 
+// ListAllByNotebook implements SourceService interface
+func (m *MockSourceService) ListAllByNotebook(ctx context.Context, notebookID string) ([]*models.SourceListResponse, error) {
+	return m.repository.ListByNotebook(ctx, notebookID, math.MaxInt32, 0)
+}
+
 // AddSourceFromLink implements SourceService interface
 func (m *MockSourceService) AddSourceFromLink(ctx context.Context, link string, options *models.SourceOptions) (*models.Source, error) {
 	title := "Mock Source from Link"
@@ -488,7 +615,7 @@ func (m *MockSourceService) GetStatus(ctx context.Context, id string) (*models.S
 }
 
 // Download implements SourceService interface
-func (m *MockSourceService) Download(ctx context.Context, id string) (io.ReadCloser, error) {
+func (m *MockSourceService) Download(ctx context.Context, id string) (io.ReadCloser, *models.DownloadMetadata, error) {
 	return m.repository.Download(ctx, id)
 }
 
@@ -512,6 +639,15 @@ func (m *MockSourceService) CreateInsight(ctx context.Context, sourceID string,
 	return m.repository.CreateInsight(ctx, sourceID, request)
 }
 
+// SaveInsightAsNote implements SourceService interface
+func (m *MockSourceService) SaveInsightAsNote(ctx context.Context, insightID string, notebookID string) (*models.Note, error) {
+	req := &models.SaveAsNoteRequest{}
+	if notebookID != "" {
+		req.NotebookID = &notebookID
+	}
+	return m.repository.SaveInsightAsNote(ctx, insightID, req)
+}
+
 // GetRepository returns the underlying mock repository for testing purposes
 func (m *MockSourceService) GetRepository() *MockSourceRepository {
 	return m.repository
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// Auth models
+
+// TokenLifetime is how long a login token, and the server's answer that
+// authentication is disabled, are reused
+const TokenLifetime = time.Hour
+
+// StoredToken represents an authentication token persisted in the config dir
+type StoredToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+	APIURL    string    `json:"api_url"`
+
+	// AuthDisabled records that the server at APIURL needs no token
+	AuthDisabled bool `json:"auth_disabled,omitempty"`
+}
+
+// IsExpired reports whether the stored token has passed its expiry time
+func (t *StoredToken) IsExpired() bool {
+	return !t.ExpiresAt.IsZero() && time.Now().After(t.ExpiresAt)
+}
+
+// AuthStatusResponse represents the server's authentication configuration
+type AuthStatusResponse struct {
+	AuthEnabled bool   `json:"auth_enabled"`
+	Message     string `json:"message,omitempty"`
+}
+
+// AuthStatus combines the server's auth mode with the CLI's token state
+type AuthStatus struct {
+	AuthEnabled bool `json:"auth_enabled"`
+	TokenValid  bool `json:"token_valid"`
+}
@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 // Chat API models
 
 // ChatSession represents a chat session
@@ -38,13 +40,31 @@ type ChatExecuteResponse struct {
 	Content   string `json:"content"`
 	ModelID   string `json:"model_id"`
 	Created   string `json:"created"`
+	// ContextSources lists the context the server used, when it reports it
+	ContextSources []ChatContextSource `json:"context_sources,omitempty"`
+	Usage          *TokenUsage         `json:"usage,omitempty"`
+}
+
+// LastChatSession records the most recently used chat session of a notebook
+type LastChatSession struct {
+	SessionID string    `json:"session_id"`
+	APIURL    string    `json:"api_url"`
+	Updated   time.Time `json:"updated"`
+}
+
+// ChatContextSource is a source or note that informed a chat response
+type ChatContextSource struct {
+	ID    string `json:"id"`
+	Title string `json:"title,omitempty"`
+	Type  string `json:"type,omitempty"`
 }
 
 // ChatContextRequest represents chat context request
 type ChatContextRequest struct {
-	NotebookID string   `json:"notebook_id,omitempty"`
-	Sources    []string `json:"sources,omitempty"`
-	MaxTokens  *int     `json:"max_tokens,omitempty"`
+	NotebookID    string         `json:"notebook_id,omitempty"`
+	Sources       []string       `json:"sources,omitempty"`
+	MaxTokens     *int           `json:"max_tokens,omitempty"`
+	ContextConfig *ContextConfig `json:"context_config,omitempty"` // per item relevance levels
 }
 
 // ChatSessionsResponse represents chat sessions list response
@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 // Common enums and types used across multiple model files
 
 // YesNoDecision represents yes/no decision with type safety
@@ -83,6 +85,19 @@ type StreamChunk struct {
 	Content string `json:"content"`
 	Done    bool   `json:"done"`
 	Error   string `json:"error,omitempty"`
+	// SessionID is set by chat streams that report the session they used
+	SessionID string `json:"session_id,omitempty"`
+	// ContextSources is set by chat streams, usually on the final chunk
+	ContextSources []ChatContextSource `json:"context_sources,omitempty"`
+	// Usage reports token counts, usually on the final chunk
+	Usage *TokenUsage `json:"usage,omitempty"`
+}
+
+// TokenUsage reports the tokens a model call consumed, when the server provides them
+type TokenUsage struct {
+	ContextTokens int `json:"context_tokens,omitempty"`
+	InputTokens   int `json:"input_tokens,omitempty"`
+	OutputTokens  int `json:"output_tokens,omitempty"`
 }
 
 // HTTP Response wrapper
@@ -92,6 +107,47 @@ type Response struct {
 	Header     map[string][]string
 }
 
+// CachedResponse is a GET response kept on disk for ETag revalidation
+type CachedResponse struct {
+	ETag     string              `json:"etag"`
+	Header   map[string][]string `json:"header"`
+	Body     []byte              `json:"body"`
+	StoredAt time.Time           `json:"stored_at"`
+}
+
+// CircuitState is the persisted state of the circuit breaker for one API URL
+type CircuitState struct {
+	APIURL       string    `json:"api_url"`
+	Failures     int       `json:"failures"`
+	FirstFailure time.Time `json:"first_failure"`
+	OpenedAt     time.Time `json:"opened_at,omitempty"`
+}
+
+// Circuit breaker states
+const (
+	CircuitClosed   = "closed"
+	CircuitOpen     = "open"
+	CircuitHalfOpen = "half-open"
+)
+
+// CircuitStatus reports the circuit breaker state for diagnostics
+type CircuitStatus struct {
+	State     string    `json:"state"`
+	Failures  int       `json:"failures"`
+	Threshold int       `json:"threshold"`
+	OpenUntil time.Time `json:"open_until,omitempty"`
+}
+
+// RetryConfig holds retry configuration for network operations
+type RetryConfig struct {
+	MaxRetries      int           `json:"max_retries"`
+	BaseDelay       time.Duration `json:"base_delay"`
+	MaxDelay        time.Duration `json:"max_delay"`
+	BackoffFactor   float64       `json:"backoff_factor"`
+	RetryableErrors []string      `json:"retryable_errors"`
+	RetryableStatus []int         `json:"retryable_status"`
+}
+
 // Error response
 type ErrorResponse struct {
 	Error   string `json:"error"`
@@ -118,10 +174,13 @@ type AskOptions struct {
 
 // SourceOptions represents source creation options
 type SourceOptions struct {
+	Title           string
+	Notebooks       []string
 	Transformations []string
 	Embed           bool
 	DeleteSource    bool
 	AsyncProcessing bool
+	ChunkSize       int64 // upload in chunks of this size when > 0
 }
 
 // TransformationOptions represents transformation execution options
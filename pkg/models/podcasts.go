@@ -67,3 +67,15 @@ type PodcastJobStatus struct {
 	Created   string   `json:"created"`
 	Updated   *string  `json:"updated,omitempty"`
 }
+
+// PodcastVoice represents a voice offered by the text-to-speech model
+type PodcastVoice struct {
+	Name      string   `json:"name"`
+	Languages []string `json:"languages,omitempty"` // empty means all languages
+	ModelID   string   `json:"model_id,omitempty"`
+}
+
+// PodcastVoicesResponse represents available voices response
+type PodcastVoicesResponse struct {
+	Voices []PodcastVoice `json:"voices"`
+}
@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 // Search models from OpenNotebook API
 
 // SearchType represents search type with type safety
@@ -35,6 +37,14 @@ type SearchResult struct {
 	Title     string  `json:"title"`
 }
 
+// SavedSearch is a named search stored in the config dir
+type SavedSearch struct {
+	Name     string        `json:"name"`
+	Request  SearchRequest `json:"request"`
+	Notebook string        `json:"notebook,omitempty"` // notebook ID or name, resolved when run
+	Created  time.Time     `json:"created"`
+}
+
 // AskRequest represents ask request
 type AskRequest struct {
 	Question         string `json:"question"`
@@ -45,6 +55,7 @@ type AskRequest struct {
 
 // AskResponse represents ask response
 type AskResponse struct {
-	Answer   string `json:"answer"`
-	Question string `json:"question"`
+	Answer   string      `json:"answer"`
+	Question string      `json:"question"`
+	Usage    *TokenUsage `json:"usage,omitempty"`
 }
@@ -1,5 +1,10 @@
 package models
 
+import (
+	"fmt"
+	"strings"
+)
+
 // Sources API models
 
 // SourceType represents source type with type safety
@@ -83,3 +88,110 @@ type SourceStatusResponse struct {
 	ProcessingInfo map[string]any `json:"processing_info,omitempty"`
 	CommandID      *string        `json:"command_id,omitempty"`
 }
+
+// DownloadMetadata describes a downloaded file as reported by the response headers
+type DownloadMetadata struct {
+	Filename    string // from Content-Disposition, empty if not provided
+	ContentType string // from Content-Type, empty if not provided
+	Size        int64  // content length in bytes
+}
+
+// Chunked upload models
+
+// ChunkedUploadInit represents the request to start a chunked upload
+type ChunkedUploadInit struct {
+	Filename  string `json:"filename"`
+	ChunkSize int64  `json:"chunk_size"`
+}
+
+// ChunkedUploadSession represents an upload session returned by the API
+type ChunkedUploadSession struct {
+	UploadID string `json:"upload_id"`
+}
+
+// ChunkedUploadComplete represents the request to finalize a chunked upload
+type ChunkedUploadComplete struct {
+	TotalChunks int   `json:"total_chunks"`
+	TotalSize   int64 `json:"total_size"`
+	SourceCreate
+}
+
+// ChunkedUploadOptions controls how a file is split and retried during chunked upload
+type ChunkedUploadOptions struct {
+	ChunkSize  int64
+	MaxRetries int
+}
+
+// Bulk import models
+
+// SourceImportItem describes one source in a bulk import file.
+// Exactly one of URL, Text or File must be set.
+type SourceImportItem struct {
+	Title           string   `json:"title"`
+	URL             string   `json:"url,omitempty"`
+	Text            string   `json:"text,omitempty"`
+	File            string   `json:"file,omitempty"`
+	Notebooks       []string `json:"notebooks,omitempty"`
+	Transformations []string `json:"transformations,omitempty"`
+	Embed           *bool    `json:"embed,omitempty"`
+	DeleteSource    bool     `json:"delete_source,omitempty"`
+}
+
+// Type returns the source type implied by the populated content field
+func (i *SourceImportItem) Type() SourceType {
+	switch {
+	case i.URL != "":
+		return SourceTypeLink
+	case i.File != "":
+		return SourceTypeUpload
+	default:
+		return SourceTypeText
+	}
+}
+
+// Validate checks the item and returns every problem found
+func (i *SourceImportItem) Validate() []error {
+	var problems []error
+
+	if strings.TrimSpace(i.Title) == "" {
+		problems = append(problems, fmt.Errorf("title is required"))
+	}
+
+	set := 0
+	for _, v := range []string{i.URL, i.Text, i.File} {
+		if v != "" {
+			set++
+		}
+	}
+	switch {
+	case set == 0:
+		problems = append(problems, fmt.Errorf("one of url, text or file is required"))
+	case set > 1:
+		problems = append(problems, fmt.Errorf("only one of url, text or file may be set"))
+	}
+
+	if i.URL != "" && !strings.HasPrefix(i.URL, "http://") && !strings.HasPrefix(i.URL, "https://") {
+		problems = append(problems, fmt.Errorf("url %q must start with http:// or https://", i.URL))
+	}
+
+	for _, id := range i.Notebooks {
+		if !IsValidNotebookID(id) {
+			problems = append(problems, fmt.Errorf("invalid notebook ID %q", id))
+		}
+	}
+
+	return problems
+}
+
+// IsValidNotebookID reports whether id looks like a notebook record ID,
+// either bare ("abc123") or table-qualified ("notebook:abc123").
+func IsValidNotebookID(id string) bool {
+	if id == "" || strings.ContainsAny(id, " \t\r\n/") {
+		return false
+	}
+	table, key, qualified := strings.Cut(id, ":")
+	if !qualified {
+		return true
+	}
+	return table == "notebook" && key != ""
+}
@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// System API models
+
+// ServerInfo represents the server configuration response
+type ServerInfo struct {
+	Version       string `json:"version"`
+	LatestVersion string `json:"latestVersion,omitempty"`
+	HasUpdate     bool   `json:"hasUpdate,omitempty"`
+}
+
+// HealthResponse represents the server health response
+type HealthResponse struct {
+	Status string `json:"status"`
+}
+
+// IsHealthy reports whether the reported status means the server is up
+func (h *HealthResponse) IsHealthy() bool {
+	switch h.Status {
+	case "", "ok", "healthy", "up":
+		return true
+	}
+	return false
+}
+
+// RequestStats summarizes the HTTP traffic of one CLI invocation
+type RequestStats struct {
+	Requests      int           `json:"requests"`
+	Retries       int           `json:"retries"`
+	TotalLatency  time.Duration `json:"total_latency"`
+	MaxLatency    time.Duration `json:"max_latency"`
+	BytesSent     int64         `json:"bytes_sent"`
+	BytesReceived int64         `json:"bytes_received"`
+}
+
+// AvgLatency returns the mean latency per request
+func (s RequestStats) AvgLatency() time.Duration {
+	if s.Requests == 0 {
+		return 0
+	}
+	return s.TotalLatency / time.Duration(s.Requests)
+}
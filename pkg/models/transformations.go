@@ -33,8 +33,10 @@ type Transformation struct {
 }
 
 // TransformationExecuteRequest represents transformation execution request
+// Prompt is only set for ephemeral (unsaved) transformations, e.g. prompt previews
 type TransformationExecuteRequest struct {
-	TransformationID string `json:"transformation_id"`
+	TransformationID string `json:"transformation_id,omitempty"`
+	Prompt           string `json:"prompt,omitempty"`
 	InputText        string `json:"input_text"`
 	ModelID          string `json:"model_id"`
 }
@@ -374,3 +374,19 @@ func TestEnumValueUniqueness(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+func TestSourceImportItemValidate(t *testing.T) {
+	valid := SourceImportItem{Title: "Doc", URL: "https://example.com", Notebooks: []string{"notebook:abc", "xyz"}}
+	assert.Empty(t, valid.Validate())
+	assert.Equal(t, SourceTypeLink, valid.Type())
+
+	missing := SourceImportItem{}
+	assert.Len(t, missing.Validate(), 2)
+
+	ambiguous := SourceImportItem{Title: "Doc", Text: "body", File: "doc.pdf"}
+	assert.Len(t, ambiguous.Validate(), 1)
+
+	badNotebook := SourceImportItem{Title: "Doc", Text: "body", Notebooks: []string{"source:abc", ""}}
+	assert.Len(t, badNotebook.Validate(), 2)
+	assert.Equal(t, SourceTypeText, badNotebook.Type())
+}
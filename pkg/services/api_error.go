@@ -0,0 +1,72 @@
+package services
+
+import (
+	"encoding/json"
+	"html"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+)
+
+// maxErrorBodyLength bounds the part of a non-JSON error body that ends up
+// in an error message
+const maxErrorBodyLength = 200
+
+var (
+	htmlTagPattern    = regexp.MustCompile(`(?s)<(script|style)[^>]*>.*?</(script|style)>|<[^>]*>`)
+	whitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// parseAPIError turns an error response from endpoint into an
+// errors.HTTPError with a concise message. JSON bodies contribute their
+// message; other bodies, such as the HTML page of a proxy returning 502,
+// are stripped of markup and truncated.
+func parseAPIError(resp *models.Response, endpoint string) error {
+	return &errors.HTTPError{
+		StatusCode: resp.StatusCode,
+		Body:       resp.Body,
+		Endpoint:   endpoint,
+		Message:    apiErrorDetail(resp),
+	}
+}
+
+// apiErrorDetail extracts a readable message from an error response body
+func apiErrorDetail(resp *models.Response) string {
+	body := strings.TrimSpace(string(resp.Body))
+	if body == "" {
+		return ""
+	}
+
+	contentType := http.Header(resp.Header).Get("Content-Type")
+	if strings.Contains(contentType, "json") || (contentType == "" && json.Valid(resp.Body)) {
+		var payload struct {
+			models.ErrorResponse
+			Detail any `json:"detail"`
+		}
+		if err := json.Unmarshal(resp.Body, &payload); err == nil {
+			switch {
+			case payload.Message != "":
+				return payload.Message
+			case payload.Error != "":
+				return payload.Error
+			case payload.Detail != nil:
+				if detail, ok := payload.Detail.(string); ok {
+					return detail
+				}
+				if encoded, err := json.Marshal(payload.Detail); err == nil {
+					return utils.TruncateString(string(encoded), maxErrorBodyLength)
+				}
+			}
+		}
+	}
+
+	if strings.Contains(contentType, "html") || strings.HasPrefix(body, "<") {
+		body = html.UnescapeString(htmlTagPattern.ReplaceAllString(body, " "))
+	}
+	body = strings.TrimSpace(whitespacePattern.ReplaceAllString(body, " "))
+	return utils.TruncateString(body, maxErrorBodyLength)
+}
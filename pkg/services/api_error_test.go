@@ -0,0 +1,49 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAPIError(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		contentType string
+		body        string
+		want        string
+	}{
+		{"json message", 404, "application/json", `{"error":"not_found","message":"Notebook not found"}`,
+			"API error: 404 Not Found: Notebook not found"},
+		{"json detail", 422, "application/json", `{"detail":"Invalid notebook ID"}`,
+			"API error: 422 Unprocessable Entity: Invalid notebook ID"},
+		{"json without content type", 400, "", `{"error":"bad_request"}`,
+			"API error: 400 Bad Request: bad_request"},
+		{"html proxy page", 502, "text/html", "<html><head><title>502 Bad Gateway</title><style>body{}</style></head>" +
+			"<body><h1>Bad&nbsp;Gateway</h1>\n<hr><center>nginx</center></body></html>",
+			"API error: 502 Bad Gateway: 502 Bad Gateway Bad Gateway nginx"},
+		{"plain text", 500, "text/plain", "  internal\n  failure ", "API error: 500 Internal Server Error: internal failure"},
+		{"empty body", 503, "", "", "API error: 503 Service Unavailable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &models.Response{StatusCode: tt.status, Body: []byte(tt.body), Header: map[string][]string{}}
+			if tt.contentType != "" {
+				resp.Header["Content-Type"] = []string{tt.contentType}
+			}
+			assert.EqualError(t, parseAPIError(resp, "/test"), tt.want)
+		})
+	}
+}
+
+func TestParseAPIError_TruncatesLongBodies(t *testing.T) {
+	resp := &models.Response{StatusCode: 500, Body: []byte(strings.Repeat("x", 1000))}
+
+	err := parseAPIError(resp, "/test")
+	assert.LessOrEqual(t, len(err.Error()), len("API error: 500 Internal Server Error: ")+maxErrorBodyLength)
+	assert.True(t, strings.HasSuffix(err.Error(), "..."))
+}
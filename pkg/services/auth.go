@@ -4,6 +4,8 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"sync"
@@ -15,22 +17,38 @@ import (
 	"github.com/samber/do/v2"
 )
 
+// BaseHTTPClientName names the HTTP client without the authentication
+// decorator. Auth talks to the server through it; everything else uses the
+// shared.HTTPClient that authenticates on first use.
+const BaseHTTPClientName = "http-client-base"
+
+// errNoPassword is returned by Authenticate when there is neither a valid
+// token nor a password to log in with
+var errNoPassword = errors.New("no password provided")
+
 // Private auth implementation
 type auth struct {
 	config   config.Service
 	logger   shared.Logger
 	http     shared.HTTPClient
+	store    shared.TokenStore // nil when the token cache is disabled
 	mu       sync.RWMutex
+	loginMu  sync.Mutex // lets one caller at a time log in
 	token    string
 	tokenEx  time.Time
 	password string
+
+	// authDisabled records that the server reported auth_enabled: false,
+	// so later calls skip authentication. It is cached with the token, so
+	// later runs skip the /auth/status check too.
+	authDisabled bool
 }
 
 // NewAuth creates a new auth service
 func NewAuth(injector do.Injector) (shared.Auth, error) {
 	cfg := do.MustInvoke[config.Service](injector)
 	logger := do.MustInvoke[shared.Logger](injector)
-	http := do.MustInvoke[shared.HTTPClient](injector)
+	http := do.MustInvokeNamed[shared.HTTPClient](injector, BaseHTTPClientName)
 
 	a := &auth{
 		config: cfg,
@@ -41,26 +59,38 @@ func NewAuth(injector do.Injector) (shared.Auth, error) {
 	// Set password from config
 	a.password = cfg.GetPassword()
 
+	// Reuse a token from a previous invocation when caching is enabled
+	if cfg.UseTokenCache() {
+		a.store = do.MustInvoke[shared.TokenStore](injector)
+		a.loadCachedToken()
+	}
+
 	return a, nil
 }
 
 // Interface implementation
 
 func (a *auth) Authenticate(ctx context.Context) error {
-	if a.password == "" {
-		return fmt.Errorf("no password provided")
-	}
+	// Concurrent callers wait for one login and then find its token
+	a.loginMu.Lock()
+	defer a.loginMu.Unlock()
 
-	// If we have a valid cached token, return success
+	// A valid token, e.g. one cached by 'auth login', needs no password
 	a.mu.RLock()
-	if a.token != "" && time.Now().Before(a.tokenEx) {
-		a.mu.RUnlock()
+	disabled := a.authDisabled
+	valid := a.token != "" && time.Now().Before(a.tokenEx)
+	password := a.password
+	a.mu.RUnlock()
+	if disabled || valid {
 		return nil
 	}
-	a.mu.RUnlock()
+
+	if password == "" {
+		return errNoPassword
+	}
 
 	// Generate token hash for authentication
-	tokenHash := a.generateTokenHash(a.password)
+	tokenHash := a.generateTokenHash(password)
 
 	// Create auth endpoint request
 	endpoint := "/auth/status"
@@ -74,12 +104,27 @@ func (a *auth) Authenticate(ctx context.Context) error {
 		return fmt.Errorf("authentication failed: status %d", resp.StatusCode)
 	}
 
+	// Open instances need no token; skip the auth flow unless --force-auth
+	var status models.AuthStatusResponse
+	if err := json.Unmarshal(resp.Body, &status); err == nil && !status.AuthEnabled && !a.config.ForceAuth() {
+		a.mu.Lock()
+		a.authDisabled = true
+		a.mu.Unlock()
+
+		a.saveCachedToken()
+		a.logger.Debug("Server has authentication disabled, skipping auth")
+		return nil
+	}
+
 	// Cache the token
 	a.mu.Lock()
 	a.token = tokenHash
-	a.tokenEx = time.Now().Add(1 * time.Hour) // Cache for 1 hour
+	a.tokenEx = time.Now().Add(models.TokenLifetime)
 	a.mu.Unlock()
 
+	a.http.SetAuth(tokenHash)
+	a.saveCachedToken()
+
 	a.logger.Debug("Authentication successful", "expires_at", a.tokenEx)
 	return nil
 }
@@ -105,6 +150,13 @@ func (a *auth) InvalidateToken(ctx context.Context) error {
 
 	a.token = ""
 	a.tokenEx = time.Time{}
+	a.http.SetAuth("")
+
+	if a.store != nil {
+		if err := a.store.Clear(); err != nil {
+			return err
+		}
+	}
 
 	a.logger.Debug("Token invalidated")
 	return nil
@@ -118,9 +170,50 @@ func (a *auth) IsAuthenticated(ctx context.Context) bool {
 }
 
 func (a *auth) RefreshToken(ctx context.Context) error {
+	// Drop the current token so Authenticate does not short-circuit on it.
+	// A 401 also means the server does require auth after all.
+	a.mu.Lock()
+	a.token = ""
+	a.tokenEx = time.Time{}
+	a.authDisabled = false
+	a.mu.Unlock()
+
 	return a.Authenticate(ctx)
 }
 
+// Status asks the server whether auth is enabled and reports whether the
+// current token is still valid
+func (a *auth) Status(ctx context.Context) (*models.AuthStatus, error) {
+	resp, err := a.http.Get(ctx, "/auth/status")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get auth status: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/auth/status")
+	}
+
+	var result models.AuthStatusResponse
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse auth status response: %w", err)
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return &models.AuthStatus{
+		AuthEnabled: result.AuthEnabled,
+		TokenValid:  a.token != "" && time.Now().Before(a.tokenEx),
+	}, nil
+}
+
+func (a *auth) TokenExpiry(ctx context.Context) time.Time {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.tokenEx
+}
+
 func (a *auth) SetPassword(password string) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
@@ -138,12 +231,68 @@ func (a *auth) generateTokenHash(password string) string {
 	return hex.EncodeToString(hash[:])
 }
 
+// loadCachedToken restores a stored token if it is still valid for this API URL
+func (a *auth) loadCachedToken() {
+	stored, err := a.store.Load()
+	if err != nil {
+		a.logger.Debug("Ignoring unreadable token cache", "error", err)
+		return
+	}
+	if stored == nil || (stored.Token == "" && !stored.AuthDisabled) {
+		return
+	}
+	if stored.APIURL != a.config.GetAPIURL() || stored.IsExpired() {
+		a.logger.Debug("Ignoring stale cached token", "api_url", stored.APIURL, "expires_at", stored.ExpiresAt)
+		return
+	}
+
+	if stored.AuthDisabled {
+		a.mu.Lock()
+		a.authDisabled = true
+		a.mu.Unlock()
+
+		a.logger.Debug("Server has authentication disabled (cached)", "expires_at", stored.ExpiresAt)
+		return
+	}
+
+	a.mu.Lock()
+	a.token = stored.Token
+	a.tokenEx = stored.ExpiresAt
+	a.mu.Unlock()
+
+	a.http.SetAuth(stored.Token)
+	a.logger.Debug("Using cached token", "expires_at", stored.ExpiresAt)
+}
+
+// saveCachedToken persists the current token; failures only cost a re-login
+func (a *auth) saveCachedToken() {
+	if a.store == nil {
+		return
+	}
+
+	a.mu.RLock()
+	stored := &models.StoredToken{
+		Token:        a.token,
+		ExpiresAt:    a.tokenEx,
+		APIURL:       a.config.GetAPIURL(),
+		AuthDisabled: a.authDisabled,
+	}
+	a.mu.RUnlock()
+	if stored.AuthDisabled {
+		stored.ExpiresAt = time.Now().Add(models.TokenLifetime)
+	}
+
+	if err := a.store.Save(stored); err != nil {
+		a.logger.Warn("Failed to cache auth token", "error", err)
+	}
+}
+
 func (a *auth) setToken(token string) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
 	a.token = token
-	a.tokenEx = time.Now().Add(1 * time.Hour)
+	a.tokenEx = time.Now().Add(models.TokenLifetime)
 }
 
 // HTTPClient decorator that adds authentication
@@ -152,6 +301,21 @@ type authenticatedHTTPClient struct {
 	auth shared.Auth
 }
 
+// NewAuthenticatedHTTPService provides the shared HTTP client: the base
+// client wrapped so that the first request authenticates, instead of every
+// command logging in up front
+func NewAuthenticatedHTTPService(injector do.Injector) (shared.HTTPClient, error) {
+	base, err := do.InvokeNamed[shared.HTTPClient](injector, BaseHTTPClientName)
+	if err != nil {
+		return nil, err
+	}
+	auth, err := do.Invoke[shared.Auth](injector)
+	if err != nil {
+		return nil, err
+	}
+	return NewAuthenticatedHTTPClient(base, auth), nil
+}
+
 func NewAuthenticatedHTTPClient(base shared.HTTPClient, auth shared.Auth) shared.HTTPClient {
 	return &authenticatedHTTPClient{
 		http: base,
@@ -212,9 +376,23 @@ func (a *authenticatedHTTPClient) WithTimeout(timeout time.Duration) shared.HTTP
 	}
 }
 
+func (a *authenticatedHTTPClient) GetRetryConfig() models.RetryConfig {
+	return a.http.GetRetryConfig()
+}
+
+func (a *authenticatedHTTPClient) SetRetryConfig(config models.RetryConfig) {
+	a.http.SetRetryConfig(config)
+}
+
+// ensureAuthenticated logs in unless there is a valid token. Without a
+// password requests go out unauthenticated, which servers with auth disabled
+// accept.
 func (a *authenticatedHTTPClient) ensureAuthenticated(ctx context.Context) error {
 	if !a.auth.IsAuthenticated(ctx) {
-		return a.auth.Authenticate(ctx)
+		if err := a.auth.Authenticate(ctx); err != nil && !errors.Is(err, errNoPassword) {
+			return err
+		}
+		return nil
 	}
 
 	token, err := a.auth.GetToken(ctx)
@@ -229,6 +407,7 @@ func (a *authenticatedHTTPClient) ensureAuthenticated(ctx context.Context) error
 // Mock implementation for testing
 type mockAuth struct {
 	mu       sync.RWMutex
+	loginMu  sync.Mutex // lets one caller at a time log in
 	token    string
 	password string
 }
@@ -287,6 +466,20 @@ func (m *mockAuth) SetPassword(password string) {
 	m.token = ""
 }
 
+func (m *mockAuth) Status(ctx context.Context) (*models.AuthStatus, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return &models.AuthStatus{
+		AuthEnabled: m.password != "",
+		TokenValid:  m.token != "",
+	}, nil
+}
+
+func (m *mockAuth) TokenExpiry(ctx context.Context) time.Time {
+	return time.Time{}
+}
+
 func (m *mockAuth) SetToken(token string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
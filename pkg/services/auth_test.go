@@ -0,0 +1,91 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/config"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestAuth returns an Auth without password that talks to serverURL, and
+// the client it authenticates
+func newTestAuth(t *testing.T, serverURL string) (*auth, shared.HTTPClient) {
+	t.Helper()
+
+	client := newTestHTTPClient(t, serverURL)
+	injector := client.(*httpService).injector
+	return &auth{
+		config: do.MustInvoke[config.Service](injector),
+		logger: do.MustInvoke[shared.Logger](injector),
+		http:   client,
+	}, client
+}
+
+func TestAuthenticatedHTTPClient_UsesCachedTokenWithoutPassword(t *testing.T) {
+	var statusChecks atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/auth/status" {
+			statusChecks.Add(1)
+		}
+		assert.Equal(t, "Bearer cached", r.Header.Get("Authorization"))
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	a, base := newTestAuth(t, server.URL)
+	a.token, a.tokenEx = "cached", time.Now().Add(time.Hour)
+	require.NoError(t, a.Authenticate(context.Background()))
+
+	_, err := NewAuthenticatedHTTPClient(base, a).Get(context.Background(), "/notebooks")
+	require.NoError(t, err)
+	assert.Zero(t, statusChecks.Load())
+}
+
+func TestAuthenticatedHTTPClient_SendsUnauthenticatedWithoutPassword(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	a, base := newTestAuth(t, server.URL)
+	assert.ErrorIs(t, a.Authenticate(context.Background()), errNoPassword)
+
+	_, err := NewAuthenticatedHTTPClient(base, a).Get(context.Background(), "/notebooks")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestAuth_CachesDisabledAuth(t *testing.T) {
+	var statusChecks atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		statusChecks.Add(1)
+		w.Write([]byte(`{"auth_enabled":false}`))
+	}))
+	defer server.Close()
+
+	a, client := newTestAuth(t, server.URL)
+	store, err := NewTokenStore(client.(*httpService).injector)
+	require.NoError(t, err)
+	a.store, a.password = store, "secret"
+
+	require.NoError(t, a.Authenticate(context.Background()))
+	assert.Equal(t, int32(1), statusChecks.Load())
+
+	// A later run restores the answer and does not ask the server again
+	next := &auth{config: a.config, logger: a.logger, http: client, store: store, password: "secret"}
+	next.loadCachedToken()
+	require.NoError(t, next.Authenticate(context.Background()))
+	assert.Equal(t, int32(1), statusChecks.Load())
+	assert.False(t, next.IsAuthenticated(context.Background()))
+}
@@ -3,7 +3,6 @@ package services
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 
 	"github.com/denkhaus/open-notebook-cli/pkg/errors"
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
@@ -48,7 +47,7 @@ func (r *chatRepository) ListSessionsForNotebook(ctx context.Context, notebookID
 
 	if resp.StatusCode >= 400 {
 		return nil, errors.APIServiceError("list", "chat sessions",
-			fmt.Errorf("API error: %d - %s", resp.StatusCode, string(resp.Body)))
+			parseAPIError(resp, endpoint))
 	}
 
 	var response models.ChatSessionsResponse
@@ -72,7 +71,7 @@ func (r *chatRepository) CreateSession(ctx context.Context, req *models.ChatCrea
 
 	if resp.StatusCode >= 400 {
 		return nil, errors.APIServiceError("create", "chat session",
-			fmt.Errorf("API error: %d - %s", resp.StatusCode, string(resp.Body)))
+			parseAPIError(resp, endpoint))
 	}
 
 	var session models.ChatSession
@@ -88,7 +87,7 @@ func (r *chatRepository) CreateSession(ctx context.Context, req *models.ChatCrea
 func (r *chatRepository) GetSession(ctx context.Context, sessionID string) (*models.ChatSession, error) {
 	r.logger.Info("Getting chat session", "session_id", sessionID)
 
-	endpoint := fmt.Sprintf("/chat/sessions/%s", sessionID)
+	endpoint := BuildURL("/chat/sessions", sessionID)
 	resp, err := r.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, errors.FailedToGet("chat session", err)
@@ -96,7 +95,7 @@ func (r *chatRepository) GetSession(ctx context.Context, sessionID string) (*mod
 
 	if resp.StatusCode >= 400 {
 		return nil, errors.APIServiceError("get", "chat session",
-			fmt.Errorf("API error: %d - %s", resp.StatusCode, string(resp.Body)))
+			parseAPIError(resp, endpoint))
 	}
 
 	var session models.ChatSession
@@ -112,7 +111,7 @@ func (r *chatRepository) GetSession(ctx context.Context, sessionID string) (*mod
 func (r *chatRepository) DeleteSession(ctx context.Context, sessionID string) error {
 	r.logger.Info("Deleting chat session", "session_id", sessionID)
 
-	endpoint := fmt.Sprintf("/chat/sessions/%s", sessionID)
+	endpoint := BuildURL("/chat/sessions", sessionID)
 	resp, err := r.httpClient.Delete(ctx, endpoint)
 	if err != nil {
 		return errors.FailedToDelete("chat session", err)
@@ -120,7 +119,7 @@ func (r *chatRepository) DeleteSession(ctx context.Context, sessionID string) er
 
 	if resp.StatusCode >= 400 {
 		return errors.APIServiceError("delete", "chat session",
-			fmt.Errorf("API error: %d - %s", resp.StatusCode, string(resp.Body)))
+			parseAPIError(resp, endpoint))
 	}
 
 	r.logger.Info("Deleted chat session", "session_id", sessionID)
@@ -139,7 +138,7 @@ func (r *chatRepository) ExecuteChat(ctx context.Context, req *models.ChatExecut
 
 	if resp.StatusCode >= 400 {
 		return nil, errors.APIServiceError("execute", "chat",
-			fmt.Errorf("API error: %d - %s", resp.StatusCode, string(resp.Body)))
+			parseAPIError(resp, endpoint))
 	}
 
 	var response models.ChatExecuteResponse
@@ -187,7 +186,7 @@ func (r *chatRepository) StreamChat(ctx context.Context, req *models.ChatExecute
 func (r *chatRepository) GetMessages(ctx context.Context, sessionID string) ([]*models.ChatMessage, error) {
 	r.logger.Info("Getting chat messages", "session_id", sessionID)
 
-	endpoint := fmt.Sprintf("/chat/sessions/%s/messages", sessionID)
+	endpoint := BuildURL("/chat/sessions", sessionID, "messages")
 	resp, err := r.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, errors.FailedToGet("chat messages", err)
@@ -195,7 +194,7 @@ func (r *chatRepository) GetMessages(ctx context.Context, sessionID string) ([]*
 
 	if resp.StatusCode >= 400 {
 		return nil, errors.APIServiceError("get", "chat messages",
-			fmt.Errorf("API error: %d - %s", resp.StatusCode, string(resp.Body)))
+			parseAPIError(resp, endpoint))
 	}
 
 	var messages []*models.ChatMessage
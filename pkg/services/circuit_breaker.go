@@ -0,0 +1,201 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/config"
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+)
+
+const circuitFileName = "circuit.json"
+
+// Private circuit breaker implementation. The state lives for the process;
+// with --circuit-persist it is kept in the config dir so that consecutive
+// commands against a down server fail fast instead of each waiting through
+// the full retry sequence.
+type circuitBreaker struct {
+	path      string
+	persist   bool
+	apiURL    string
+	threshold int           // consecutive failures that open the circuit, 0 disables
+	window    time.Duration // failures further apart than this start a new count
+	cooldown  time.Duration // how long the circuit stays open
+	logger    shared.Logger
+
+	mu     sync.Mutex
+	state  *models.CircuitState
+	probed bool // a half-open trial request is in flight
+}
+
+// NewCircuitBreaker creates a circuit breaker for the configured API URL
+func NewCircuitBreaker(injector do.Injector) (shared.CircuitBreaker, error) {
+	cfg := do.MustInvoke[config.Service](injector)
+	logger := do.MustInvoke[shared.Logger](injector)
+
+	return &circuitBreaker{
+		path:      filepath.Join(cfg.GetConfigDir(), circuitFileName),
+		persist:   cfg.PersistCircuit(),
+		apiURL:    cfg.GetAPIURL(),
+		threshold: cfg.GetCircuitThreshold(),
+		window:    time.Duration(cfg.GetCircuitWindow()) * time.Second,
+		cooldown:  time.Duration(cfg.GetCircuitCooldown()) * time.Second,
+		logger:    logger,
+	}, nil
+}
+
+// Allow returns a CircuitOpenError while the circuit is open. Once the
+// cooldown has passed a single trial request is let through.
+func (c *circuitBreaker) Allow() error {
+	if c.threshold <= 0 {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state := c.load()
+	if state.OpenedAt.IsZero() {
+		return nil
+	}
+
+	retryAt := state.OpenedAt.Add(c.cooldown)
+	if time.Now().Before(retryAt) || c.probed {
+		return &errors.CircuitOpenError{Failures: state.Failures, RetryAt: retryAt}
+	}
+
+	c.probed = true
+	c.logger.Debug("Circuit half-open, sending trial request", "api_url", c.apiURL)
+	return nil
+}
+
+// RecordSuccess closes the circuit
+func (c *circuitBreaker) RecordSuccess() {
+	if c.threshold <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.probed = false
+	if state := c.load(); state.Failures == 0 && state.OpenedAt.IsZero() {
+		return
+	}
+	c.state = &models.CircuitState{APIURL: c.apiURL}
+	c.save()
+}
+
+// RecordFailure counts a failed request and opens the circuit once the
+// threshold is reached within the window
+func (c *circuitBreaker) RecordFailure() {
+	if c.threshold <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	state := c.load()
+	if state.Failures == 0 || (state.OpenedAt.IsZero() && now.Sub(state.FirstFailure) > c.window) {
+		state.Failures = 0
+		state.FirstFailure = now
+	}
+	state.Failures++
+
+	if state.Failures >= c.threshold {
+		if state.OpenedAt.IsZero() || c.probed {
+			c.logger.Debug("Circuit opened", "api_url", c.apiURL, "failures", state.Failures)
+		}
+		state.OpenedAt = now
+	}
+	c.probed = false
+	c.save()
+}
+
+// Status reports the current state for diagnostics
+func (c *circuitBreaker) Status() models.CircuitStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state := c.load()
+	status := models.CircuitStatus{
+		State:     models.CircuitClosed,
+		Failures:  state.Failures,
+		Threshold: c.threshold,
+	}
+	if !state.OpenedAt.IsZero() {
+		status.OpenUntil = state.OpenedAt.Add(c.cooldown)
+		status.State = models.CircuitOpen
+		if !time.Now().Before(status.OpenUntil) {
+			status.State = models.CircuitHalfOpen
+		}
+	}
+	return status
+}
+
+// Reset closes the circuit and forgets recorded failures
+func (c *circuitBreaker) Reset() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.state = &models.CircuitState{APIURL: c.apiURL}
+	c.probed = false
+	if err := os.Remove(c.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to reset circuit breaker: %w", err)
+	}
+	return nil
+}
+
+// load returns the current state, reading it from disk on first use when
+// persisted. State recorded for another API URL is ignored.
+func (c *circuitBreaker) load() *models.CircuitState {
+	if c.state != nil {
+		return c.state
+	}
+
+	c.state = &models.CircuitState{APIURL: c.apiURL}
+	if !c.persist {
+		return c.state
+	}
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return c.state
+	}
+
+	var stored models.CircuitState
+	if err := json.Unmarshal(data, &stored); err != nil {
+		c.logger.Debug("Ignoring unreadable circuit breaker state", "error", err)
+		return c.state
+	}
+	if stored.APIURL == c.apiURL {
+		c.state = &stored
+	}
+	return c.state
+}
+
+// save persists the state; failures only cost the fast-fail across commands
+func (c *circuitBreaker) save() {
+	if !c.persist {
+		return
+	}
+	data, err := json.Marshal(c.state)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		c.logger.Debug("Failed to create config directory for circuit state", "error", err)
+		return
+	}
+	if err := os.WriteFile(c.path, data, 0600); err != nil {
+		c.logger.Debug("Failed to store circuit breaker state", "error", err)
+	}
+}
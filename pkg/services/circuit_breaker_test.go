@@ -0,0 +1,132 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestCircuitBreaker(t *testing.T, path string, cooldown time.Duration) *circuitBreaker {
+	t.Helper()
+	return &circuitBreaker{
+		path:      path,
+		persist:   true,
+		apiURL:    "http://localhost:5055",
+		threshold: 3,
+		window:    time.Minute,
+		cooldown:  cooldown,
+		logger:    &logger{zap: zap.NewNop()},
+	}
+}
+
+func TestCircuitBreaker_OpensAfterThreshold(t *testing.T) {
+	breaker := newTestCircuitBreaker(t, filepath.Join(t.TempDir(), circuitFileName), time.Minute)
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, breaker.Allow())
+		breaker.RecordFailure()
+	}
+	assert.Equal(t, models.CircuitClosed, breaker.Status().State)
+
+	breaker.RecordFailure()
+	var circuitErr *errors.CircuitOpenError
+	require.ErrorAs(t, breaker.Allow(), &circuitErr)
+	assert.Equal(t, 3, circuitErr.Failures)
+	assert.Equal(t, models.CircuitOpen, breaker.Status().State)
+}
+
+func TestCircuitBreaker_SuccessResetsCount(t *testing.T) {
+	breaker := newTestCircuitBreaker(t, filepath.Join(t.TempDir(), circuitFileName), time.Minute)
+
+	breaker.RecordFailure()
+	breaker.RecordFailure()
+	breaker.RecordSuccess()
+	breaker.RecordFailure()
+
+	assert.NoError(t, breaker.Allow())
+	assert.Equal(t, 1, breaker.Status().Failures)
+}
+
+func TestCircuitBreaker_HalfOpenAllowsOneTrial(t *testing.T) {
+	breaker := newTestCircuitBreaker(t, filepath.Join(t.TempDir(), circuitFileName), 0)
+	for i := 0; i < 3; i++ {
+		breaker.RecordFailure()
+	}
+
+	assert.Equal(t, models.CircuitHalfOpen, breaker.Status().State)
+	require.NoError(t, breaker.Allow())
+	assert.Error(t, breaker.Allow(), "only one trial request while half-open")
+
+	breaker.RecordSuccess()
+	assert.NoError(t, breaker.Allow())
+	assert.Equal(t, models.CircuitClosed, breaker.Status().State)
+}
+
+func TestCircuitBreaker_StatePersistsAcrossCommands(t *testing.T) {
+	path := filepath.Join(t.TempDir(), circuitFileName)
+	first := newTestCircuitBreaker(t, path, time.Minute)
+	for i := 0; i < 3; i++ {
+		first.RecordFailure()
+	}
+
+	second := newTestCircuitBreaker(t, path, time.Minute)
+	assert.Error(t, second.Allow())
+
+	require.NoError(t, second.Reset())
+	third := newTestCircuitBreaker(t, path, time.Minute)
+	assert.NoError(t, third.Allow())
+}
+
+func TestCircuitBreaker_StateIsPerProcessByDefault(t *testing.T) {
+	path := filepath.Join(t.TempDir(), circuitFileName)
+	first := newTestCircuitBreaker(t, path, time.Minute)
+	first.persist = false
+	for i := 0; i < 3; i++ {
+		first.RecordFailure()
+	}
+	assert.Error(t, first.Allow())
+	assert.NoFileExists(t, path)
+
+	second := newTestCircuitBreaker(t, path, time.Minute)
+	assert.NoError(t, second.Allow())
+}
+
+func TestRetryableHTTPClient_BreakerCountsRequestsNotAttempts(t *testing.T) {
+	tests := map[string]struct {
+		status   int
+		failures int
+	}{
+		"503 counts once":        {http.StatusServiceUnavailable, 1},
+		"500 is not an outage":   {http.StatusInternalServerError, 0},
+		"404 shows server is up": {http.StatusNotFound, 0},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			breaker := newTestCircuitBreaker(t, filepath.Join(t.TempDir(), circuitFileName), time.Minute)
+			client := &retryableHTTPService{
+				httpService: newTestHTTPClient(t, server.URL).(*httpService),
+				retryConfig: DefaultRetryConfig(),
+				classifier:  NewNetworkErrorClassifier(breaker.logger),
+				breaker:     breaker,
+			}
+			client.retryConfig.BaseDelay = time.Millisecond
+
+			_, _ = client.Get(context.Background(), "/notebooks")
+			assert.Equal(t, tt.failures, breaker.Status().Failures)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+)
+
+type contextRepository struct {
+	httpClient shared.HTTPClient
+	logger     shared.Logger
+}
+
+// NewContextRepository creates a new context repository
+func NewContextRepository(injector do.Injector) (shared.ContextRepository, error) {
+	httpClient := do.MustInvoke[shared.HTTPClient](injector)
+	logger := do.MustInvoke[shared.Logger](injector)
+
+	return &contextRepository{
+		httpClient: httpClient,
+		logger:     logger,
+	}, nil
+}
+
+// Get implements ContextRepository interface
+func (c *contextRepository) Get(ctx context.Context, req *models.ContextRequest) (*models.ContextResponse, error) {
+	if req == nil || req.NotebookID == nil || *req.NotebookID == "" {
+		return nil, fmt.Errorf("notebook ID is required")
+	}
+
+	endpoint := BuildURL("/notebooks", *req.NotebookID, "context")
+	resp, err := c.httpClient.Post(ctx, endpoint, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get context: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
+	var result models.ContextResponse
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse context response: %w", err)
+	}
+
+	c.logger.Info("Retrieved context", "notebook_id", *req.NotebookID,
+		"sources", len(result.Sources), "notes", len(result.Notes))
+	return &result, nil
+}
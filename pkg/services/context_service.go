@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+)
+
+type contextService struct {
+	repo shared.ContextRepository
+}
+
+// NewContextService creates a new context service
+func NewContextService(injector do.Injector) (shared.ContextService, error) {
+	repo := do.MustInvoke[shared.ContextRepository](injector)
+
+	return &contextService{
+		repo: repo,
+	}, nil
+}
+
+// Interface implementation
+
+func (s *contextService) Repository() shared.ContextRepository {
+	return s.repo
+}
+
+func (s *contextService) GetContext(ctx context.Context, notebookID string, config *models.ContextConfig) (*models.ContextResponse, error) {
+	if notebookID == "" {
+		return nil, fmt.Errorf("notebook ID is required")
+	}
+
+	return s.repo.Get(ctx, &models.ContextRequest{
+		NotebookID:    &notebookID,
+		ContextConfig: config,
+	})
+}
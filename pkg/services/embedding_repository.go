@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+)
+
+type embeddingRepository struct {
+	httpClient shared.HTTPClient
+	logger     shared.Logger
+}
+
+// NewEmbeddingRepository creates a new embedding repository
+func NewEmbeddingRepository(injector do.Injector) (shared.EmbeddingRepository, error) {
+	httpClient := do.MustInvoke[shared.HTTPClient](injector)
+	logger := do.MustInvoke[shared.Logger](injector)
+
+	return &embeddingRepository{
+		httpClient: httpClient,
+		logger:     logger,
+	}, nil
+}
+
+// Embed implements EmbeddingRepository interface
+func (e *embeddingRepository) Embed(ctx context.Context, req *models.EmbedRequest) (*models.EmbedResponse, error) {
+	e.logger.Info("Embedding item", "item_id", req.ItemID, "item_type", string(req.ItemType), "async", req.AsyncProcessing)
+
+	resp, err := e.httpClient.Post(ctx, "/embed", req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed item: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/embed")
+	}
+
+	var result models.EmbedResponse
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse embed response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// Rebuild implements EmbeddingRepository interface
+func (e *embeddingRepository) Rebuild(ctx context.Context, req *models.RebuildRequest) (*models.RebuildResponse, error) {
+	resp, err := e.httpClient.Post(ctx, "/embeddings/rebuild", req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start embedding rebuild: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/embeddings/rebuild")
+	}
+
+	var result models.RebuildResponse
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse rebuild response: %w", err)
+	}
+
+	e.logger.Info("Started embedding rebuild", "command_id", result.CommandID, "total_items", result.TotalItems)
+	return &result, nil
+}
+
+// GetRebuildStatus implements EmbeddingRepository interface
+func (e *embeddingRepository) GetRebuildStatus(ctx context.Context, commandID string) (*models.RebuildStatusResponse, error) {
+	endpoint := BuildURL("/embeddings/rebuild", commandID, "status")
+	resp, err := e.httpClient.Get(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rebuild status: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
+	var result models.RebuildStatusResponse
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse rebuild status response: %w", err)
+	}
+
+	return &result, nil
+}
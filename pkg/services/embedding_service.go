@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+)
+
+type embeddingService struct {
+	repo shared.EmbeddingRepository
+}
+
+// NewEmbeddingService creates a new embedding service
+func NewEmbeddingService(injector do.Injector) (shared.EmbeddingService, error) {
+	repo := do.MustInvoke[shared.EmbeddingRepository](injector)
+
+	return &embeddingService{
+		repo: repo,
+	}, nil
+}
+
+// Interface implementation
+
+func (s *embeddingService) Repository() shared.EmbeddingRepository {
+	return s.repo
+}
+
+func (s *embeddingService) EmbedItem(ctx context.Context, itemID, itemType string, async bool) (*models.EmbedResponse, error) {
+	if itemID == "" {
+		return nil, fmt.Errorf("item ID is required")
+	}
+
+	switch models.ItemType(itemType) {
+	case models.ItemTypeSource, models.ItemTypeNote:
+	default:
+		return nil, fmt.Errorf("invalid item type '%s' (expected source or note)", itemType)
+	}
+
+	return s.repo.Embed(ctx, &models.EmbedRequest{
+		ItemID:          itemID,
+		ItemType:        models.ItemType(itemType),
+		AsyncProcessing: async,
+	})
+}
+
+func (s *embeddingService) RebuildEmbeddings(ctx context.Context, mode string, includeSources, includeNotes, includeInsights bool) (*models.RebuildResponse, error) {
+	switch models.RebuildMode(mode) {
+	case models.RebuildModeExisting, models.RebuildModeAll:
+	default:
+		return nil, fmt.Errorf("invalid rebuild mode '%s' (expected existing or all)", mode)
+	}
+
+	if !includeSources && !includeNotes && !includeInsights {
+		return nil, fmt.Errorf("at least one of sources, notes or insights must be included")
+	}
+
+	return s.repo.Rebuild(ctx, &models.RebuildRequest{
+		Mode:            models.RebuildMode(mode),
+		IncludeSources:  includeSources,
+		IncludeNotes:    includeNotes,
+		IncludeInsights: includeInsights,
+	})
+}
+
+func (s *embeddingService) GetRebuildStatus(ctx context.Context, commandID string) (*models.RebuildStatusResponse, error) {
+	if commandID == "" {
+		return nil, fmt.Errorf("command ID is required")
+	}
+
+	return s.repo.GetRebuildStatus(ctx, commandID)
+}
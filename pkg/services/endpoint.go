@@ -0,0 +1,20 @@
+package services
+
+import (
+	"net/url"
+	"strings"
+)
+
+// BuildURL joins an endpoint base such as "/sources" with path segments,
+// escaping each segment so IDs containing slashes, spaces or other reserved
+// characters stay a single path element. The result is an endpoint for the
+// HTTP client, which adds the API URL and the configured --api-prefix.
+func BuildURL(base string, segments ...string) string {
+	var b strings.Builder
+	b.WriteString(strings.TrimSuffix(base, "/"))
+	for _, segment := range segments {
+		b.WriteByte('/')
+		b.WriteString(url.PathEscape(segment))
+	}
+	return b.String()
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     string
+		segments []string
+		want     string
+	}{
+		{"base only", "/sources", nil, "/sources"},
+		{"plain id", "/sources", []string{"source:abc"}, "/sources/source:abc"},
+		{"id with slash", "/sources", []string{"a/b", "status"}, "/sources/a%2Fb/status"},
+		{"id with space", "/notebooks", []string{"my notebook"}, "/notebooks/my%20notebook"},
+		{"trailing slash base", "/notes/", []string{"n1"}, "/notes/n1"},
+		{"query characters", "/models", []string{"a?b#c"}, "/models/a%3Fb%23c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, BuildURL(tt.base, tt.segments...))
+		})
+	}
+}
+
+func TestBuildURL_SlashInIDReachesServerAsOneSegment(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.EscapedPath()
+	}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/api" + BuildURL("/sources", "team/report.pdf", "status"))
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "/api/sources/team%2Freport.pdf/status", gotPath)
+}
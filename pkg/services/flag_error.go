@@ -0,0 +1,13 @@
+package services
+
+// FlagError is a global flag whose value the HTTP client could not be set up
+// with. Flag names the flag, e.g. "--proxy", so that callers can point the
+// user at it.
+type FlagError struct {
+	Flag string
+	Err  error
+}
+
+func (e *FlagError) Error() string { return e.Err.Error() }
+
+func (e *FlagError) Unwrap() error { return e.Err }
@@ -3,18 +3,23 @@ package services
 import (
 	"bufio"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
+	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/denkhaus/open-notebook-cli/pkg/config"
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
 	"github.com/samber/do/v2"
 )
 
@@ -23,30 +28,90 @@ type httpService struct {
 	config     config.Service
 	logger     shared.Logger
 	httpClient *http.Client
-	authToken  string
+	auth       *authToken // shared with the clients derived by WithTimeout
+	metrics    shared.Metrics
+	gzipOK     *atomic.Bool         // server accepts gzip request bodies
+	cache      shared.ResponseCache // nil unless --cache is set
+	inflight   *requestGroup        // shares concurrent identical GETs
+	refreshes  *requestGroup        // collapses concurrent token refreshes into one
+	limiter    *rateLimiter         // nil unless --rate-limit is set
+	injector   do.Injector          // used to resolve Auth lazily for token refresh
 }
 
+// authToken is the bearer token, read by parallel requests while a 401
+// refresh or SetAuth replaces it
+type authToken struct {
+	mu    sync.RWMutex
+	token string
+}
+
+func (t *authToken) get() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.token
+}
+
+func (t *authToken) set(token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.token = token
+}
+
+// authRetryKey marks a context whose 401 responses must not trigger a refresh
+type authRetryKey struct{}
+
+// compressMinBytes is the smallest request body gzipped under --compress
+const compressMinBytes = 8 << 10
+
+// idempotencyKey carries the Idempotency-Key of one logical operation so
+// that every retry of it sends the same key
+type idempotencyKey struct{}
+
 // NewHTTPClient creates a new HTTP client service
 func NewHTTPClient(injector do.Injector) (shared.HTTPClient, error) {
 	cfg := do.MustInvoke[config.Service](injector)
 	logger := do.MustInvoke[shared.Logger](injector)
+	metrics := do.MustInvoke[shared.Metrics](injector)
+
+	var cache shared.ResponseCache
+	if cfg.UseResponseCache() {
+		cache = do.MustInvoke[shared.ResponseCache](injector)
+	}
 
 	// Create HTTP client with configuration
 	httpClient := &http.Client{
 		Timeout: time.Duration(cfg.GetTimeout()) * time.Second,
 	}
 
+	logger.Debug("Using request ID", "request_id", cfg.GetRequestID())
+
 	return &httpService{
 		config:     cfg,
 		logger:     logger,
 		httpClient: httpClient,
+		metrics:    metrics,
+		gzipOK:     &atomic.Bool{},
+		cache:      cache,
+		auth:       &authToken{},
+		inflight:   newRequestGroup(),
+		refreshes:  newRequestGroup(),
+		limiter:    newRateLimiter(cfg.GetRateLimit()),
+		injector:   injector,
 	}, nil
 }
 
 // Interface implementation
 
+// Get performs a GET request. Concurrent GETs of the same endpoint, as
+// issued by parallel aggregation commands, share one request and response.
 func (h *httpService) Get(ctx context.Context, endpoint string) (*models.Response, error) {
-	return h.request(ctx, "GET", endpoint, nil)
+	resp, err, shared := h.inflight.Do(endpoint, func() (*models.Response, error) {
+		return h.request(ctx, "GET", endpoint, nil)
+	})
+	if shared {
+		h.logger.Debug("Shared in-flight GET response", "endpoint", endpoint)
+	}
+	return resp, err
 }
 
 func (h *httpService) Post(ctx context.Context, endpoint string, body interface{}) (*models.Response, error) {
@@ -86,16 +151,48 @@ func (h *httpService) Stream(ctx context.Context, endpoint string, body interfac
 			return
 		}
 
+		sent := h.auth.get()
 		h.setHeaders(req, false)
 
+		if err := h.limiter.Wait(ctx); err != nil {
+			ch <- []byte(fmt.Sprintf(`{"error": "Request failed: %s"}`, err.Error()))
+			return
+		}
+
+		start := time.Now()
 		resp, err := h.httpClient.Do(req)
+		if err == nil && resp.StatusCode == http.StatusUnauthorized && h.canRefreshAuth(ctx) {
+			resp.Body.Close()
+			if err := h.refreshAuth(ctx, sent); err != nil {
+				h.logger.Error("Streaming request authentication failed", "error", err)
+				ch <- []byte(fmt.Sprintf(`{"error": "%s"}`, err.Error()))
+				return
+			}
+			reqBody, _ = h.marshalBody(body)
+			req, err = http.NewRequestWithContext(ctx, "POST", h.buildURL(endpoint), reqBody)
+			if err == nil {
+				h.setHeaders(req, false)
+				resp, err = h.httpClient.Do(req)
+			}
+		}
 		if err != nil {
+			h.recordRequest(start, req, 0)
 			h.logger.Error("Streaming request failed", "error", err)
 			ch <- []byte(fmt.Sprintf(`{"error": "Request failed: %s"}`, err.Error()))
 			return
 		}
 		defer resp.Body.Close()
 
+		received := &countingReader{r: resp.Body}
+		defer func() { h.recordRequest(start, req, received.n) }()
+
+		events, err := decodeContent(resp.Header, received)
+		if err != nil {
+			h.logger.Error("Failed to decode streaming response", "error", err)
+			ch <- []byte(fmt.Sprintf(`{"error": "Decode error: %s"}`, err.Error()))
+			return
+		}
+
 		if resp.StatusCode != http.StatusOK {
 			h.logger.Error("Streaming request returned error status", "status", resp.StatusCode)
 			ch <- []byte(fmt.Sprintf(`{"error": "HTTP %d"}`, resp.StatusCode))
@@ -103,7 +200,7 @@ func (h *httpService) Stream(ctx context.Context, endpoint string, body interfac
 		}
 
 		// Handle Server-Sent Events
-		scanner := newSSEScanner(resp.Body)
+		scanner := newSSEScanner(events)
 		for scanner.Scan() {
 			data := scanner.Bytes()
 			if len(data) > 0 {
@@ -121,7 +218,7 @@ func (h *httpService) Stream(ctx context.Context, endpoint string, body interfac
 }
 
 func (h *httpService) SetAuth(token string) {
-	h.authToken = token
+	h.auth.set(token)
 }
 
 func (h *httpService) WithTimeout(timeout time.Duration) shared.HTTPClient {
@@ -134,9 +231,36 @@ func (h *httpService) WithTimeout(timeout time.Duration) shared.HTTPClient {
 	return &newHTTPService
 }
 
+// GetRetryConfig reports that the plain client does not retry; requests are
+// retried by the client of NewRetryableHTTPClient
+func (h *httpService) GetRetryConfig() RetryConfig {
+	return RetryConfig{}
+}
+
+// SetRetryConfig is ignored by the plain client, which does not retry
+func (h *httpService) SetRetryConfig(config RetryConfig) {
+	h.logger.Debug("Retry configuration ignored by HTTP client without retries")
+}
+
 // Private helper methods
 
+// request performs the request and, on a 401, refreshes the token and
+// retries exactly once.
 func (h *httpService) request(ctx context.Context, method, endpoint string, body interface{}) (*models.Response, error) {
+	ctx = h.withIdempotencyKey(ctx, method)
+	sent := h.auth.get()
+	resp, err := h.doRequest(ctx, method, endpoint, body)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !h.canRefreshAuth(ctx) {
+		return resp, err
+	}
+
+	if err := h.refreshAuth(ctx, sent); err != nil {
+		return nil, err
+	}
+	return h.doRequest(ctx, method, endpoint, body)
+}
+
+func (h *httpService) doRequest(ctx context.Context, method, endpoint string, body interface{}) (*models.Response, error) {
 	var reqBody io.Reader
 	var err error
 
@@ -153,18 +277,40 @@ func (h *httpService) request(ctx context.Context, method, endpoint string, body
 	}
 
 	h.setHeaders(req, false)
+	if err := h.compressBody(req); err != nil {
+		return nil, fmt.Errorf("failed to compress body: %w", err)
+	}
+	cached := h.lookupCache(req)
 
+	if err := h.limiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	start := time.Now()
 	resp, err := h.httpClient.Do(req)
 	if err != nil {
+		h.recordRequest(start, req, 0)
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
+	wire := &countingReader{r: resp.Body}
+	respBody, err := h.readBody(resp, wire)
+	h.recordRequest(start, req, wire.n)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		h.logger.Debug("Using cached response", "endpoint", endpoint, "etag", cached.ETag)
+		return &models.Response{
+			StatusCode: http.StatusOK,
+			Body:       cached.Body,
+			Header:     cached.Header,
+		}, nil
+	}
+	h.storeCache(req, resp, respBody)
+
 	// Create response
 	response := &models.Response{
 		StatusCode: resp.StatusCode,
@@ -178,17 +324,42 @@ func (h *httpService) request(ctx context.Context, method, endpoint string, body
 		"endpoint", endpoint,
 		"status", resp.StatusCode,
 		"body_size", len(respBody),
+		"request_id", h.config.GetRequestID(),
 	)
 
 	return response, nil
 }
 
+// requestMultipart performs the upload and retries once after a token refresh
+// on a 401, provided every file can be rewound.
 func (h *httpService) requestMultipart(ctx context.Context, method, endpoint string, fields map[string]string, files map[string]io.Reader) (*models.Response, error) {
-	reqBody, contentType, err := h.createMultipartBody(fields, files)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create multipart body: %w", err)
+	ctx = h.withIdempotencyKey(ctx, method)
+	sent := h.auth.get()
+	resp, err := h.doRequestMultipart(ctx, method, endpoint, fields, files)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !h.canRefreshAuth(ctx) {
+		return resp, err
+	}
+
+	for _, file := range files {
+		seeker, ok := file.(io.Seeker)
+		if !ok {
+			return resp, nil
+		}
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return resp, nil
+		}
 	}
 
+	if err := h.refreshAuth(ctx, sent); err != nil {
+		return nil, err
+	}
+	return h.doRequestMultipart(ctx, method, endpoint, fields, files)
+}
+
+func (h *httpService) doRequestMultipart(ctx context.Context, method, endpoint string, fields map[string]string, files map[string]io.Reader) (*models.Response, error) {
+	reqBody, contentType := h.createMultipartBody(fields, files)
+	defer reqBody.Close()
+
 	req, err := http.NewRequestWithContext(ctx, method, h.buildURL(endpoint), reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
@@ -197,13 +368,24 @@ func (h *httpService) requestMultipart(ctx context.Context, method, endpoint str
 	h.setHeaders(req, true)
 	req.Header.Set("Content-Type", contentType)
 
+	counter := &countingReader{r: reqBody}
+	req.Body = counter
+
+	if err := h.limiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	start := time.Now()
 	resp, err := h.httpClient.Do(req)
 	if err != nil {
+		h.recordBytes(start, counter.n, 0)
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
+	wire := &countingReader{r: resp.Body}
+	respBody, err := h.readBody(resp, wire)
+	h.recordBytes(start, counter.n, wire.n)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
@@ -218,35 +400,241 @@ func (h *httpService) requestMultipart(ctx context.Context, method, endpoint str
 	return response, nil
 }
 
-func (h *httpService) buildURL(endpoint string) string {
-	baseURL := h.config.GetAPIURL()
-	if !strings.HasSuffix(baseURL, "/") {
-		baseURL += "/"
+// recordRequest adds a finished request to the run metrics. The request
+// size is taken from its Content-Length.
+func (h *httpService) recordRequest(start time.Time, req *http.Request, received int64) {
+	var sent int64
+	if req.ContentLength > 0 {
+		sent = req.ContentLength
 	}
+	h.recordBytes(start, sent, received)
+}
+
+// recordBytes adds a finished request with explicit byte counts to the run metrics
+func (h *httpService) recordBytes(start time.Time, sent, received int64) {
+	if h.metrics != nil {
+		h.metrics.RecordRequest(time.Since(start), sent, received)
+	}
+}
+
+// countingReader counts the bytes read from a request body whose length is
+// not known up front
+type countingReader struct {
+	r io.ReadCloser
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (c *countingReader) Close() error {
+	return c.r.Close()
+}
+
+// canRefreshAuth reports whether a 401 on this request may trigger a refresh.
+// Requests issued during a refresh are excluded to avoid loops.
+func (h *httpService) canRefreshAuth(ctx context.Context) bool {
+	return h.injector != nil && ctx.Value(authRetryKey{}) == nil
+}
+
+// refreshAuth re-authenticates via the Auth service after a request sent
+// with token sent got a 401. Parallel requests failing together share one
+// refresh, and a request that raced with a finished refresh just retries
+// with the new token.
+func (h *httpService) refreshAuth(ctx context.Context, sent string) error {
+	if h.auth.get() != sent {
+		return nil
+	}
+
+	_, err, _ := h.refreshes.Do("refresh", func() (*models.Response, error) {
+		auth, err := do.Invoke[shared.Auth](h.injector)
+		if err != nil {
+			return nil, fmt.Errorf("authentication failed: %w", err)
+		}
+
+		h.logger.Debug("Received 401, refreshing auth token")
+		// The login request is a separate operation and must not reuse the
+		// idempotency key of the request that triggered the refresh
+		refreshCtx := context.WithValue(context.WithValue(ctx, authRetryKey{}, true), idempotencyKey{}, "")
+		if err := auth.RefreshToken(refreshCtx); err != nil {
+			return nil, fmt.Errorf("authentication failed (401 unauthorized): %w", err)
+		}
+		return nil, nil
+	})
+	return err
+}
+
+// withIdempotencyKey attaches a new idempotency key to ctx for POST and PUT
+// requests when --idempotent is set. A key already present is kept, so
+// retries of the same operation reuse it.
+func (h *httpService) withIdempotencyKey(ctx context.Context, method string) context.Context {
+	if !h.config.UseIdempotencyKeys() || (method != http.MethodPost && method != http.MethodPut) {
+		return ctx
+	}
+	if _, ok := ctx.Value(idempotencyKey{}).(string); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, idempotencyKey{}, utils.NewUUID())
+}
+
+// buildURL joins the API URL, the configured API prefix and endpoint. All
+// requests go through here, so repositories only pass prefix-less endpoints.
+func (h *httpService) buildURL(endpoint string) string {
+	baseURL := strings.TrimSuffix(h.config.GetAPIURL(), "/")
 	if !strings.HasPrefix(endpoint, "/") {
 		endpoint = "/" + endpoint
 	}
-	return baseURL + "api" + endpoint
+	return baseURL + h.config.GetAPIPrefix() + endpoint
 }
 
 func (h *httpService) setHeaders(req *http.Request, isMultipart bool) {
 	// Set User-Agent
 	req.Header.Set("User-Agent", "open-notebook-cli/1.0.0")
 
+	// Tag every request of this invocation for correlation with server logs
+	if id := h.config.GetRequestID(); id != "" {
+		req.Header.Set("X-Request-ID", id)
+	}
+
 	// Set Authorization header if token is available
-	if h.authToken != "" {
-		req.Header.Set("Authorization", "Bearer "+h.authToken)
+	if token := h.auth.get(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	if key, _ := req.Context().Value(idempotencyKey{}).(string); key != "" {
+		req.Header.Set("Idempotency-Key", key)
 	}
 
 	// Set common headers
 	req.Header.Set("Accept", "application/json")
-	
+	req.Header.Set("Accept-Encoding", "gzip")
+
 	// Set Content-Type for non-multipart requests
 	if !isMultipart && req.Method != "GET" && req.Method != "DELETE" {
 		req.Header.Set("Content-Type", "application/json")
 	}
 }
 
+// lookupCache returns the cached response for a GET request under --cache
+// and asks the server to revalidate it via If-None-Match
+func (h *httpService) lookupCache(req *http.Request) *models.CachedResponse {
+	if h.cache == nil || req.Method != http.MethodGet {
+		return nil
+	}
+
+	entry, err := h.cache.Get(h.cacheKey(req))
+	if err != nil {
+		h.logger.Debug("Ignoring unreadable cache entry", "error", err)
+		return nil
+	}
+	if entry == nil || entry.ETag == "" {
+		return nil
+	}
+
+	req.Header.Set("If-None-Match", entry.ETag)
+	return entry
+}
+
+// storeCache keeps a successful GET response that carries an ETag. Failures
+// only cost a full download next time.
+func (h *httpService) storeCache(req *http.Request, resp *http.Response, body []byte) {
+	etag := resp.Header.Get("ETag")
+	if h.cache == nil || req.Method != http.MethodGet || resp.StatusCode != http.StatusOK || etag == "" {
+		return
+	}
+
+	entry := &models.CachedResponse{
+		ETag:     etag,
+		Header:   resp.Header,
+		Body:     body,
+		StoredAt: time.Now(),
+	}
+	if err := h.cache.Put(h.cacheKey(req), entry); err != nil {
+		h.logger.Debug("Failed to cache response", "error", err)
+	}
+}
+
+// cacheKey identifies a cached response by URL and credentials, so
+// responses are never shared between tokens
+func (h *httpService) cacheKey(req *http.Request) string {
+	return req.URL.String() + "\n" + h.auth.get()
+}
+
+// compressBody gzips a large JSON request body when --compress is set and a
+// previous response advertised gzip support (Accept-Encoding, RFC 7694)
+func (h *httpService) compressBody(req *http.Request) error {
+	if !h.config.UseCompression() || !h.gzipOK.Load() || req.ContentLength < compressMinBytes {
+		return nil
+	}
+
+	plain, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	defer plain.Close()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := io.Copy(zw, plain); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	compressed := buf.Bytes()
+	req.Body = io.NopCloser(bytes.NewReader(compressed))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(compressed)), nil
+	}
+	req.ContentLength = int64(len(compressed))
+	req.Header.Set("Content-Encoding", "gzip")
+
+	h.logger.Debug("Compressed request body", "size", len(compressed))
+	return nil
+}
+
+// readBody reads a complete response body from wire, decompressing it if
+// needed, and notes whether the server accepts gzip request bodies
+func (h *httpService) readBody(resp *http.Response, wire io.Reader) ([]byte, error) {
+	for _, value := range resp.Header.Values("Accept-Encoding") {
+		if strings.Contains(strings.ToLower(value), "gzip") {
+			h.gzipOK.Store(true)
+		}
+	}
+
+	body, err := decodeContent(resp.Header, wire)
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Header.Get("Content-Encoding") != "" {
+		// Body is now decoded; drop headers that described the wire format
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+	}
+	return data, nil
+}
+
+// decodeContent wraps r to undo the response Content-Encoding
+func decodeContent(header http.Header, r io.Reader) (io.Reader, error) {
+	switch strings.ToLower(header.Get("Content-Encoding")) {
+	case "", "identity":
+		return r, nil
+	case "gzip", "x-gzip":
+		return gzip.NewReader(r)
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %q", header.Get("Content-Encoding"))
+	}
+}
+
 func (h *httpService) marshalBody(body interface{}) (io.Reader, error) {
 	if body == nil {
 		return nil, nil
@@ -260,34 +648,60 @@ func (h *httpService) marshalBody(body interface{}) (io.Reader, error) {
 	return bytes.NewBuffer(jsonBody), nil
 }
 
-func (h *httpService) createMultipartBody(fields map[string]string, files map[string]io.Reader) (io.Reader, string, error) {
-	var buf bytes.Buffer
-	writer := multipart.NewWriter(&buf)
+// createMultipartBody streams the multipart body through a pipe so that
+// large files are never buffered in memory. Readers implementing
+// Name() string (e.g. *os.File) are sent with their base file name.
+func (h *httpService) createMultipartBody(fields map[string]string, files map[string]io.Reader) (io.ReadCloser, string) {
+	pipeReader, pipeWriter := io.Pipe()
+	writer := multipart.NewWriter(pipeWriter)
+	body := &multipartBody{PipeReader: pipeReader, done: make(chan struct{})}
 
-	// Add form fields
-	for key, value := range fields {
-		if err := writer.WriteField(key, value); err != nil {
-			return nil, "", err
-		}
-	}
+	go func() {
+		defer close(body.done)
 
-	// Add files
-	for fieldName, fileReader := range files {
-		part, err := writer.CreateFormFile(fieldName, "upload")
-		if err != nil {
-			return nil, "", err
+		// Add form fields
+		for key, value := range fields {
+			if err := writer.WriteField(key, value); err != nil {
+				pipeWriter.CloseWithError(err)
+				return
+			}
 		}
-		if _, err := io.Copy(part, fileReader); err != nil {
-			return nil, "", err
+
+		// Add files
+		for fieldName, fileReader := range files {
+			fileName := "upload"
+			if named, ok := fileReader.(interface{ Name() string }); ok {
+				fileName = filepath.Base(named.Name())
+			}
+
+			part, err := writer.CreateFormFile(fieldName, fileName)
+			if err != nil {
+				pipeWriter.CloseWithError(err)
+				return
+			}
+			if _, err := io.Copy(part, fileReader); err != nil {
+				pipeWriter.CloseWithError(err)
+				return
+			}
 		}
-	}
 
-	if err := writer.Close(); err != nil {
-		return nil, "", err
-	}
+		pipeWriter.CloseWithError(writer.Close())
+	}()
+
+	return body, writer.FormDataContentType()
+}
+
+// multipartBody is a streamed multipart request body. Close waits until the
+// files are no longer read, so they can be rewound for a retry.
+type multipartBody struct {
+	*io.PipeReader
+	done chan struct{}
+}
 
-	contentType := writer.FormDataContentType()
-	return &buf, contentType, nil
+func (b *multipartBody) Close() error {
+	err := b.PipeReader.Close()
+	<-b.done
+	return err
 }
 
 // SSE Scanner for Server-Sent Events
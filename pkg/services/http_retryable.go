@@ -3,12 +3,14 @@ package services
 import (
 	"context"
 	"crypto/tls"
+	stderrors "errors"
 	"io"
 	"net"
 	"net/http"
 	"time"
 
 	"github.com/denkhaus/open-notebook-cli/pkg/config"
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
 	"github.com/samber/do/v2"
@@ -20,6 +22,7 @@ type retryableHTTPService struct {
 	retryConfig  RetryConfig
 	classifier   *NetworkErrorClassifier
 	diagnostics  *NetworkDiagnostics
+	breaker      shared.CircuitBreaker
 }
 
 // NewRetryableHTTPClient creates an enhanced HTTP client with retry logic
@@ -39,30 +42,48 @@ func NewRetryableHTTPClient(injector do.Injector) (shared.HTTPClient, error) {
 		httpConfig.Timeout = time.Duration(timeout) * time.Second
 	}
 
+	httpConfig.RetryConfig.RetryableStatus, err = NewRetryableStatus(cfg, httpConfig.RetryConfig.RetryableStatus)
+	if err != nil {
+		return nil, &FlagError{Flag: "--retry-status", Err: err}
+	}
+
+	tlsConfig, err := NewTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	proxy, err := NewProxyFunc(cfg)
+	if err != nil {
+		return nil, &FlagError{Flag: "--proxy", Err: err}
+	}
+
 	// Create enhanced service
 	enhanced := &retryableHTTPService{
 		httpService: baseService.(*httpService),
 		retryConfig: httpConfig.RetryConfig,
 		classifier:  NewNetworkErrorClassifier(logger),
 		diagnostics: NewNetworkDiagnostics(logger),
+		breaker:     do.MustInvoke[shared.CircuitBreaker](injector),
 	}
 
 	// Configure the underlying HTTP client with connection pooling
-	enhanced.configureHTTPClient(httpConfig.ConnectionPoolConfig)
+	enhanced.configureHTTPClient(httpConfig.ConnectionPoolConfig, tlsConfig, proxy)
 
 	logger.Debug("Enhanced HTTP client initialized",
 		"max_retries", enhanced.retryConfig.MaxRetries,
 		"base_delay", enhanced.retryConfig.BaseDelay,
+		"retryable_status", enhanced.retryConfig.RetryableStatus,
 		"timeout", httpConfig.Timeout,
 	)
 
 	return enhanced, nil
 }
 
-// configureHTTPClient configures the HTTP client with connection pooling settings
-func (e *retryableHTTPService) configureHTTPClient(config ConnectionPoolConfig) {
+// configureHTTPClient configures the HTTP client with connection pooling,
+// TLS and proxy settings
+func (e *retryableHTTPService) configureHTTPClient(config ConnectionPoolConfig, tlsConfig *tls.Config, proxy ProxyFunc) {
 	// Create custom transport with connection pooling
 	transport := &http.Transport{
+		Proxy:               proxy,
 		MaxIdleConns:        config.MaxIdleConns,
 		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost,
 		MaxConnsPerHost:     config.MaxConnsPerHost,
@@ -74,10 +95,7 @@ func (e *retryableHTTPService) configureHTTPClient(config ConnectionPoolConfig)
 		}).DialContext,
 		ForceAttemptHTTP2:     true,
 		ExpectContinueTimeout: 1 * time.Second,
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: false,
-			MinVersion:         tls.VersionTLS12,
-		},
+		TLSClientConfig:       tlsConfig,
 	}
 
 	// Apply transport to HTTP client
@@ -86,32 +104,87 @@ func (e *retryableHTTPService) configureHTTPClient(config ConnectionPoolConfig)
 
 // Get performs HTTP GET with retry logic
 func (e *retryableHTTPService) Get(ctx context.Context, endpoint string) (*models.Response, error) {
-	return e.classifier.RetryWithBackoff(ctx, e.retryConfig, func() (*models.Response, error) {
+	return e.retry(ctx, func() (*models.Response, error) {
 		return e.httpService.Get(ctx, endpoint)
 	})
 }
 
 // Post performs HTTP POST with retry logic
+// The idempotency key, if enabled, is assigned before the first attempt so
+// every retry sends the same key.
 func (e *retryableHTTPService) Post(ctx context.Context, endpoint string, body interface{}) (*models.Response, error) {
-	return e.classifier.RetryWithBackoff(ctx, e.retryConfig, func() (*models.Response, error) {
+	ctx = e.withIdempotencyKey(ctx, http.MethodPost)
+	return e.retry(ctx, func() (*models.Response, error) {
 		return e.httpService.Post(ctx, endpoint, body)
 	})
 }
 
 // Put performs HTTP PUT with retry logic
 func (e *retryableHTTPService) Put(ctx context.Context, endpoint string, body interface{}) (*models.Response, error) {
-	return e.classifier.RetryWithBackoff(ctx, e.retryConfig, func() (*models.Response, error) {
+	ctx = e.withIdempotencyKey(ctx, http.MethodPut)
+	return e.retry(ctx, func() (*models.Response, error) {
 		return e.httpService.Put(ctx, endpoint, body)
 	})
 }
 
 // Delete performs HTTP DELETE with retry logic
 func (e *retryableHTTPService) Delete(ctx context.Context, endpoint string) (*models.Response, error) {
-	return e.classifier.RetryWithBackoff(ctx, e.retryConfig, func() (*models.Response, error) {
+	return e.retry(ctx, func() (*models.Response, error) {
 		return e.httpService.Delete(ctx, endpoint)
 	})
 }
 
+// retry runs operation with backoff and counts every repeated attempt in
+// the run metrics. The circuit breaker sees the request once, with the
+// outcome of its last attempt.
+func (e *retryableHTTPService) retry(ctx context.Context, operation func() (*models.Response, error)) (*models.Response, error) {
+	// An open circuit fails fast; the error is not retryable
+	if err := e.breaker.Allow(); err != nil {
+		return nil, err
+	}
+
+	attempts := 0
+	resp, err := e.classifier.RetryWithBackoff(ctx, e.retryConfig, func() (*models.Response, error) {
+		if attempts > 0 && e.metrics != nil {
+			e.metrics.RecordRetry()
+		}
+		attempts++
+		return operation()
+	})
+	e.recordOutcome(err, resp)
+	return resp, err
+}
+
+// breakerFailureStatus are the responses that count as the server being
+// down: a proxy in front of it answering for an unreachable or overloaded
+// backend
+var breakerFailureStatus = map[int]bool{
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// recordOutcome feeds a request into the circuit breaker. Connection
+// failures and 502, 503 and 504 responses count as failures; any other
+// answer shows the server is up. A cancelled command counts as neither.
+func (e *retryableHTTPService) recordOutcome(err error, resp *models.Response) {
+	var retryErr *errors.RetryExhaustedError
+	switch {
+	case stderrors.Is(err, context.Canceled):
+		return
+	case stderrors.As(err, &retryErr) && retryErr.StatusCode != 0:
+		if breakerFailureStatus[retryErr.StatusCode] {
+			e.breaker.RecordFailure()
+		} else {
+			e.breaker.RecordSuccess()
+		}
+	case err != nil, resp != nil && breakerFailureStatus[resp.StatusCode]:
+		e.breaker.RecordFailure()
+	default:
+		e.breaker.RecordSuccess()
+	}
+}
+
 // PostMultipart performs HTTP multipart POST with retry logic
 func (e *retryableHTTPService) PostMultipart(ctx context.Context, endpoint string, fields map[string]string, files map[string]io.Reader) (*models.Response, error) {
 	// Note: Multipart requests with file uploads are generally not retryable
@@ -143,6 +216,7 @@ func (e *retryableHTTPService) WithTimeout(timeout time.Duration) shared.HTTPCli
 		retryConfig: e.retryConfig,
 		classifier:  e.classifier,
 		diagnostics: e.diagnostics,
+		breaker:     e.breaker,
 	}
 
 	return enhancedCopy
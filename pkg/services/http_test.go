@@ -0,0 +1,335 @@
+package services
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/config"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+// newTestHTTPClient wires the base HTTP client against serverURL. Extra
+// boolean flags (e.g. "compress") are set to true.
+func newTestHTTPClient(t *testing.T, serverURL string, boolFlags ...string) shared.HTTPClient {
+	t.Helper()
+
+	flagSet := flag.NewFlagSet("onb-test", flag.ContinueOnError)
+	flagSet.String("api-url", serverURL, "")
+	flagSet.String("config-dir", t.TempDir(), "")
+	flagSet.Bool("quiet", true, "")
+	for _, name := range boolFlags {
+		flagSet.Bool(name, true, "")
+	}
+	require.NoError(t, flagSet.Parse(nil))
+
+	injector := do.New()
+	do.ProvideValue(injector, cli.NewContext(cli.NewApp(), flagSet, nil))
+	do.Provide(injector, config.NewConfig)
+	do.Provide(injector, NewLogger)
+	do.Provide(injector, NewMetrics)
+	do.Provide(injector, NewResponseCache)
+
+	client, err := NewHTTPClient(injector)
+	require.NoError(t, err)
+	return client
+}
+
+func gzipBytes(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestHTTPClient_DecompressesGzipResponse(t *testing.T) {
+	payload := `{"id":"source:1","full_text":"` + strings.Repeat("lorem ipsum ", 500) + `"}`
+
+	var acceptEncoding string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		acceptEncoding = r.Header.Get("Accept-Encoding")
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(gzipBytes(t, []byte(payload)))
+	}))
+	defer server.Close()
+
+	client := newTestHTTPClient(t, server.URL)
+	resp, err := client.Get(context.Background(), "/sources/source:1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "gzip", acceptEncoding)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, payload, string(resp.Body))
+	assert.Empty(t, http.Header(resp.Header).Get("Content-Encoding"))
+}
+
+func TestHTTPClient_CompressesLargeRequestsWhenAdvertised(t *testing.T) {
+	var encodings []string
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encodings = append(encodings, r.Header.Get("Content-Encoding"))
+		var body io.Reader = r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			zr, err := gzip.NewReader(r.Body)
+			require.NoError(t, err)
+			body = zr
+		}
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(data))
+
+		w.Header().Set("Accept-Encoding", "gzip")
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newTestHTTPClient(t, server.URL, "compress")
+	large := map[string]string{"content": strings.Repeat("x", compressMinBytes)}
+
+	// The first request learns that the server accepts gzip bodies
+	_, err := client.Post(context.Background(), "/sources", large)
+	require.NoError(t, err)
+	_, err = client.Post(context.Background(), "/sources", large)
+	require.NoError(t, err)
+	_, err = client.Post(context.Background(), "/sources", map[string]string{"content": "small"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"", "gzip", ""}, encodings)
+	assert.Equal(t, bodies[0], bodies[1])
+}
+
+func TestHTTPClient_RevalidatesCachedResponsesWithETag(t *testing.T) {
+	const etag = `"v1"`
+	const payload = `[{"id":"notebook:1","name":"Research"}]`
+
+	var ifNoneMatch []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", etag)
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Write([]byte(payload))
+	}))
+	defer server.Close()
+
+	client := newTestHTTPClient(t, server.URL, "cache")
+
+	first, err := client.Get(context.Background(), "/notebooks")
+	require.NoError(t, err)
+	second, err := client.Get(context.Background(), "/notebooks")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"", etag}, ifNoneMatch)
+	assert.Equal(t, http.StatusOK, second.StatusCode)
+	assert.Equal(t, payload, string(first.Body))
+	assert.Equal(t, payload, string(second.Body))
+}
+
+func TestHTTPClient_CacheIsOptIn(t *testing.T) {
+	var ifNoneMatch []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch = append(ifNoneMatch, r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := newTestHTTPClient(t, server.URL)
+	for i := 0; i < 2; i++ {
+		_, err := client.Get(context.Background(), "/notebooks")
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{"", ""}, ifNoneMatch)
+}
+
+func TestHTTPClient_SharesConcurrentIdenticalGets(t *testing.T) {
+	const payload = `[{"id":"notebook:1","name":"Research"}]`
+
+	var hits atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		<-release
+		w.Write([]byte(payload))
+	}))
+	defer server.Close()
+
+	client := newTestHTTPClient(t, server.URL)
+
+	const callers = 20
+	var wg sync.WaitGroup
+	bodies := make([]string, callers)
+	errs := make([]error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := client.Get(context.Background(), "/notebooks")
+			errs[i] = err
+			if err == nil {
+				bodies[i] = string(resp.Body)
+			}
+		}(i)
+	}
+
+	// Hold the first request until every caller has had time to join it
+	require.Eventually(t, func() bool { return hits.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), hits.Load())
+	for i := 0; i < callers; i++ {
+		require.NoError(t, errs[i])
+		assert.Equal(t, payload, bodies[i])
+	}
+
+	// Once completed, the next GET reaches the server again
+	_, err := client.Get(context.Background(), "/notebooks")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), hits.Load())
+}
+
+func TestHTTPClient_RateLimitSpacesRequests(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		times []time.Time
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		times = append(times, time.Now())
+		mu.Unlock()
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newTestHTTPClient(t, server.URL).(*httpService)
+	client.limiter = newRateLimiter(20) // one request every 50ms
+
+	for i := 0; i < 4; i++ {
+		_, err := client.Get(context.Background(), fmt.Sprintf("/sources/source:%d", i))
+		require.NoError(t, err)
+	}
+
+	require.Len(t, times, 4)
+	for i := 1; i < len(times); i++ {
+		assert.GreaterOrEqual(t, times[i].Sub(times[i-1]), 40*time.Millisecond)
+	}
+}
+
+// refreshingAuth is an Auth whose RefreshToken hands client a fresh token
+type refreshingAuth struct {
+	shared.Auth
+	client    shared.HTTPClient
+	refreshes atomic.Int32
+}
+
+func (a *refreshingAuth) RefreshToken(ctx context.Context) error {
+	a.refreshes.Add(1)
+	time.Sleep(50 * time.Millisecond)
+	a.client.SetAuth("fresh")
+	return nil
+}
+
+func TestHTTPClient_ParallelUnauthorizedRequestsRefreshOnce(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newTestHTTPClient(t, server.URL).(*httpService)
+	client.SetAuth("stale")
+	auth := &refreshingAuth{client: client}
+	do.ProvideValue[shared.Auth](client.injector, auth)
+
+	const workers = 10
+	var wg sync.WaitGroup
+	errs := make([]error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := client.Get(context.Background(), fmt.Sprintf("/sources/source:%d", i))
+			if err == nil && resp.StatusCode != http.StatusOK {
+				err = fmt.Errorf("status %d", resp.StatusCode)
+			}
+			errs[i] = err
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 0; i < workers; i++ {
+		require.NoError(t, errs[i])
+	}
+	assert.Equal(t, int32(1), auth.refreshes.Load())
+}
+
+func TestHTTPClient_RetriesUploadAfterTokenRefresh(t *testing.T) {
+	tests := map[string]struct {
+		file        io.Reader
+		wantStatus  int
+		wantRefresh int32
+	}{
+		"seekable file":     {strings.NewReader("file content"), http.StatusOK, 1},
+		"non-seekable file": {io.MultiReader(strings.NewReader("file content")), http.StatusUnauthorized, 0},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var uploads []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				file, _, err := r.FormFile("file")
+				require.NoError(t, err)
+				content, err := io.ReadAll(file)
+				require.NoError(t, err)
+				uploads = append(uploads, string(content))
+
+				if r.Header.Get("Authorization") != "Bearer fresh" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				w.Write([]byte(`{}`))
+			}))
+			defer server.Close()
+
+			client := newTestHTTPClient(t, server.URL).(*httpService)
+			client.SetAuth("stale")
+			auth := &refreshingAuth{client: client}
+			do.ProvideValue[shared.Auth](client.injector, auth)
+
+			files := map[string]io.Reader{"file": &namedReader{Reader: tt.file, name: "notes.txt"}}
+			resp, err := client.PostMultipart(context.Background(), "/sources", nil, files)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, tt.wantRefresh, auth.refreshes.Load())
+			assert.Len(t, uploads, int(tt.wantRefresh)+1)
+			for _, upload := range uploads {
+				assert.Equal(t, "file content", upload)
+			}
+		})
+	}
+}
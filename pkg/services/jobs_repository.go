@@ -36,6 +36,10 @@ func (j *jobRepository) List(ctx context.Context) (*models.JobsListResponse, err
 		return nil, errors.FailedToList("jobs", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.JobsListResponse
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, errors.FailedToDecode("jobs response", err)
@@ -47,12 +51,16 @@ func (j *jobRepository) List(ctx context.Context) (*models.JobsListResponse, err
 
 // GetStatus implements JobRepository interface
 func (j *jobRepository) GetStatus(ctx context.Context, jobID string) (*models.JobStatus, error) {
-	endpoint := fmt.Sprintf("/commands/jobs/%s", jobID)
+	endpoint := BuildURL("/commands/jobs", jobID)
 	resp, err := j.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, errors.FailedToGet("job status", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.JobStatus
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, errors.FailedToDecode("job status response", err)
@@ -64,12 +72,16 @@ func (j *jobRepository) GetStatus(ctx context.Context, jobID string) (*models.Jo
 
 // Cancel implements JobRepository interface
 func (j *jobRepository) Cancel(ctx context.Context, jobID string) error {
-	endpoint := fmt.Sprintf("/commands/jobs/%s", jobID)
-	_, err := j.httpClient.Delete(ctx, endpoint)
+	endpoint := BuildURL("/commands/jobs", jobID)
+	resp, err := j.httpClient.Delete(ctx, endpoint)
 	if err != nil {
 		return errors.FailedToCancel(fmt.Sprintf("job %s", jobID), err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return parseAPIError(resp, endpoint)
+	}
+
 	j.logger.Info("Cancelled job", "job_id", jobID)
 	return nil
 }
@@ -89,6 +101,10 @@ func (j *jobRepository) ListWithStatus(ctx context.Context, status string) (*mod
 		return nil, fmt.Errorf("failed to list jobs by status %s: %w", status, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.JobsListResponse
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse jobs response: %w", err)
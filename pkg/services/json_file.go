@@ -0,0 +1,63 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Permissions of the files kept in the config dir, which hold tokens and
+// other per-user state
+const (
+	privateFileMode = 0600
+	privateDirMode  = 0700
+)
+
+// readJSONFile decodes the JSON file at path into v and reports whether it
+// exists. A missing file leaves v unchanged. what names the file in errors,
+// e.g. "token".
+func readJSONFile(path, what string, v any) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read %s file: %w", what, err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, fmt.Errorf("failed to parse %s file: %w", what, err)
+	}
+	return true, nil
+}
+
+// writeJSONFile writes v as indented JSON to path, readable by the current
+// user only. It writes a temp file first so a partial write never leaves a
+// broken file behind.
+func writeJSONFile(path, what string, v any) error {
+	if err := os.MkdirAll(filepath.Dir(path), privateDirMode); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", what, err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, privateFileMode); err != nil {
+		return fmt.Errorf("failed to write %s file: %w", what, err)
+	}
+	// WriteFile keeps the mode of a stale temp file
+	if err := os.Chmod(tmp, privateFileMode); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to set %s file permissions: %w", what, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to store %s file: %w", what, err)
+	}
+	return nil
+}
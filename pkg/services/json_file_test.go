@@ -0,0 +1,44 @@
+package services
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONFile_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "data.json")
+
+	var missing map[string]int
+	found, err := readJSONFile(path, "data", &missing)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	// A stale temp file with wider permissions must not leak its mode
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path+".tmp", nil, 0644))
+
+	require.NoError(t, writeJSONFile(path, "data", map[string]int{"a": 1}))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(privateFileMode), info.Mode().Perm())
+	assert.NoFileExists(t, path+".tmp")
+
+	var data map[string]int
+	found, err = readJSONFile(path, "data", &data)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, map[string]int{"a": 1}, data)
+}
+
+func TestReadJSONFile_ReportsBrokenFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.json")
+	require.NoError(t, os.WriteFile(path, []byte("{"), privateFileMode))
+
+	var data map[string]int
+	_, err := readJSONFile(path, "data", &data)
+	assert.ErrorContains(t, err, "failed to parse data file")
+}
@@ -20,18 +20,32 @@ type logger struct {
 func NewLogger(injector do.Injector) (shared.Logger, error) {
 	cfg := do.MustInvoke[config.Service](injector)
 
-	// Configure zap based on verbosity
+	level, err := zapcore.ParseLevel(cfg.GetLogLevel())
+	if err != nil {
+		return nil, err
+	}
+
+	// Configure zap based on verbosity; the level applies in every mode
 	var zapConfig zap.Config
 	if cfg.IsVerbose() {
 		zapConfig = zap.NewDevelopmentConfig()
-		zapConfig.Level = zap.NewAtomicLevelAt(zap.DebugLevel)
 	} else {
 		zapConfig = zap.NewProductionConfig()
-		zapConfig.Level = zap.NewAtomicLevelAt(zap.InfoLevel)
 		zapConfig.EncoderConfig.TimeKey = "timestamp"
 		zapConfig.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+		zapConfig.Encoding = "console"
 	}
 
+	// JSON lines for log collectors use the same keys in every mode
+	if cfg.GetLogFormat() == "json" {
+		zapConfig.Encoding = "json"
+		zapConfig.EncoderConfig = zap.NewProductionEncoderConfig()
+		zapConfig.EncoderConfig.TimeKey = "timestamp"
+		zapConfig.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	}
+
+	zapConfig.Level = zap.NewAtomicLevelAt(level)
+
 	// Create the logger
 	zapLogger, err := zapConfig.Build(
 		zap.AddCallerSkip(1), // Skip the wrapper to show correct caller
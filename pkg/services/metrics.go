@@ -0,0 +1,51 @@
+package services
+
+import (
+	"sync"
+	"time"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+)
+
+// Private metrics implementation
+type metrics struct {
+	mu    sync.Mutex
+	stats models.RequestStats
+}
+
+// NewMetrics creates the per-run request statistics accumulator
+func NewMetrics(injector do.Injector) (shared.Metrics, error) {
+	return &metrics{}, nil
+}
+
+// RecordRequest implements Metrics interface
+func (m *metrics) RecordRequest(latency time.Duration, sent, received int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.stats.Requests++
+	m.stats.TotalLatency += latency
+	if latency > m.stats.MaxLatency {
+		m.stats.MaxLatency = latency
+	}
+	m.stats.BytesSent += sent
+	m.stats.BytesReceived += received
+}
+
+// RecordRetry implements Metrics interface
+func (m *metrics) RecordRetry() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.stats.Retries++
+}
+
+// Stats implements Metrics interface
+func (m *metrics) Stats() models.RequestStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.stats
+}
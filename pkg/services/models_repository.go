@@ -36,6 +36,10 @@ func (m *modelRepository) List(ctx context.Context) ([]*models.Model, error) {
 		return nil, fmt.Errorf("failed to list models: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result []*models.Model
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse models response: %w", err)
@@ -52,6 +56,10 @@ func (m *modelRepository) Create(ctx context.Context, model *models.ModelCreate)
 		return nil, fmt.Errorf("failed to create model: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/models")
+	}
+
 	var result models.Model
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse model response: %w", err)
@@ -63,12 +71,16 @@ func (m *modelRepository) Create(ctx context.Context, model *models.ModelCreate)
 
 // Delete implements ModelRepository interface
 func (m *modelRepository) Delete(ctx context.Context, id string) error {
-	endpoint := fmt.Sprintf("/models/%s", id)
-	_, err := m.httpClient.Delete(ctx, endpoint)
+	endpoint := BuildURL("/models", id)
+	resp, err := m.httpClient.Delete(ctx, endpoint)
 	if err != nil {
 		return fmt.Errorf("failed to delete model %s: %w", id, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return parseAPIError(resp, endpoint)
+	}
+
 	m.logger.Info("Deleted model", "id", id)
 	return nil
 }
@@ -81,6 +93,10 @@ func (m *modelRepository) GetDefaults(ctx context.Context) (*models.DefaultModel
 		return nil, fmt.Errorf("failed to get default models: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.DefaultModelsResponse
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse defaults response: %w", err)
@@ -98,6 +114,10 @@ func (m *modelRepository) SetDefaults(ctx context.Context, defaults *models.Defa
 		return fmt.Errorf("failed to set default models: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return parseAPIError(resp, endpoint)
+	}
+
 	var result models.DefaultModelsResponse
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return fmt.Errorf("failed to parse set defaults response: %w", err)
@@ -115,6 +135,10 @@ func (m *modelRepository) GetProviders(ctx context.Context) (*models.ProviderAva
 		return nil, fmt.Errorf("failed to get providers: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.ProviderAvailabilityResponse
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse providers response: %w", err)
@@ -137,6 +161,10 @@ func (m *modelRepository) ListByType(ctx context.Context, modelType models.Model
 		return nil, fmt.Errorf("failed to list models by type %s: %w", modelType, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result []*models.Model
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse models response: %w", err)
@@ -157,6 +185,10 @@ func (m *modelRepository) ListByProvider(ctx context.Context, provider string) (
 		return nil, fmt.Errorf("failed to list models by provider %s: %w", provider, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result []*models.Model
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse models response: %w", err)
@@ -178,6 +210,10 @@ func (m *modelRepository) ListWithPagination(ctx context.Context, limit, offset
 		return nil, fmt.Errorf("failed to list models with pagination: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.ModelsListResponse
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse models response: %w", err)
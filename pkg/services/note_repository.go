@@ -37,12 +37,16 @@ func (n *noteRepository) List(ctx context.Context, notebookID string, limit, off
 	queryParams.Set("limit", strconv.Itoa(limit))
 	queryParams.Set("offset", strconv.Itoa(offset))
 
-	endpoint := "/api/notes?" + queryParams.Encode()
+	endpoint := "/notes?" + queryParams.Encode()
 	resp, err := n.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list notes: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result []*models.Note
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse notes response: %w", err)
@@ -58,12 +62,16 @@ func (n *noteRepository) Search(ctx context.Context, notebookID, query string) (
 	queryParams.Set("notebook_id", notebookID)
 	queryParams.Set("query", query)
 
-	endpoint := "/api/notes/search?" + queryParams.Encode()
+	endpoint := "/notes/search?" + queryParams.Encode()
 	resp, err := n.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search notes: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result []*models.Note
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse search response: %w", err)
@@ -75,11 +83,15 @@ func (n *noteRepository) Search(ctx context.Context, notebookID, query string) (
 
 // Create implements NoteRepository interface
 func (n *noteRepository) Create(ctx context.Context, note *models.NoteCreate) (*models.Note, error) {
-	resp, err := n.httpClient.Post(ctx, "/api/notes", note)
+	resp, err := n.httpClient.Post(ctx, "/notes", note)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create note: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/notes")
+	}
+
 	var result models.Note
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse note response: %w", err)
@@ -91,12 +103,16 @@ func (n *noteRepository) Create(ctx context.Context, note *models.NoteCreate) (*
 
 // Get implements NoteRepository interface
 func (n *noteRepository) Get(ctx context.Context, id string) (*models.Note, error) {
-	endpoint := fmt.Sprintf("/api/notes/%s", id)
+	endpoint := BuildURL("/notes", id)
 	resp, err := n.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get note %s: %w", id, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.Note
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse note response: %w", err)
@@ -108,12 +124,16 @@ func (n *noteRepository) Get(ctx context.Context, id string) (*models.Note, erro
 
 // Update implements NoteRepository interface
 func (n *noteRepository) Update(ctx context.Context, id string, note *models.NoteUpdate) (*models.Note, error) {
-	endpoint := fmt.Sprintf("/api/notes/%s", id)
+	endpoint := BuildURL("/notes", id)
 	resp, err := n.httpClient.Put(ctx, endpoint, note)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update note %s: %w", id, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.Note
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse update response: %w", err)
@@ -125,12 +145,16 @@ func (n *noteRepository) Update(ctx context.Context, id string, note *models.Not
 
 // Delete implements NoteRepository interface
 func (n *noteRepository) Delete(ctx context.Context, id string) error {
-	endpoint := fmt.Sprintf("/api/notes/%s", id)
-	_, err := n.httpClient.Delete(ctx, endpoint)
+	endpoint := BuildURL("/notes", id)
+	resp, err := n.httpClient.Delete(ctx, endpoint)
 	if err != nil {
 		return fmt.Errorf("failed to delete note %s: %w", id, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return parseAPIError(resp, endpoint)
+	}
+
 	n.logger.Info("Deleted note", "id", id)
 	return nil
 }
@@ -144,12 +168,16 @@ func (n *noteRepository) ListByNotebook(ctx context.Context, notebookID string,
 	queryParams.Set("limit", strconv.Itoa(limit))
 	queryParams.Set("offset", strconv.Itoa(offset))
 
-	endpoint := "/api/notes?" + queryParams.Encode()
+	endpoint := "/notes?" + queryParams.Encode()
 	resp, err := n.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list notes by notebook %s: %w", notebookID, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result []*models.Note
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse notes response: %w", err)
@@ -166,12 +194,16 @@ func (n *noteRepository) ListByType(ctx context.Context, noteType models.NoteTyp
 	queryParams.Set("limit", strconv.Itoa(limit))
 	queryParams.Set("offset", strconv.Itoa(offset))
 
-	endpoint := "/api/notes?" + queryParams.Encode()
+	endpoint := "/notes?" + queryParams.Encode()
 	resp, err := n.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list notes by type %s: %w", noteType, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result []*models.Note
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse notes response: %w", err)
@@ -188,12 +220,16 @@ func (n *noteRepository) SearchWithFilters(ctx context.Context, filters map[stri
 		queryParams.Set(key, value)
 	}
 
-	endpoint := "/api/notes/search?" + queryParams.Encode()
+	endpoint := "/notes/search?" + queryParams.Encode()
 	resp, err := n.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search notes with filters: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result []*models.Note
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse search response: %w", err)
@@ -211,12 +247,16 @@ func (n *noteRepository) GetNotesCount(ctx context.Context, notebookID string) (
 	}
 	queryParams.Set("count_only", "true")
 
-	endpoint := "/api/notes?" + queryParams.Encode()
+	endpoint := "/notes?" + queryParams.Encode()
 	resp, err := n.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return 0, fmt.Errorf("failed to get notes count: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return 0, parseAPIError(resp, endpoint)
+	}
+
 	var result struct {
 		Count int `json:"count"`
 	}
@@ -33,7 +33,7 @@ func (r *notebookRepository) List(ctx context.Context) ([]*models.Notebook, erro
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(resp.Body))
+		return nil, parseAPIError(resp, "/notebooks")
 	}
 
 	var notebooks []*models.Notebook
@@ -51,7 +51,7 @@ func (r *notebookRepository) Create(ctx context.Context, notebook *models.Notebo
 	}
 
 	if resp.StatusCode != 201 && resp.StatusCode != 200 {
-		return nil, fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(resp.Body))
+		return nil, parseAPIError(resp, "/notebooks")
 	}
 
 	var createdNotebook models.Notebook
@@ -63,13 +63,14 @@ func (r *notebookRepository) Create(ctx context.Context, notebook *models.Notebo
 }
 
 func (r *notebookRepository) Get(ctx context.Context, id string) (*models.Notebook, error) {
-	resp, err := r.http.Get(ctx, "/notebooks/"+id)
+	endpoint := BuildURL("/notebooks", id)
+	resp, err := r.http.Get(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get notebook: %w", err)
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(resp.Body))
+		return nil, parseAPIError(resp, endpoint)
 	}
 
 	var notebook models.Notebook
@@ -81,13 +82,14 @@ func (r *notebookRepository) Get(ctx context.Context, id string) (*models.Notebo
 }
 
 func (r *notebookRepository) Update(ctx context.Context, id string, notebook *models.NotebookUpdate) (*models.Notebook, error) {
-	resp, err := r.http.Put(ctx, "/notebooks/"+id, notebook)
+	endpoint := BuildURL("/notebooks", id)
+	resp, err := r.http.Put(ctx, endpoint, notebook)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update notebook: %w", err)
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(resp.Body))
+		return nil, parseAPIError(resp, endpoint)
 	}
 
 	var updatedNotebook models.Notebook
@@ -99,13 +101,14 @@ func (r *notebookRepository) Update(ctx context.Context, id string, notebook *mo
 }
 
 func (r *notebookRepository) Delete(ctx context.Context, id string) error {
-	resp, err := r.http.Delete(ctx, "/notebooks/"+id)
+	endpoint := BuildURL("/notebooks", id)
+	resp, err := r.http.Delete(ctx, endpoint)
 	if err != nil {
 		return fmt.Errorf("failed to delete notebook: %w", err)
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
-		return fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(resp.Body))
+		return parseAPIError(resp, endpoint)
 	}
 
 	return nil
@@ -118,26 +121,28 @@ func (r *notebookRepository) AddSource(ctx context.Context, notebookID, sourceID
 		"source_id": sourceID,
 	}
 
-	resp, err := r.http.Post(ctx, "/notebooks/"+notebookID+"/sources", payload)
+	endpoint := BuildURL("/notebooks", notebookID, "sources")
+	resp, err := r.http.Post(ctx, endpoint, payload)
 	if err != nil {
 		return fmt.Errorf("failed to add source to notebook: %w", err)
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 201 {
-		return fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(resp.Body))
+		return parseAPIError(resp, endpoint)
 	}
 
 	return nil
 }
 
 func (r *notebookRepository) RemoveSource(ctx context.Context, notebookID, sourceID string) error {
-	resp, err := r.http.Delete(ctx, "/notebooks/"+notebookID+"/sources/"+sourceID)
+	endpoint := BuildURL("/notebooks", notebookID, "sources", sourceID)
+	resp, err := r.http.Delete(ctx, endpoint)
 	if err != nil {
 		return fmt.Errorf("failed to remove source from notebook: %w", err)
 	}
 
 	if resp.StatusCode != 200 && resp.StatusCode != 204 {
-		return fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(resp.Body))
+		return parseAPIError(resp, endpoint)
 	}
 
 	return nil
@@ -37,6 +37,10 @@ func (p *podcastRepository) Generate(ctx context.Context, req *models.PodcastGen
 		return nil, fmt.Errorf("failed to generate podcast: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/podcasts/generate")
+	}
+
 	var result models.PodcastGenerationResponse
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse podcast generation response: %w", err)
@@ -48,12 +52,16 @@ func (p *podcastRepository) Generate(ctx context.Context, req *models.PodcastGen
 
 // GetJobStatus implements PodcastRepository interface
 func (p *podcastRepository) GetJobStatus(ctx context.Context, jobID string) (*models.PodcastJobStatus, error) {
-	endpoint := fmt.Sprintf("/podcasts/jobs/%s", jobID)
+	endpoint := BuildURL("/podcasts/jobs", jobID)
 	resp, err := p.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get podcast job status: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.PodcastJobStatus
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse podcast job status response: %w", err)
@@ -75,6 +83,10 @@ func (p *podcastRepository) ListEpisodes(ctx context.Context, limit, offset int)
 		return nil, fmt.Errorf("failed to list podcast episodes: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.PodcastEpisodesListResponse
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse podcast episodes response: %w", err)
@@ -86,12 +98,16 @@ func (p *podcastRepository) ListEpisodes(ctx context.Context, limit, offset int)
 
 // GetEpisode implements PodcastRepository interface
 func (p *podcastRepository) GetEpisode(ctx context.Context, episodeID string) (*models.PodcastEpisodeResponse, error) {
-	endpoint := fmt.Sprintf("/podcasts/episodes/%s", episodeID)
+	endpoint := BuildURL("/podcasts/episodes", episodeID)
 	resp, err := p.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get podcast episode: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.PodcastEpisodeResponse
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse podcast episode response: %w", err)
@@ -103,28 +119,56 @@ func (p *podcastRepository) GetEpisode(ctx context.Context, episodeID string) (*
 
 // DownloadEpisodeAudio implements PodcastRepository interface
 func (p *podcastRepository) DownloadEpisodeAudio(ctx context.Context, episodeID string) (io.ReadCloser, error) {
-	endpoint := fmt.Sprintf("/podcasts/episodes/%s/audio", episodeID)
+	endpoint := BuildURL("/podcasts/episodes", episodeID, "audio")
 	resp, err := p.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to download podcast audio: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	p.logger.Info("Downloaded podcast episode audio", "episode_id", episodeID, "size", len(resp.Body))
 	return io.NopCloser(bytes.NewReader(resp.Body)), nil
 }
 
 // DeleteEpisode implements PodcastRepository interface
 func (p *podcastRepository) DeleteEpisode(ctx context.Context, episodeID string) error {
-	endpoint := fmt.Sprintf("/podcasts/episodes/%s", episodeID)
-	_, err := p.httpClient.Delete(ctx, endpoint)
+	endpoint := BuildURL("/podcasts/episodes", episodeID)
+	resp, err := p.httpClient.Delete(ctx, endpoint)
 	if err != nil {
 		return fmt.Errorf("failed to delete podcast episode %s: %w", episodeID, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return parseAPIError(resp, endpoint)
+	}
+
 	p.logger.Info("Deleted podcast episode", "episode_id", episodeID)
 	return nil
 }
 
+// ListVoices implements PodcastRepository interface
+func (p *podcastRepository) ListVoices(ctx context.Context) (*models.PodcastVoicesResponse, error) {
+	resp, err := p.httpClient.Get(ctx, "/podcasts/voices")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list podcast voices: %w", err)
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, parseAPIError(resp, "/podcasts/voices")
+	}
+
+	var result models.PodcastVoicesResponse
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse podcast voices response: %w", err)
+	}
+
+	p.logger.Info("Retrieved podcast voices", "count", len(result.Voices))
+	return &result, nil
+}
+
 // Extended methods not part of interface
 
 // ListEpisodesWithLanguage filters episodes by language
@@ -142,6 +186,10 @@ func (p *podcastRepository) ListEpisodesWithLanguage(ctx context.Context, langua
 		return nil, fmt.Errorf("failed to list podcast episodes by language %s: %w", language, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.PodcastEpisodesListResponse
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse podcast episodes response: %w", err)
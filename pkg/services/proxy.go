@@ -0,0 +1,34 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/config"
+)
+
+// ProxyFunc selects the proxy for a request, as used by http.Transport
+type ProxyFunc func(*http.Request) (*url.URL, error)
+
+// NewProxyFunc returns the proxy selection of the HTTP transport. An
+// explicit --proxy is used for every request; otherwise HTTP_PROXY,
+// HTTPS_PROXY and NO_PROXY from the environment apply.
+func NewProxyFunc(cfg config.Service) (ProxyFunc, error) {
+	raw := cfg.GetProxy()
+	if raw == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+
+	proxyURL, err := url.Parse(raw)
+	if err != nil || proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: expected e.g. http://proxy.example.com:3128", raw)
+	}
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q: use http, https or socks5", proxyURL.Scheme)
+	}
+
+	return http.ProxyURL(proxyURL), nil
+}
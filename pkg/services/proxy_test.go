@@ -0,0 +1,67 @@
+package services
+
+import (
+	"flag"
+	"net/http"
+	"testing"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/config"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+// newTestTransport builds the retryable client with the given --proxy and
+// returns its transport
+func newTestTransport(t *testing.T, proxy string) (*http.Transport, error) {
+	t.Helper()
+
+	flagSet := flag.NewFlagSet("onb-test", flag.ContinueOnError)
+	flagSet.String("api-url", "https://notebook.example.com", "")
+	flagSet.String("config-dir", t.TempDir(), "")
+	flagSet.String("proxy", proxy, "")
+	require.NoError(t, flagSet.Parse(nil))
+
+	injector := do.New()
+	do.ProvideValue(injector, cli.NewContext(cli.NewApp(), flagSet, nil))
+	do.Provide(injector, config.NewConfig)
+	do.Provide(injector, NewLogger)
+	do.Provide(injector, NewMetrics)
+	do.Provide(injector, NewCircuitBreaker)
+
+	client, err := NewRetryableHTTPClient(injector)
+	if err != nil {
+		return nil, err
+	}
+	return client.(*retryableHTTPService).httpClient.Transport.(*http.Transport), nil
+}
+
+func TestRetryableHTTPClient_UsesExplicitProxy(t *testing.T) {
+	transport, err := newTestTransport(t, "http://proxy.example.com:3128")
+	require.NoError(t, err)
+	require.NotNil(t, transport.Proxy)
+
+	req, err := http.NewRequest(http.MethodGet, "https://notebook.example.com/api/notebooks", nil)
+	require.NoError(t, err)
+
+	proxyURL, err := transport.Proxy(req)
+	require.NoError(t, err)
+	require.NotNil(t, proxyURL)
+	assert.Equal(t, "http://proxy.example.com:3128", proxyURL.String())
+}
+
+func TestRetryableHTTPClient_ProxyDefaultsToEnvironment(t *testing.T) {
+	transport, err := newTestTransport(t, "")
+	require.NoError(t, err)
+	assert.NotNil(t, transport.Proxy)
+}
+
+func TestRetryableHTTPClient_RejectsInvalidProxy(t *testing.T) {
+	for _, proxy := range []string{"proxy.example.com", "ftp://proxy.example.com:21"} {
+		_, err := newTestTransport(t, proxy)
+		var flagErr *FlagError
+		require.ErrorAs(t, err, &flagErr, proxy)
+		assert.Equal(t, "--proxy", flagErr.Flag)
+	}
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket that spaces requests to at most rate per
+// second. The bucket holds a single token, so requests never burst above
+// the configured rate.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration // time to earn one token
+	next     time.Time     // when the next token is available
+}
+
+// newRateLimiter creates a limiter for rate requests per second, or returns
+// nil when rate is not positive (no limit)
+func newRateLimiter(rate float64) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / rate)}
+}
+
+// Wait blocks until the next request may be sent or ctx is done. A nil
+// limiter never blocks.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := time.Until(at)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
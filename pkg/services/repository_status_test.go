@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
+	"github.com/denkhaus/open-notebook-cli/pkg/mocks"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRepositories_ReturnAPIErrorOnErrorStatus checks that error responses
+// surface as API errors instead of being decoded as empty results. The mock
+// client answers every request with a 404.
+func TestRepositories_ReturnAPIErrorOnErrorStatus(t *testing.T) {
+	injector := do.New()
+	do.ProvideValue[shared.HTTPClient](injector, mocks.NewMockHTTPClient())
+	do.ProvideValue[shared.Logger](injector, mocks.NewMockLogger(false))
+
+	sources, err := NewSourceRepository(injector)
+	require.NoError(t, err)
+	notes, err := NewNoteRepository(injector)
+	require.NoError(t, err)
+	modelRepo, err := NewModelRepository(injector)
+	require.NoError(t, err)
+	jobs, err := NewJobRepository(injector)
+	require.NoError(t, err)
+	transformations, err := NewTransformationRepository(injector)
+	require.NoError(t, err)
+	search, err := NewSearchRepository(injector)
+	require.NoError(t, err)
+	podcasts, err := NewPodcastRepository(injector)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	title, link := "Missing", "https://example.com"
+	calls := map[string]func() error{
+		"sources list": func() error { _, err := sources.List(ctx, 10, 0); return err },
+		"sources get":  func() error { _, err := sources.Get(ctx, "source:missing"); return err },
+		"sources create": func() error {
+			_, err := sources.Create(ctx, &models.SourceCreate{Type: models.SourceTypeLink, Title: &title, URL: &link})
+			return err
+		},
+		"sources update":      func() error { _, err := sources.Update(ctx, "source:missing", &models.SourceUpdate{}); return err },
+		"sources delete":      func() error { return sources.Delete(ctx, "source:missing") },
+		"notes get":           func() error { _, err := notes.Get(ctx, "note:missing"); return err },
+		"notes delete":        func() error { return notes.Delete(ctx, "note:missing") },
+		"models list":         func() error { _, err := modelRepo.List(ctx); return err },
+		"jobs status":         func() error { _, err := jobs.GetStatus(ctx, "job:missing"); return err },
+		"transformations get": func() error { _, err := transformations.Get(ctx, "transformation:missing"); return err },
+		"search":              func() error { _, err := search.Search(ctx, &models.SearchRequest{Query: "q"}); return err },
+		"podcasts episode":    func() error { _, err := podcasts.GetEpisode(ctx, "episode:missing"); return err },
+		"podcasts delete":     func() error { return podcasts.DeleteEpisode(ctx, "episode:missing") },
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			assert.True(t, errors.IsNotFound(call()))
+		})
+	}
+}
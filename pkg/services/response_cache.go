@@ -0,0 +1,97 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/config"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+)
+
+const (
+	cacheDirName  = "cache"
+	cacheFileMode = 0600
+	cacheDirMode  = 0700
+)
+
+// Private file based response cache implementation
+type responseCache struct {
+	dir    string
+	logger shared.Logger
+}
+
+// NewResponseCache creates a response cache in the config dir
+func NewResponseCache(injector do.Injector) (shared.ResponseCache, error) {
+	cfg := do.MustInvoke[config.Service](injector)
+	logger := do.MustInvoke[shared.Logger](injector)
+
+	return &responseCache{
+		dir:    filepath.Join(cfg.GetConfigDir(), cacheDirName),
+		logger: logger,
+	}, nil
+}
+
+// Get returns the cached entry for key, or nil if there is none
+func (c *responseCache) Get(key string) (*models.CachedResponse, error) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read cache entry: %w", err)
+	}
+
+	var entry models.CachedResponse
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to parse cache entry: %w", err)
+	}
+
+	return &entry, nil
+}
+
+// Put stores entry under key, readable by the current user only
+func (c *responseCache) Put(key string, entry *models.CachedResponse) error {
+	if err := os.MkdirAll(c.dir, cacheDirMode); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode cache entry: %w", err)
+	}
+
+	// Write to a temp file first so readers never see a partial entry
+	path := c.path(key)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, cacheFileMode); err != nil {
+		return fmt.Errorf("failed to write cache entry: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to store cache entry: %w", err)
+	}
+
+	c.logger.Debug("Cached response", "etag", entry.ETag)
+	return nil
+}
+
+// Clear removes all cached responses
+func (c *responseCache) Clear() error {
+	if err := os.RemoveAll(c.dir); err != nil {
+		return fmt.Errorf("failed to remove cache directory: %w", err)
+	}
+	return nil
+}
+
+// path maps a cache key to a file name that is safe on every platform
+func (c *responseCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
@@ -8,22 +8,17 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/denkhaus/open-notebook-cli/pkg/errors"
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
 )
 
 // RetryConfig holds retry configuration for network operations
-type RetryConfig struct {
-	MaxRetries      int           `json:"max_retries"`
-	BaseDelay       time.Duration `json:"base_delay"`
-	MaxDelay        time.Duration `json:"max_delay"`
-	BackoffFactor   float64       `json:"backoff_factor"`
-	RetryableErrors []string      `json:"retryable_errors"`
-	RetryableStatus []int         `json:"retryable_status"`
-}
+type RetryConfig = models.RetryConfig
 
 // DefaultRetryConfig returns default retry configuration
 func DefaultRetryConfig() RetryConfig {
@@ -163,13 +158,16 @@ func (nec *NetworkErrorClassifier) IsRetryableStatus(statusCode int, config Retr
 	return false
 }
 
-// RetryWithBackoff executes a function with exponential backoff retry logic
+// RetryWithBackoff executes a function with exponential backoff retry logic.
+// Every attempt runs under the same ctx, so an idempotency key attached to it
+// by the caller is sent unchanged with each retry.
 func (nec *NetworkErrorClassifier) RetryWithBackoff(
 	ctx context.Context,
 	config RetryConfig,
 	operation func() (*models.Response, error),
 ) (*models.Response, error) {
 	var lastErr error
+	var lastStatus int
 	var resp *models.Response
 
 	for attempt := 0; attempt <= config.MaxRetries; attempt++ {
@@ -207,6 +205,7 @@ func (nec *NetworkErrorClassifier) RetryWithBackoff(
 			)
 
 			// Treat retryable status as an error for retry logic
+			lastStatus = resp.StatusCode
 			lastErr = fmt.Errorf("HTTP %d: retryable status", resp.StatusCode)
 		} else {
 			// Check if this error is retryable
@@ -214,6 +213,7 @@ func (nec *NetworkErrorClassifier) RetryWithBackoff(
 				return resp, lastErr
 			}
 
+			lastStatus = 0
 			nec.logger.Debug("Network operation failed with retryable error",
 				"attempt", attempt,
 				"error_type", nec.ClassifyError(lastErr),
@@ -222,7 +222,15 @@ func (nec *NetworkErrorClassifier) RetryWithBackoff(
 		}
 	}
 
-	return resp, lastErr
+	if lastErr == nil {
+		return resp, nil
+	}
+
+	return resp, &errors.RetryExhaustedError{
+		Attempts:   config.MaxRetries + 1,
+		StatusCode: lastStatus,
+		Cause:      lastErr,
+	}
 }
 
 // calculateBackoffDelay calculates exponential backoff delay with jitter
@@ -329,7 +337,12 @@ func (nd *NetworkDiagnostics) parseHostPort(serverURL string) (string, int, erro
 		}
 	}
 
-	return host, 0, fmt.Errorf("port parsing not implemented")
+	portNumber, err := strconv.Atoi(port)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid port %q: %w", port, err)
+	}
+
+	return host, portNumber, nil
 }
 
 // testTCPConnectivity tests basic TCP connectivity
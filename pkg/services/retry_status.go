@@ -0,0 +1,55 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/config"
+)
+
+// serverErrorStatus are the 5xx codes --no-retry-5xx stops retrying. 503
+// stays retryable: it announces a temporary outage.
+var serverErrorStatus = []int{
+	http.StatusInternalServerError, // 500
+	http.StatusBadGateway,          // 502
+	http.StatusGatewayTimeout,      // 504
+}
+
+// NewRetryableStatus returns the HTTP status codes that are retried: the
+// codes of --retry-status, or defaults when it is not set, without 500, 502
+// and 504 under --no-retry-5xx.
+func NewRetryableStatus(cfg config.Service, defaults []int) ([]int, error) {
+	status := slices.Clone(defaults)
+	if raw := cfg.GetRetryStatus(); raw != "" {
+		var err error
+		if status, err = parseStatusCodes(raw); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.SkipRetry5xx() {
+		status = slices.DeleteFunc(status, func(code int) bool {
+			return slices.Contains(serverErrorStatus, code)
+		})
+	}
+	return status, nil
+}
+
+// parseStatusCodes parses a comma-separated list of HTTP status codes
+func parseStatusCodes(raw string) ([]int, error) {
+	var codes []int
+	for _, field := range strings.Split(raw, ",") {
+		field = strings.TrimSpace(field)
+		code, err := strconv.Atoi(field)
+		if err != nil || code < 100 || code > 599 {
+			return nil, fmt.Errorf("invalid retry status %q: expected HTTP status codes between 100 and 599, e.g. 408,429,503", field)
+		}
+		if !slices.Contains(codes, code) {
+			codes = append(codes, code)
+		}
+	}
+	return codes, nil
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/config"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+// newRetryStatusInjector wires the configuration for the given
+// --retry-status and --no-retry-5xx
+func newRetryStatusInjector(t *testing.T, retryStatus string, noRetry5xx bool) do.Injector {
+	t.Helper()
+
+	flagSet := flag.NewFlagSet("onb-test", flag.ContinueOnError)
+	flagSet.String("config-dir", t.TempDir(), "")
+	flagSet.String("retry-status", retryStatus, "")
+	flagSet.Bool("no-retry-5xx", noRetry5xx, "")
+	require.NoError(t, flagSet.Parse(nil))
+
+	injector := do.New()
+	do.ProvideValue(injector, cli.NewContext(cli.NewApp(), flagSet, nil))
+	do.Provide(injector, config.NewConfig)
+	return injector
+}
+
+// newRetryStatusConfig returns the configuration for the given
+// --retry-status and --no-retry-5xx
+func newRetryStatusConfig(t *testing.T, retryStatus string, noRetry5xx bool) config.Service {
+	t.Helper()
+	return do.MustInvoke[config.Service](newRetryStatusInjector(t, retryStatus, noRetry5xx))
+}
+
+func TestNewRetryableStatus(t *testing.T) {
+	defaults := DefaultRetryConfig().RetryableStatus
+
+	tests := map[string]struct {
+		retryStatus string
+		noRetry5xx  bool
+		want        []int
+	}{
+		"defaults":            {"", false, []int{408, 429, 500, 502, 503, 504}},
+		"custom list":         {"408, 429,503,429", false, []int{408, 429, 503}},
+		"no 5xx":              {"", true, []int{408, 429, 503}},
+		"custom list, no 5xx": {"500,503", true, []int{503}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			status, err := NewRetryableStatus(newRetryStatusConfig(t, tt.retryStatus, tt.noRetry5xx), defaults)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, status)
+		})
+	}
+}
+
+func TestNewRetryableStatus_RejectsInvalidCodes(t *testing.T) {
+	for _, raw := range []string{"abc", "408,", "99", "600", "429;503"} {
+		_, err := NewRetryableStatus(newRetryStatusConfig(t, raw, false), nil)
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestRetryableHTTPClient_RetryConfigThroughInterface(t *testing.T) {
+	injector := newRetryStatusInjector(t, "", true)
+	do.Provide(injector, NewLogger)
+	do.Provide(injector, NewMetrics)
+	do.Provide(injector, NewCircuitBreaker)
+
+	base, err := NewRetryableHTTPClient(injector)
+	require.NoError(t, err)
+	client := NewAuthenticatedHTTPClient(base, nil)
+
+	retryConfig := client.GetRetryConfig()
+	assert.Equal(t, []int{408, 429, 503}, retryConfig.RetryableStatus)
+
+	retryConfig.MaxRetries = 1
+	client.SetRetryConfig(retryConfig)
+	assert.Equal(t, 1, base.GetRetryConfig().MaxRetries)
+	assert.Equal(t, 1, client.WithTimeout(time.Second).GetRetryConfig().MaxRetries)
+}
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/config"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+)
+
+const savedSearchesFileName = "searches.json"
+
+// Private file based store of named searches, keyed by name
+type savedSearchStore struct {
+	path   string
+	logger shared.Logger
+	mu     sync.Mutex
+}
+
+// NewSavedSearchStore creates a saved search store that keeps its file in the
+// config dir
+func NewSavedSearchStore(injector do.Injector) (shared.SavedSearchStore, error) {
+	cfg := do.MustInvoke[config.Service](injector)
+	logger := do.MustInvoke[shared.Logger](injector)
+
+	return &savedSearchStore{
+		path:   filepath.Join(cfg.GetConfigDir(), savedSearchesFileName),
+		logger: logger,
+	}, nil
+}
+
+// List returns all saved searches ordered by name
+func (s *savedSearchStore) List() ([]models.SavedSearch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	searches, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]models.SavedSearch, 0, len(searches))
+	for _, search := range searches {
+		list = append(list, search)
+	}
+	slices.SortFunc(list, func(a, b models.SavedSearch) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return list, nil
+}
+
+// Get returns the saved search called name, or nil if there is none
+func (s *savedSearchStore) Get(name string) (*models.SavedSearch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	searches, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	search, ok := searches[name]
+	if !ok {
+		return nil, nil
+	}
+	return &search, nil
+}
+
+// Save stores search under its name, replacing a search of the same name
+func (s *savedSearchStore) Save(search models.SavedSearch) error {
+	if search.Name == "" {
+		return fmt.Errorf("saved search name is required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	searches, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	searches[search.Name] = search
+	return s.save(searches)
+}
+
+// Remove deletes the saved search called name and reports whether it existed
+func (s *savedSearchStore) Remove(name string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	searches, err := s.load()
+	if err != nil {
+		return false, err
+	}
+	if _, ok := searches[name]; !ok {
+		return false, nil
+	}
+
+	delete(searches, name)
+	return true, s.save(searches)
+}
+
+func (s *savedSearchStore) load() (map[string]models.SavedSearch, error) {
+	searches := map[string]models.SavedSearch{}
+	if _, err := readJSONFile(s.path, "saved searches", &searches); err != nil {
+		return nil, err
+	}
+	return searches, nil
+}
+
+func (s *savedSearchStore) save(searches map[string]models.SavedSearch) error {
+	if err := writeJSONFile(s.path, "saved searches", searches); err != nil {
+		return err
+	}
+
+	s.logger.Debug("Stored saved searches", "path", s.path)
+	return nil
+}
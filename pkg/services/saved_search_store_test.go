@@ -0,0 +1,48 @@
+package services
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSavedSearchStore_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config", savedSearchesFileName)
+	store := &savedSearchStore{path: path, logger: &logger{zap: zap.NewNop()}}
+
+	missing, err := store.Get("ml")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	ml := models.SavedSearch{
+		Name:     "ml",
+		Request:  models.SearchRequest{Query: "machine learning", Type: models.SearchTypeVector, Limit: 20, MinimumScore: 0.4},
+		Notebook: "Research",
+	}
+	require.NoError(t, store.Save(ml))
+	require.NoError(t, store.Save(models.SavedSearch{Name: "go", Request: models.SearchRequest{Query: "golang"}}))
+
+	// A second store on the same file sees the saved searches
+	reopened := &savedSearchStore{path: path, logger: store.logger}
+	got, err := reopened.Get("ml")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, ml.Request, got.Request)
+	assert.Equal(t, "Research", got.Notebook)
+
+	list, err := reopened.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "go", list[0].Name)
+
+	removed, err := reopened.Remove("go")
+	require.NoError(t, err)
+	assert.True(t, removed)
+	removed, err = reopened.Remove("go")
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
@@ -31,11 +31,15 @@ func NewSearchRepository(injector do.Injector) (shared.SearchRepository, error)
 func (r *searchRepository) Search(ctx context.Context, req *models.SearchRequest) (*models.SearchResponse, error) {
 	r.logger.Info("Performing search", "query", req.Query, "type", string(req.Type), "limit", req.Limit)
 
-	resp, err := r.httpClient.Post(ctx, "/api/search", req)
+	resp, err := r.httpClient.Post(ctx, "/search", req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to perform search: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/search")
+	}
+
 	var result models.SearchResponse
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse search response: %w", err)
@@ -50,7 +54,7 @@ func (r *searchRepository) Ask(ctx context.Context, req *models.AskRequest) (<-c
 	r.logger.Info("Starting AI ask", "question", req.Question, "strategy_model", req.StrategyModel, "answer_model", req.AnswerModel)
 
 	// Use the streaming HTTP client for streaming responses
-	streamChan, err := r.httpClient.Stream(ctx, "/api/search/ask", req)
+	streamChan, err := r.httpClient.Stream(ctx, "/search/ask", req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start ask stream: %w", err)
 	}
@@ -107,11 +111,15 @@ func (r *searchRepository) Ask(ctx context.Context, req *models.AskRequest) (<-c
 func (r *searchRepository) AskSimple(ctx context.Context, req *models.AskRequest) (*models.AskResponse, error) {
 	r.logger.Info("Starting simple AI ask", "question", req.Question, "strategy_model", req.StrategyModel, "answer_model", req.AnswerModel)
 
-	resp, err := r.httpClient.Post(ctx, "/api/search/ask/simple", req)
+	resp, err := r.httpClient.Post(ctx, "/search/ask/simple", req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to perform simple ask: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/search/ask/simple")
+	}
+
 	var result models.AskResponse
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse ask response: %w", err)
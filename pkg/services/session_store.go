@@ -0,0 +1,105 @@
+package services
+
+import (
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/config"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+)
+
+const sessionsFileName = "sessions.json"
+
+// Private file based store of the last chat session per notebook. Sessions
+// used without a notebook are stored under the empty notebook ID.
+type sessionStore struct {
+	path   string
+	apiURL string
+	logger shared.Logger
+	mu     sync.Mutex
+}
+
+// NewSessionStore creates a session store that keeps its file in the config dir
+func NewSessionStore(injector do.Injector) (shared.SessionStore, error) {
+	cfg := do.MustInvoke[config.Service](injector)
+	logger := do.MustInvoke[shared.Logger](injector)
+
+	return &sessionStore{
+		path:   filepath.Join(cfg.GetConfigDir(), sessionsFileName),
+		apiURL: cfg.GetAPIURL(),
+		logger: logger,
+	}, nil
+}
+
+// LastSession returns the last session used for notebookID on the current
+// API URL, or "" if there is none
+func (s *sessionStore) LastSession(notebookID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sessions, err := s.load()
+	if err != nil {
+		return "", err
+	}
+
+	last, ok := sessions[notebookID]
+	if !ok || last.APIURL != s.apiURL {
+		return "", nil
+	}
+	return last.SessionID, nil
+}
+
+// SetLastSession records sessionID as the last session of notebookID
+func (s *sessionStore) SetLastSession(notebookID, sessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sessions, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	sessions[notebookID] = models.LastChatSession{
+		SessionID: sessionID,
+		APIURL:    s.apiURL,
+		Updated:   time.Now(),
+	}
+	return s.save(sessions)
+}
+
+// ClearLastSession forgets the last session of notebookID
+func (s *sessionStore) ClearLastSession(notebookID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sessions, err := s.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := sessions[notebookID]; !ok {
+		return nil
+	}
+
+	delete(sessions, notebookID)
+	return s.save(sessions)
+}
+
+func (s *sessionStore) load() (map[string]models.LastChatSession, error) {
+	sessions := map[string]models.LastChatSession{}
+	if _, err := readJSONFile(s.path, "sessions", &sessions); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func (s *sessionStore) save(sessions map[string]models.LastChatSession) error {
+	if err := writeJSONFile(s.path, "sessions", sessions); err != nil {
+		return err
+	}
+
+	s.logger.Debug("Stored last chat sessions", "path", s.path)
+	return nil
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+)
+
+type settingsRepository struct {
+	httpClient shared.HTTPClient
+	logger     shared.Logger
+}
+
+// NewSettingsRepository creates a new settings repository
+func NewSettingsRepository(injector do.Injector) (shared.SettingsRepository, error) {
+	httpClient := do.MustInvoke[shared.HTTPClient](injector)
+	logger := do.MustInvoke[shared.Logger](injector)
+
+	return &settingsRepository{
+		httpClient: httpClient,
+		logger:     logger,
+	}, nil
+}
+
+// Get implements SettingsRepository interface
+func (s *settingsRepository) Get(ctx context.Context) (*models.SettingsResponse, error) {
+	resp, err := s.httpClient.Get(ctx, "/settings")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get settings: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/settings")
+	}
+
+	var result models.SettingsResponse
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse settings response: %w", err)
+	}
+
+	s.logger.Info("Retrieved settings")
+	return &result, nil
+}
+
+// Update implements SettingsRepository interface
+func (s *settingsRepository) Update(ctx context.Context, settings *models.SettingsUpdate) (*models.SettingsResponse, error) {
+	resp, err := s.httpClient.Put(ctx, "/settings", settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update settings: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/settings")
+	}
+
+	var result models.SettingsResponse
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse settings response: %w", err)
+	}
+
+	s.logger.Info("Updated settings")
+	return &result, nil
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"sync"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+)
+
+// requestGroup deduplicates concurrent identical requests: callers asking
+// for a key that is already in flight wait for that call and share its
+// response instead of issuing their own.
+type requestGroup struct {
+	mu    sync.Mutex
+	calls map[string]*inflightRequest
+}
+
+// inflightRequest is a call in progress or completed
+type inflightRequest struct {
+	done chan struct{}
+	resp *models.Response
+	err  error
+}
+
+// newRequestGroup creates an empty request group
+func newRequestGroup() *requestGroup {
+	return &requestGroup{calls: make(map[string]*inflightRequest)}
+}
+
+// Do runs fn for key unless a call for key is already in flight, in which
+// case it waits for and returns that call's result. shared reports whether
+// the result came from another caller. Responses are shared, so callers
+// must treat them as read-only.
+func (g *requestGroup) Do(key string, fn func() (*models.Response, error)) (resp *models.Response, err error, shared bool) {
+	g.mu.Lock()
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		<-call.done
+		return call.resp, call.err, true
+	}
+	call := &inflightRequest{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(call.done)
+	}()
+
+	call.resp, call.err = fn()
+	return call.resp, call.err, false
+}
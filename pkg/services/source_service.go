@@ -10,6 +10,9 @@ import (
 	"github.com/samber/do/v2"
 )
 
+// notebookSourcesPageSize is the page size used when listing all sources of a notebook
+const notebookSourcesPageSize = 100
+
 type sourceService struct {
 	repo shared.SourceRepository
 }
@@ -33,6 +36,25 @@ func (s *sourceService) List(ctx context.Context, limit, offset int) ([]*models.
 	return s.repo.List(ctx, limit, offset)
 }
 
+// ListAllByNotebook returns every source of a notebook, paging through the API
+func (s *sourceService) ListAllByNotebook(ctx context.Context, notebookID string) ([]*models.SourceListResponse, error) {
+	if notebookID == "" {
+		return nil, fmt.Errorf("notebook ID is required")
+	}
+
+	var all []*models.SourceListResponse
+	for offset := 0; ; offset += notebookSourcesPageSize {
+		page, err := s.repo.ListByNotebook(ctx, notebookID, notebookSourcesPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		if len(page) < notebookSourcesPageSize {
+			return all, nil
+		}
+	}
+}
+
 func (s *sourceService) AddSourceFromLink(ctx context.Context, link string, options *models.SourceOptions) (*models.Source, error) {
 	if link == "" {
 		return nil, fmt.Errorf("source link is required")
@@ -74,20 +96,31 @@ func (s *sourceService) AddSourceFromUpload(ctx context.Context, filename string
 
 	// Create source with upload-specific business logic
 	source := &models.SourceCreate{
-		FilePath:        &filename,
 		Type:            models.SourceTypeUpload,
+		Notebooks:       options.Notebooks,
 		Embed:           options.Embed,
 		DeleteSource:    options.DeleteSource,
 		AsyncProcessing: options.AsyncProcessing,
 	}
 
+	if options.Title != "" {
+		source.Title = &options.Title
+	}
+
 	// Add transformations if provided
 	if len(options.Transformations) > 0 {
 		source.Transformations = options.Transformations
 	}
 
-	// Use CreateFromJSON method for file uploads (if available)
-	return s.repo.CreateFromJSON(ctx, source)
+	// Large files are split into individually retried chunks
+	if options.ChunkSize > 0 {
+		return s.repo.UploadChunked(ctx, filename, file, source, &models.ChunkedUploadOptions{
+			ChunkSize: options.ChunkSize,
+		})
+	}
+
+	// Stream the file content as multipart form data
+	return s.repo.Upload(ctx, filename, file, source)
 }
 
 func (s *sourceService) AddSourceFromText(ctx context.Context, text, title string, options *models.SourceOptions) (*models.Source, error) {
@@ -169,9 +202,9 @@ func (s *sourceService) GetStatus(ctx context.Context, id string) (*models.Sourc
 	return s.repo.GetStatus(ctx, id)
 }
 
-func (s *sourceService) Download(ctx context.Context, id string) (io.ReadCloser, error) {
+func (s *sourceService) Download(ctx context.Context, id string) (io.ReadCloser, *models.DownloadMetadata, error) {
 	if id == "" {
-		return nil, fmt.Errorf("source ID is required")
+		return nil, nil, fmt.Errorf("source ID is required")
 	}
 
 	return s.repo.Download(ctx, id)
@@ -212,6 +245,18 @@ func (s *sourceService) CreateInsight(ctx context.Context, sourceID string, requ
 	return s.repo.CreateInsight(ctx, sourceID, request)
 }
 
+func (s *sourceService) SaveInsightAsNote(ctx context.Context, insightID string, notebookID string) (*models.Note, error) {
+	if insightID == "" {
+		return nil, fmt.Errorf("insight ID is required")
+	}
+
+	req := &models.SaveAsNoteRequest{}
+	if notebookID != "" {
+		req.NotebookID = &notebookID
+	}
+	return s.repo.SaveInsightAsNote(ctx, insightID, req)
+}
+
 // Helper functions for business logic validation
 
 func isValidURL(url string) bool {
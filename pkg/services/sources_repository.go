@@ -6,16 +6,28 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"mime/multipart"
+	"net/http"
 	"net/url"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
 	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
 	"github.com/samber/do/v2"
 )
 
+const (
+	// defaultChunkSize is used for chunked uploads when no chunk size is given
+	defaultChunkSize = 8 * 1024 * 1024
+	// defaultChunkRetries is the number of retries per chunk
+	defaultChunkRetries = 3
+)
+
 type sourceRepository struct {
 	httpClient shared.HTTPClient
 	logger     shared.Logger
@@ -44,6 +56,10 @@ func (s *sourceRepository) List(ctx context.Context, limit, offset int) ([]*mode
 		return nil, fmt.Errorf("failed to list sources: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.SourcesListResponse
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse sources response: %w", err)
@@ -59,11 +75,42 @@ func (s *sourceRepository) List(ctx context.Context, limit, offset int) ([]*mode
 	return sources, nil
 }
 
+// ListByNotebook lists the sources that belong to a notebook
+func (s *sourceRepository) ListByNotebook(ctx context.Context, notebookID string, limit, offset int) ([]*models.SourceListResponse, error) {
+	queryParams := url.Values{}
+	queryParams.Set("notebook_id", notebookID)
+	queryParams.Set("limit", fmt.Sprintf("%d", limit))
+	queryParams.Set("offset", fmt.Sprintf("%d", offset))
+
+	endpoint := "/sources?" + queryParams.Encode()
+	resp, err := s.httpClient.Get(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notebook sources: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
+	var result models.SourcesListResponse
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse sources response: %w", err)
+	}
+
+	sources := make([]*models.SourceListResponse, len(result))
+	for i := range result {
+		sources[i] = &result[i]
+	}
+
+	s.logger.Info("Retrieved notebook sources", "notebook_id", notebookID, "count", len(sources))
+	return sources, nil
+}
+
 // Create implements existing SourceRepository interface
 func (s *sourceRepository) Create(ctx context.Context, source *models.SourceCreate) (*models.Source, error) {
 	// Debug: log what we're sending
 	if source.Type != "" {
-		s.logger.Info("Creating source", "type", string(source.Type), "title", *source.Title, "url", *source.URL)
+		s.logger.Info("Creating source", "type", string(source.Type), "title", utils.SafeDereferenceString(source.Title), "url", utils.SafeDereferenceString(source.URL))
 	}
 
 	// Debug: log the actual JSON being sent
@@ -78,6 +125,10 @@ func (s *sourceRepository) Create(ctx context.Context, source *models.SourceCrea
 		return nil, fmt.Errorf("failed to create source: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/sources/json")
+	}
+
 	var result models.Source
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse source response: %w", err)
@@ -97,14 +148,214 @@ func (s *sourceRepository) CreateFromJSON(ctx context.Context, source *models.So
 	return s.Create(ctx, source)
 }
 
+// namedReader attaches a file name to a reader for multipart uploads
+type namedReader struct {
+	io.Reader
+	name string
+}
+
+func (n *namedReader) Name() string {
+	return n.name
+}
+
+// Seek rewinds the upload for a retry when the underlying reader supports it
+func (n *namedReader) Seek(offset int64, whence int) (int64, error) {
+	seeker, ok := n.Reader.(io.Seeker)
+	if !ok {
+		return 0, fmt.Errorf("upload of %s cannot be rewound", n.name)
+	}
+	return seeker.Seek(offset, whence)
+}
+
+// Upload implements SourceRepository interface by streaming the file as multipart form data
+func (s *sourceRepository) Upload(ctx context.Context, filename string, file io.Reader, source *models.SourceCreate) (*models.Source, error) {
+	fields := map[string]string{
+		"type":             string(models.SourceTypeUpload),
+		"embed":            strconv.FormatBool(source.Embed),
+		"delete_source":    strconv.FormatBool(source.DeleteSource),
+		"async_processing": strconv.FormatBool(source.AsyncProcessing),
+	}
+	if source.Title != nil {
+		fields["title"] = *source.Title
+	}
+	if len(source.Notebooks) > 0 {
+		notebooks, err := json.Marshal(source.Notebooks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal notebooks: %w", err)
+		}
+		fields["notebooks"] = string(notebooks)
+	}
+	if len(source.Transformations) > 0 {
+		transformations, err := json.Marshal(source.Transformations)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal transformations: %w", err)
+		}
+		fields["transformations"] = string(transformations)
+	}
+
+	files := map[string]io.Reader{
+		"file": &namedReader{Reader: file, name: filename},
+	}
+
+	resp, err := s.httpClient.PostMultipart(ctx, "/sources", fields, files)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/sources")
+	}
+
+	var result models.Source
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse upload response: %w", err)
+	}
+
+	// Fail loud if ID is missing - API should always return an ID
+	if result.ID == nil {
+		return nil, fmt.Errorf("API error: uploaded source returned without ID")
+	}
+
+	s.logger.Info("Uploaded source file", "id", *result.ID, "file", filename)
+	return &result, nil
+}
+
+// UploadChunked implements SourceRepository interface by splitting the file into
+// chunks that are uploaded (and retried) individually before finalizing the source
+func (s *sourceRepository) UploadChunked(ctx context.Context, filename string, file io.Reader, source *models.SourceCreate, options *models.ChunkedUploadOptions) (*models.Source, error) {
+	chunkSize := options.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+	maxRetries := options.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultChunkRetries
+	}
+
+	resp, err := s.httpClient.Post(ctx, "/sources/uploads", &models.ChunkedUploadInit{
+		Filename:  filename,
+		ChunkSize: chunkSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start chunked upload: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/sources/uploads")
+	}
+
+	var session models.ChunkedUploadSession
+	if err := json.Unmarshal(resp.Body, &session); err != nil {
+		return nil, fmt.Errorf("failed to parse upload session response: %w", err)
+	}
+	if session.UploadID == "" {
+		return nil, fmt.Errorf("API error: upload session returned without ID")
+	}
+
+	s.logger.Info("Started chunked upload", "upload_id", session.UploadID, "file", filename, "chunk_size", chunkSize)
+
+	buf := make([]byte, chunkSize)
+	totalChunks := 0
+	var totalSize int64
+
+	for {
+		n, readErr := io.ReadFull(file, buf)
+		if n > 0 {
+			if err := s.uploadChunk(ctx, session.UploadID, totalChunks, buf[:n], maxRetries); err != nil {
+				return nil, err
+			}
+			totalChunks++
+			totalSize += int64(n)
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("failed to read file %s: %w", filename, readErr)
+		}
+	}
+
+	endpoint := BuildURL("/sources/uploads", session.UploadID, "complete")
+	resp, err = s.httpClient.Post(ctx, endpoint, &models.ChunkedUploadComplete{
+		TotalChunks:  totalChunks,
+		TotalSize:    totalSize,
+		SourceCreate: *source,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to finalize chunked upload: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
+	var result models.Source
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse upload response: %w", err)
+	}
+
+	// Fail loud if ID is missing - API should always return an ID
+	if result.ID == nil {
+		return nil, fmt.Errorf("API error: uploaded source returned without ID")
+	}
+
+	s.logger.Info("Completed chunked upload", "id", *result.ID, "chunks", totalChunks, "size", totalSize)
+	return &result, nil
+}
+
+// uploadChunk uploads a single chunk, retrying transient failures with exponential backoff
+func (s *sourceRepository) uploadChunk(ctx context.Context, uploadID string, index int, chunk []byte, maxRetries int) error {
+	endpoint := BuildURL("/sources/uploads", uploadID, "chunks", strconv.Itoa(index))
+	fields := map[string]string{
+		"index": strconv.Itoa(index),
+	}
+
+	var lastErr error
+	delay := time.Second
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			s.logger.Warn("Retrying chunk upload", "upload_id", uploadID, "chunk", index, "attempt", attempt, "error", lastErr)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+
+		files := map[string]io.Reader{
+			"chunk": bytes.NewReader(chunk),
+		}
+		resp, err := s.httpClient.PostMultipart(ctx, endpoint, fields, files)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if resp.StatusCode >= 500 || resp.StatusCode == 408 || resp.StatusCode == 429 {
+			lastErr = parseAPIError(resp, endpoint)
+			continue
+		}
+		if resp.StatusCode >= 400 {
+			return parseAPIError(resp, endpoint)
+		}
+
+		s.logger.Debug("Uploaded chunk", "upload_id", uploadID, "chunk", index, "size", len(chunk))
+		return nil
+	}
+
+	return fmt.Errorf("failed to upload chunk %d after %d attempts: %w", index, maxRetries+1, lastErr)
+}
+
 // Get implements existing SourceRepository interface
 func (s *sourceRepository) Get(ctx context.Context, id string) (*models.Source, error) {
-	endpoint := fmt.Sprintf("/sources/%s", id)
+	endpoint := BuildURL("/sources", id)
 	resp, err := s.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get source %s: %w", id, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.Source
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse source response: %w", err)
@@ -116,12 +367,16 @@ func (s *sourceRepository) Get(ctx context.Context, id string) (*models.Source,
 
 // Update implements existing SourceRepository interface
 func (s *sourceRepository) Update(ctx context.Context, id string, source *models.SourceUpdate) (*models.Source, error) {
-	endpoint := fmt.Sprintf("/sources/%s", id)
+	endpoint := BuildURL("/sources", id)
 	resp, err := s.httpClient.Put(ctx, endpoint, source)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update source %s: %w", id, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.Source
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse update response: %w", err)
@@ -133,24 +388,32 @@ func (s *sourceRepository) Update(ctx context.Context, id string, source *models
 
 // Delete implements existing SourceRepository interface
 func (s *sourceRepository) Delete(ctx context.Context, id string) error {
-	endpoint := fmt.Sprintf("/sources/%s", id)
-	_, err := s.httpClient.Delete(ctx, endpoint)
+	endpoint := BuildURL("/sources", id)
+	resp, err := s.httpClient.Delete(ctx, endpoint)
 	if err != nil {
 		return fmt.Errorf("failed to delete source %s: %w", id, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return parseAPIError(resp, endpoint)
+	}
+
 	s.logger.Info("Deleted source", "id", id)
 	return nil
 }
 
 // GetStatus implements existing SourceRepository interface
 func (s *sourceRepository) GetStatus(ctx context.Context, id string) (*models.SourceStatusResponse, error) {
-	endpoint := fmt.Sprintf("/sources/%s/status", id)
+	endpoint := BuildURL("/sources", id, "status")
 	resp, err := s.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get source status %s: %w", id, err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
 	var result models.SourceStatusResponse
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse status response: %w", err)
@@ -161,15 +424,34 @@ func (s *sourceRepository) GetStatus(ctx context.Context, id string) (*models.So
 }
 
 // Download implements existing SourceRepository interface
-func (s *sourceRepository) Download(ctx context.Context, id string) (io.ReadCloser, error) {
-	endpoint := fmt.Sprintf("/sources/%s/download", id)
+func (s *sourceRepository) Download(ctx context.Context, id string) (io.ReadCloser, *models.DownloadMetadata, error) {
+	endpoint := BuildURL("/sources", id, "download")
 	resp, err := s.httpClient.Get(ctx, endpoint)
 	if err != nil {
-		return nil, fmt.Errorf("failed to download source %s: %w", id, err)
+		return nil, nil, fmt.Errorf("failed to download source %s: %w", id, err)
 	}
 
-	s.logger.Info("Downloaded source file", "id", id, "size", len(resp.Body))
-	return io.NopCloser(bytes.NewReader(resp.Body)), nil
+	if resp.StatusCode >= 400 {
+		return nil, nil, parseAPIError(resp, endpoint)
+	}
+
+	metadata := &models.DownloadMetadata{
+		Size: int64(len(resp.Body)),
+	}
+	header := http.Header(resp.Header)
+	if contentType := header.Get("Content-Type"); contentType != "" {
+		if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+			metadata.ContentType = mediaType
+		}
+	}
+	if disposition := header.Get("Content-Disposition"); disposition != "" {
+		if _, params, err := mime.ParseMediaType(disposition); err == nil {
+			metadata.Filename = filepath.Base(params["filename"])
+		}
+	}
+
+	s.logger.Info("Downloaded source file", "id", id, "size", metadata.Size, "filename", metadata.Filename)
+	return io.NopCloser(bytes.NewReader(resp.Body)), metadata, nil
 }
 
 // Additional utility methods for file upload (not part of interface)
@@ -213,6 +495,10 @@ func (s *sourceRepository) CreateFromFile(ctx context.Context, filePath string,
 		return nil, fmt.Errorf("failed to upload file: %w", err)
 	}
 
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/sources")
+	}
+
 	var result models.Source
 	if err := json.Unmarshal(resp.Body, &result); err != nil {
 		return nil, fmt.Errorf("failed to parse upload response: %w", err)
@@ -226,14 +512,14 @@ func (s *sourceRepository) CreateFromFile(ctx context.Context, filePath string,
 func (r *sourceRepository) GetInsights(ctx context.Context, sourceID string) ([]*models.SourceInsightResponse, error) {
 	r.logger.Info("Getting insights for source", "source_id", sourceID)
 
-	endpoint := fmt.Sprintf("/sources/%s/insights", sourceID)
+	endpoint := BuildURL("/sources", sourceID, "insights")
 	resp, err := r.httpClient.Get(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get source insights: %w", err)
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error: %d - %s", resp.StatusCode, string(resp.Body))
+		return nil, parseAPIError(resp, endpoint)
 	}
 
 	var insights []*models.SourceInsightResponse
@@ -249,14 +535,14 @@ func (r *sourceRepository) GetInsights(ctx context.Context, sourceID string) ([]
 func (r *sourceRepository) CreateInsight(ctx context.Context, sourceID string, req *models.CreateSourceInsightRequest) (*models.SourceInsightResponse, error) {
 	r.logger.Info("Creating insight for source", "source_id", sourceID)
 
-	endpoint := fmt.Sprintf("/sources/%s/insights", sourceID)
+	endpoint := BuildURL("/sources", sourceID, "insights")
 	resp, err := r.httpClient.Post(ctx, endpoint, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create insight: %w", err)
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("API error: %d - %s", resp.StatusCode, string(resp.Body))
+		return nil, parseAPIError(resp, endpoint)
 	}
 
 	var insight models.SourceInsightResponse
@@ -267,3 +553,26 @@ func (r *sourceRepository) CreateInsight(ctx context.Context, sourceID string, r
 	r.logger.Info("Created insight", "insight_id", insight.ID)
 	return &insight, nil
 }
+
+// SaveInsightAsNote stores an insight as a note, optionally in a notebook
+func (r *sourceRepository) SaveInsightAsNote(ctx context.Context, insightID string, req *models.SaveAsNoteRequest) (*models.Note, error) {
+	r.logger.Info("Saving insight as note", "insight_id", insightID)
+
+	endpoint := BuildURL("/insights", insightID, "save-as-note")
+	resp, err := r.httpClient.Post(ctx, endpoint, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save insight as note: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
+	var note models.Note
+	if err := json.Unmarshal(resp.Body, &note); err != nil {
+		return nil, fmt.Errorf("failed to decode note response: %w", err)
+	}
+
+	r.logger.Info("Saved insight as note", "insight_id", insightID)
+	return &note, nil
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/mocks"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSourceRepository_CreateWithoutURL(t *testing.T) {
+	tests := map[string]string{
+		"--link value added with --source-type text": "https://example.com/article",
+		"--text URL kept as literal text":            "https://example.com/quoted",
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := mocks.NewMockHTTPClient()
+			client.SetJSONResponse("POST", "/sources/json", `{"id":"source:1"}`)
+
+			injector := do.New()
+			do.ProvideValue[shared.HTTPClient](injector, client)
+			do.ProvideValue[shared.Logger](injector, mocks.NewMockLogger(false))
+			repo, err := NewSourceRepository(injector)
+			require.NoError(t, err)
+
+			title := "Notes"
+			source, err := repo.Create(context.Background(), &models.SourceCreate{
+				Type:    models.SourceTypeText,
+				Title:   &title,
+				Content: &content,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "source:1", *source.ID)
+		})
+	}
+}
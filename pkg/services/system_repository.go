@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+)
+
+type systemRepository struct {
+	httpClient shared.HTTPClient
+	logger     shared.Logger
+}
+
+// NewSystemRepository creates a new system repository
+func NewSystemRepository(injector do.Injector) (shared.SystemRepository, error) {
+	httpClient := do.MustInvoke[shared.HTTPClient](injector)
+	logger := do.MustInvoke[shared.Logger](injector)
+
+	return &systemRepository{
+		httpClient: httpClient,
+		logger:     logger,
+	}, nil
+}
+
+// GetServerInfo implements SystemRepository interface
+func (s *systemRepository) GetServerInfo(ctx context.Context) (*models.ServerInfo, error) {
+	resp, err := s.httpClient.Get(ctx, "/config")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server info: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/config")
+	}
+
+	var result models.ServerInfo
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse server info response: %w", err)
+	}
+
+	s.logger.Info("Retrieved server info", "version", result.Version)
+	return &result, nil
+}
+
+// Health implements SystemRepository interface. Servers without a health
+// endpoint are probed with the cheap config endpoint instead.
+func (s *systemRepository) Health(ctx context.Context) (*models.HealthResponse, error) {
+	resp, err := s.httpClient.Get(ctx, "/health")
+	if err != nil {
+		return nil, fmt.Errorf("failed to check health: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		s.logger.Debug("Health endpoint not available, falling back to config endpoint")
+		resp, err = s.httpClient.Get(ctx, "/config")
+		if err != nil {
+			return nil, fmt.Errorf("failed to check health: %w", err)
+		}
+		if resp.StatusCode >= 400 {
+			return nil, parseAPIError(resp, "/config")
+		}
+		return &models.HealthResponse{Status: "ok"}, nil
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/health")
+	}
+
+	var result models.HealthResponse
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		// A 2xx without a JSON body still means the server is up
+		s.logger.Debug("Health response is not JSON", "error", err)
+		return &models.HealthResponse{Status: "ok"}, nil
+	}
+
+	s.logger.Info("Checked server health", "status", result.Status)
+	return &result, nil
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/config"
+)
+
+// NewTLSConfig builds the TLS settings of the HTTP transport from the
+// --insecure, --ca-cert, --client-cert and --client-key flags. A custom CA
+// is added to the system pool so public certificates keep working. Errors
+// are *FlagError naming --ca-cert or --client-cert.
+func NewTLSConfig(cfg config.Service) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: cfg.IsInsecure(),
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	if caFile := cfg.GetCACert(); caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, &FlagError{Flag: "--ca-cert", Err: fmt.Errorf("failed to read CA certificate: %w", err)}
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, &FlagError{Flag: "--ca-cert", Err: fmt.Errorf("no PEM encoded certificates found in CA certificate %s", caFile)}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	certFile, keyFile := cfg.GetClientCert(), cfg.GetClientKey()
+	if certFile != "" || keyFile != "" {
+		cert, err := loadClientCertificate(certFile, keyFile)
+		if err != nil {
+			return nil, &FlagError{Flag: "--client-cert", Err: err}
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// loadClientCertificate loads the mutual TLS key pair, reporting which file
+// is missing or whether the key does not belong to the certificate
+func loadClientCertificate(certFile, keyFile string) (tls.Certificate, error) {
+	switch {
+	case certFile == "":
+		return tls.Certificate{}, fmt.Errorf("--client-key requires --client-cert")
+	case keyFile == "":
+		return tls.Certificate{}, fmt.Errorf("--client-cert requires --client-key")
+	}
+
+	for _, file := range []string{certFile, keyFile} {
+		if _, err := os.Stat(file); err != nil {
+			return tls.Certificate{}, fmt.Errorf("failed to read client certificate: %w", err)
+		}
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("invalid client certificate %s or key %s (are they PEM encoded and does the key match the certificate?): %w",
+			certFile, keyFile, err)
+	}
+	return cert, nil
+}
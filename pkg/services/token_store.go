@@ -0,0 +1,67 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/config"
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+)
+
+const tokenFileName = "token.json"
+
+// Private file based token store implementation
+type tokenStore struct {
+	path   string
+	logger shared.Logger
+}
+
+// NewTokenStore creates a token store that keeps the token in the config dir
+func NewTokenStore(injector do.Injector) (shared.TokenStore, error) {
+	cfg := do.MustInvoke[config.Service](injector)
+	logger := do.MustInvoke[shared.Logger](injector)
+
+	return &tokenStore{
+		path:   filepath.Join(cfg.GetConfigDir(), tokenFileName),
+		logger: logger,
+	}, nil
+}
+
+// Load returns the stored token, or nil if no token has been stored
+func (s *tokenStore) Load() (*models.StoredToken, error) {
+	var token models.StoredToken
+	found, err := readJSONFile(s.path, "token", &token)
+	if !found {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// Save writes the token to disk, readable by the current user only
+func (s *tokenStore) Save(token *models.StoredToken) error {
+	if err := writeJSONFile(s.path, "token", token); err != nil {
+		return err
+	}
+
+	s.logger.Debug("Stored auth token", "path", s.path)
+	return nil
+}
+
+// Clear removes the stored token, ignoring a missing file
+func (s *tokenStore) Clear() error {
+	if err := os.Remove(s.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove token file: %w", err)
+	}
+
+	s.logger.Debug("Removed auth token", "path", s.path)
+	return nil
+}
+
+// Path returns the location of the token file
+func (s *tokenStore) Path() string {
+	return s.path
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
+)
+
+type transformationRepository struct {
+	httpClient shared.HTTPClient
+	logger     shared.Logger
+}
+
+// NewTransformationRepository creates a new transformation repository
+func NewTransformationRepository(injector do.Injector) (shared.TransformationRepository, error) {
+	httpClient := do.MustInvoke[shared.HTTPClient](injector)
+	logger := do.MustInvoke[shared.Logger](injector)
+
+	return &transformationRepository{
+		httpClient: httpClient,
+		logger:     logger,
+	}, nil
+}
+
+// List implements TransformationRepository interface
+func (t *transformationRepository) List(ctx context.Context) ([]*models.Transformation, error) {
+	resp, err := t.httpClient.Get(ctx, "/transformations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transformations: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/transformations")
+	}
+
+	var result []*models.Transformation
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse transformations response: %w", err)
+	}
+
+	t.logger.Info("Retrieved transformations", "count", len(result))
+	return result, nil
+}
+
+// Create implements TransformationRepository interface
+func (t *transformationRepository) Create(ctx context.Context, transformation *models.TransformationCreate) (*models.Transformation, error) {
+	resp, err := t.httpClient.Post(ctx, "/transformations", transformation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transformation: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/transformations")
+	}
+
+	var result models.Transformation
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse transformation response: %w", err)
+	}
+
+	t.logger.Info("Created transformation", "id", result.ID, "name", result.Name)
+	return &result, nil
+}
+
+// Get implements TransformationRepository interface
+func (t *transformationRepository) Get(ctx context.Context, id string) (*models.Transformation, error) {
+	endpoint := BuildURL("/transformations", id)
+	resp, err := t.httpClient.Get(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transformation %s: %w", id, err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
+	var result models.Transformation
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse transformation response: %w", err)
+	}
+
+	t.logger.Info("Retrieved transformation", "id", id)
+	return &result, nil
+}
+
+// Update implements TransformationRepository interface
+func (t *transformationRepository) Update(ctx context.Context, id string, transformation *models.TransformationUpdate) (*models.Transformation, error) {
+	endpoint := BuildURL("/transformations", id)
+	resp, err := t.httpClient.Put(ctx, endpoint, transformation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update transformation %s: %w", id, err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, endpoint)
+	}
+
+	var result models.Transformation
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse transformation response: %w", err)
+	}
+
+	t.logger.Info("Updated transformation", "id", id)
+	return &result, nil
+}
+
+// Delete implements TransformationRepository interface
+func (t *transformationRepository) Delete(ctx context.Context, id string) error {
+	endpoint := BuildURL("/transformations", id)
+	resp, err := t.httpClient.Delete(ctx, endpoint)
+	if err != nil {
+		return fmt.Errorf("failed to delete transformation %s: %w", id, err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return parseAPIError(resp, endpoint)
+	}
+
+	t.logger.Info("Deleted transformation", "id", id)
+	return nil
+}
+
+// Execute implements TransformationRepository interface
+func (t *transformationRepository) Execute(ctx context.Context, req *models.TransformationExecuteRequest) (*models.TransformationExecuteResponse, error) {
+	resp, err := t.httpClient.Post(ctx, "/transformations/execute", req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute transformation: %w", err)
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, parseAPIError(resp, "/transformations/execute")
+	}
+
+	var result models.TransformationExecuteResponse
+	if err := json.Unmarshal(resp.Body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse transformation execute response: %w", err)
+	}
+
+	t.logger.Info("Executed transformation", "transformation_id", req.TransformationID, "model_id", req.ModelID)
+	return &result, nil
+}
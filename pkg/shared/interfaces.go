@@ -30,6 +30,47 @@ type Auth interface {
 	IsAuthenticated(ctx context.Context) bool
 	RefreshToken(ctx context.Context) error
 	SetPassword(password string)
+	Status(ctx context.Context) (*models.AuthStatus, error)
+	TokenExpiry(ctx context.Context) time.Time
+}
+
+// TokenStore interface for persisting authentication tokens between runs
+type TokenStore interface {
+	Load() (*models.StoredToken, error)
+	Save(token *models.StoredToken) error
+	Clear() error
+	Path() string
+}
+
+// ResponseCache interface for persisting GET responses between runs
+type ResponseCache interface {
+	Get(key string) (*models.CachedResponse, error)
+	Put(key string, entry *models.CachedResponse) error
+	Clear() error
+}
+
+// CircuitBreaker interface for failing fast while the API server is down
+type CircuitBreaker interface {
+	Allow() error
+	RecordSuccess()
+	RecordFailure()
+	Status() models.CircuitStatus
+	Reset() error
+}
+
+// SessionStore interface for remembering the last chat session per notebook
+type SessionStore interface {
+	LastSession(notebookID string) (string, error)
+	SetLastSession(notebookID, sessionID string) error
+	ClearLastSession(notebookID string) error
+}
+
+// SavedSearchStore interface for named searches kept in the config dir
+type SavedSearchStore interface {
+	List() ([]models.SavedSearch, error)
+	Get(name string) (*models.SavedSearch, error)
+	Save(search models.SavedSearch) error
+	Remove(name string) (bool, error)
 }
 
 // HTTPClient interface for API communication
@@ -42,6 +83,15 @@ type HTTPClient interface {
 	Stream(ctx context.Context, endpoint string, body interface{}) (<-chan []byte, error)
 	SetAuth(token string)
 	WithTimeout(timeout time.Duration) HTTPClient
+	GetRetryConfig() models.RetryConfig
+	SetRetryConfig(config models.RetryConfig)
+}
+
+// Metrics accumulates HTTP request statistics for the current invocation
+type Metrics interface {
+	RecordRequest(latency time.Duration, sent, received int64)
+	RecordRetry()
+	Stats() models.RequestStats
 }
 
 // Repository interfaces for domain operations
@@ -77,15 +127,19 @@ type SearchRepository interface {
 // SourceRepository interface for source management
 type SourceRepository interface {
 	List(ctx context.Context, limit, offset int) ([]*models.SourceListResponse, error)
+	ListByNotebook(ctx context.Context, notebookID string, limit, offset int) ([]*models.SourceListResponse, error)
 	Create(ctx context.Context, source *models.SourceCreate) (*models.Source, error)
 	CreateFromJSON(ctx context.Context, source *models.SourceCreate) (*models.Source, error)
+	Upload(ctx context.Context, filename string, file io.Reader, source *models.SourceCreate) (*models.Source, error)
+	UploadChunked(ctx context.Context, filename string, file io.Reader, source *models.SourceCreate, options *models.ChunkedUploadOptions) (*models.Source, error)
 	Get(ctx context.Context, id string) (*models.Source, error)
 	Update(ctx context.Context, id string, source *models.SourceUpdate) (*models.Source, error)
 	Delete(ctx context.Context, id string) error
 	GetStatus(ctx context.Context, id string) (*models.SourceStatusResponse, error)
-	Download(ctx context.Context, id string) (io.ReadCloser, error)
+	Download(ctx context.Context, id string) (io.ReadCloser, *models.DownloadMetadata, error)
 	GetInsights(ctx context.Context, sourceID string) ([]*models.SourceInsightResponse, error)
 	CreateInsight(ctx context.Context, sourceID string, req *models.CreateSourceInsightRequest) (*models.SourceInsightResponse, error)
+	SaveInsightAsNote(ctx context.Context, insightID string, req *models.SaveAsNoteRequest) (*models.Note, error)
 }
 
 // ModelRepository interface for AI model management
@@ -121,6 +175,12 @@ type SettingsRepository interface {
 	Update(ctx context.Context, settings *models.SettingsUpdate) (*models.SettingsResponse, error)
 }
 
+// SystemRepository interface for server metadata
+type SystemRepository interface {
+	GetServerInfo(ctx context.Context) (*models.ServerInfo, error)
+	Health(ctx context.Context) (*models.HealthResponse, error)
+}
+
 // ContextRepository interface for context operations
 type ContextRepository interface {
 	Get(ctx context.Context, req *models.ContextRequest) (*models.ContextResponse, error)
@@ -172,6 +232,7 @@ type SearchService interface {
 type SourceService interface {
 	Repository() SourceRepository
 	List(ctx context.Context, limit, offset int) ([]*models.SourceListResponse, error)
+	ListAllByNotebook(ctx context.Context, notebookID string) ([]*models.SourceListResponse, error)
 	AddSourceFromLink(ctx context.Context, link string, options *models.SourceOptions) (*models.Source, error)
 	AddSourceFromUpload(ctx context.Context, filename string, file io.Reader, options *models.SourceOptions) (*models.Source, error)
 	AddSourceFromText(ctx context.Context, text, title string, options *models.SourceOptions) (*models.Source, error)
@@ -179,11 +240,12 @@ type SourceService interface {
 	Update(ctx context.Context, id string, title string, topics []string) (*models.Source, error)
 	Delete(ctx context.Context, id string) error
 	GetStatus(ctx context.Context, id string) (*models.SourceStatusResponse, error)
-	Download(ctx context.Context, id string) (io.ReadCloser, error)
+	Download(ctx context.Context, id string) (io.ReadCloser, *models.DownloadMetadata, error)
 	Create(ctx context.Context, source *models.SourceCreate) (*models.Source, error)
 	CreateFromJSON(ctx context.Context, source *models.SourceCreate) (*models.Source, error)
 	GetInsights(ctx context.Context, sourceID string) ([]*models.SourceInsightResponse, error)
 	CreateInsight(ctx context.Context, sourceID string, request *models.CreateSourceInsightRequest) (*models.SourceInsightResponse, error)
+	SaveInsightAsNote(ctx context.Context, insightID string, notebookID string) (*models.Note, error)
 }
 
 // ModelService interface for model business logic
@@ -274,6 +336,7 @@ type PodcastRepository interface {
 	GetEpisode(ctx context.Context, episodeID string) (*models.PodcastEpisodeResponse, error)
 	DownloadEpisodeAudio(ctx context.Context, episodeID string) (io.ReadCloser, error)
 	DeleteEpisode(ctx context.Context, episodeID string) error
+	ListVoices(ctx context.Context) (*models.PodcastVoicesResponse, error)
 }
 
 // PodcastService interface for podcast business logic
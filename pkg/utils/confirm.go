@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ErrNotInteractive is returned by Confirm when an answer is required but
+// stdin is not a terminal
+var ErrNotInteractive = errors.New("confirmation required but stdin is not interactive")
+
+// stdin is shared by all prompts so that input buffered while reading one
+// answer is not lost to the next
+var stdin = bufio.NewReader(os.Stdin)
+
+// ReadLine reads one line from stdin with surrounding whitespace removed
+func ReadLine() (string, error) {
+	line, err := stdin.ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// Confirm asks a yes/no question on stdin and reports the answer. The prompt
+// is followed by [Y/n] or [y/N] depending on defaultYes. Answers are case
+// insensitive: y or yes, n or no, and an empty answer selects the default.
+// Anything else asks again.
+//
+// When stdin is not a terminal, for example in CI, nothing is read: a
+// default of yes is returned as is, while a default of no returns
+// ErrNotInteractive so that destructive commands fail loudly instead of
+// silently doing nothing. Such commands should offer --force instead.
+func Confirm(prompt string, defaultYes bool) (bool, error) {
+	if !IsTerminal(os.Stdin) {
+		if defaultYes {
+			return true, nil
+		}
+		return false, ErrNotInteractive
+	}
+	StopPager()
+
+	hint := "[y/N]"
+	if defaultYes {
+		hint = "[Y/n]"
+	}
+
+	for {
+		fmt.Printf("%s %s: ", prompt, hint)
+		response, err := ReadLine()
+		if err != nil {
+			fmt.Println()
+			return defaultYes, nil
+		}
+
+		switch strings.ToLower(response) {
+		case "":
+			return defaultYes, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		fmt.Println("Please answer y (yes) or n (no).")
+	}
+}
@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateFormatExample lists the date formats ParseDate accepts, for help and
+// error messages
+const DateFormatExample = "2024-01-31, '2024-01-31 15:04', 2024-01-31T15:04:05Z, today, yesterday or an age like 12h, 7d, 2w"
+
+// timestampLayouts are the absolute layouts understood by ParseTimestamp and
+// ParseDate, most specific first
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006/01/02",
+}
+
+// dateOnlyLayouts are the layouts that name a whole day
+var dateOnlyLayouts = map[string]bool{
+	"2006-01-02": true,
+	"2006/01/02": true,
+}
+
+// ParseTimestamp parses a timestamp as returned by the API. Timestamps
+// without a zone are taken as UTC.
+func ParseTimestamp(timestamp string) (time.Time, bool) {
+	timestamp = strings.TrimSpace(timestamp)
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, timestamp); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// ParseDate parses a user supplied date: an absolute date or time, "today",
+// "yesterday", or an age such as 12h, 7d or 2w counted back from now.
+// Absolute dates without a time and the day keywords are interpreted in the
+// local time zone; with endOfDay set they resolve to the end of that day so
+// an upper bound like --until 2024-01-31 includes the whole day.
+func ParseDate(value string, endOfDay bool) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	now := time.Now()
+
+	day := func(t time.Time) time.Time {
+		start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+		if endOfDay {
+			return start.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		}
+		return start
+	}
+
+	switch strings.ToLower(value) {
+	case "":
+		return time.Time{}, fmt.Errorf("empty date")
+	case "now":
+		return now, nil
+	case "today":
+		return day(now), nil
+	case "yesterday":
+		return day(now.AddDate(0, 0, -1)), nil
+	}
+
+	if age, ok := parseAge(value); ok {
+		return now.Add(-age), nil
+	}
+
+	for _, layout := range timestampLayouts {
+		if dateOnlyLayouts[layout] {
+			if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+				return day(t), nil
+			}
+			continue
+		}
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized date %q", value)
+}
+
+// parseAge parses a relative age such as 30m, 12h, 7d or 2w
+func parseAge(value string) (time.Duration, bool) {
+	if len(value) < 2 {
+		return 0, false
+	}
+	units := map[byte]time.Duration{
+		'm': time.Minute,
+		'h': time.Hour,
+		'd': 24 * time.Hour,
+		'w': 7 * 24 * time.Hour,
+	}
+	unit, ok := units[value[len(value)-1]]
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(value[:len(value)-1])
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return time.Duration(n) * unit, true
+}
+
+// RelativeTimeThreshold is the age beyond which FormatRelativeTime falls
+// back to an absolute timestamp
+const RelativeTimeThreshold = 30 * 24 * time.Hour
+
+// FormatRelativeTime renders t as its age relative to now, e.g. "just now",
+// "5m ago", "2h ago" or "3d ago". Times older than RelativeTimeThreshold or
+// in the future are shown as absolute timestamps.
+func FormatRelativeTime(t, now time.Time) string {
+	age := now.Sub(t)
+	switch {
+	case age < -time.Minute || age > RelativeTimeThreshold:
+		return t.Format("2006-01-02 15:04:05")
+	case age < time.Minute:
+		return "just now"
+	case age < time.Hour:
+		return fmt.Sprintf("%dm ago", int(age/time.Minute))
+	case age < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(age/time.Hour))
+	default:
+		return fmt.Sprintf("%dd ago", int(age/(24*time.Hour)))
+	}
+}
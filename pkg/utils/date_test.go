@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDate(t *testing.T) {
+	endOfJan31 := time.Date(2024, 1, 31, 23, 59, 59, int(time.Second-time.Nanosecond), time.Local)
+
+	tests := map[string]struct {
+		value    string
+		endOfDay bool
+		want     time.Time
+	}{
+		"date":                     {"2024-01-31", false, time.Date(2024, 1, 31, 0, 0, 0, 0, time.Local)},
+		"date, end of day":         {"2024-01-31", true, endOfJan31},
+		"slashed date":             {"2024/01/31", false, time.Date(2024, 1, 31, 0, 0, 0, 0, time.Local)},
+		"slashed date, end of day": {"2024/01/31", true, endOfJan31},
+		"date and minutes":         {"2024-01-31 15:04", false, time.Date(2024, 1, 31, 15, 4, 0, 0, time.UTC)},
+		"date and minutes with T":  {"2024-01-31T15:04", true, time.Date(2024, 1, 31, 15, 4, 0, 0, time.UTC)},
+		"RFC 3339":                 {"2024-01-31T15:04:05Z", false, time.Date(2024, 1, 31, 15, 4, 5, 0, time.UTC)},
+		"RFC 3339 with offset":     {"2024-01-31T15:04:05+02:00", false, time.Date(2024, 1, 31, 13, 4, 5, 0, time.UTC)},
+		"fractional seconds":       {"2024-01-31 15:04:05.5", false, time.Date(2024, 1, 31, 15, 4, 5, 5e8, time.UTC)},
+		"surrounding whitespace":   {"  2024-01-31\n", false, time.Date(2024, 1, 31, 0, 0, 0, 0, time.Local)},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseDate(tt.value, tt.endOfDay)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+		})
+	}
+}
+
+func TestParseDate_Relative(t *testing.T) {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+
+	tests := map[string]struct {
+		value    string
+		endOfDay bool
+		want     time.Time
+	}{
+		"today":                {"today", false, today},
+		"today, end of day":    {"Today", true, today.AddDate(0, 0, 1).Add(-time.Nanosecond)},
+		"yesterday":            {"yesterday", false, today.AddDate(0, 0, -1)},
+		"yesterday end of day": {"yesterday", true, today.Add(-time.Nanosecond)},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseDate(tt.value, tt.endOfDay)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+		})
+	}
+}
+
+func TestParseDate_Age(t *testing.T) {
+	tests := map[string]time.Duration{
+		"now": 0,
+		"30m": 30 * time.Minute,
+		"12h": 12 * time.Hour,
+		"7d":  7 * 24 * time.Hour,
+		"2w":  14 * 24 * time.Hour,
+		"0d":  0,
+	}
+	for value, age := range tests {
+		t.Run(value, func(t *testing.T) {
+			before := time.Now()
+			got, err := ParseDate(value, true)
+			after := time.Now()
+			require.NoError(t, err)
+			assert.False(t, got.Before(before.Add(-age)), "got %s", got)
+			assert.False(t, got.After(after.Add(-age)), "got %s", got)
+		})
+	}
+}
+
+func TestParseDate_RejectsInvalidDates(t *testing.T) {
+	for _, value := range []string{"", "   ", "tomorrow", "2024-13-01", "2024-02-30", "31.01.2024", "7", "d", "-3d", "3y", "1.5h"} {
+		_, err := ParseDate(value, false)
+		assert.Error(t, err, value)
+	}
+}
@@ -0,0 +1,298 @@
+// Package diff compares texts line by line and formats the changes as a
+// unified or side-by-side diff. Lines are matched with the Myers algorithm,
+// so the changes shown are a shortest edit script.
+package diff
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Op is the kind of change of a line
+type Op int
+
+const (
+	Equal  Op = iota // line is in both texts
+	Delete           // line is only in the old text
+	Insert           // line is only in the new text
+)
+
+// Line is one line of a diff
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// Hunk is a run of changes with the unchanged lines around them. OldStart and
+// NewStart are 1-based line numbers of the first line in each text.
+type Hunk struct {
+	OldStart, OldLines int
+	NewStart, NewLines int
+	Lines              []Line
+}
+
+// SplitLines splits text into lines, ignoring a final line break
+func SplitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// Lines returns the lines of a and b in order, marking each as kept, deleted
+// from a or inserted from b
+func Lines(a, b []string) []Line {
+	// Common prefix and suffix are kept as is and left out of the search
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	lines := make([]Line, 0, len(a)+len(b)-prefix-suffix)
+	for _, text := range a[:prefix] {
+		lines = append(lines, Line{Op: Equal, Text: text})
+	}
+	lines = append(lines, shortestEdit(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, text := range a[len(a)-suffix:] {
+		lines = append(lines, Line{Op: Equal, Text: text})
+	}
+	return lines
+}
+
+// shortestEdit finds a shortest edit script from a to b. For every edit
+// distance d it keeps the furthest reaching x of each diagonal k = x - y in
+// the range -d..d, which is then walked back to recover the edits.
+func shortestEdit(a, b []string) []Line {
+	n, m := len(a), len(b)
+	if n+m == 0 {
+		return nil
+	}
+
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	var trace [][]int
+	for d := 0; d <= n+m; d++ {
+		// trace[d] holds the diagonals -d..d reached with d-1 edits
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1] // insertion, moving down from diagonal k+1
+			} else {
+				x = v[offset+k-1] + 1 // deletion, moving right from diagonal k-1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				return backtrack(trace, a, b)
+			}
+		}
+	}
+	return nil // not reached: n+m edits always suffice
+}
+
+// backtrack recovers the edits of shortestEdit from its trace
+func backtrack(trace [][]int, a, b []string) []Line {
+	x, y := len(a), len(b)
+	var reversed []Line
+	for d := len(trace) - 1; d > 0; d-- {
+		v := trace[d]
+		at := func(k int) int { return v[k+d] }
+
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			reversed = append(reversed, Line{Op: Equal, Text: a[x-1]})
+			x--
+			y--
+		}
+		if x == prevX {
+			reversed = append(reversed, Line{Op: Insert, Text: b[y-1]})
+			y--
+		} else {
+			reversed = append(reversed, Line{Op: Delete, Text: a[x-1]})
+			x--
+		}
+	}
+	for x > 0 && y > 0 {
+		reversed = append(reversed, Line{Op: Equal, Text: a[x-1]})
+		x--
+		y--
+	}
+
+	lines := make([]Line, len(reversed))
+	for i, line := range reversed {
+		lines[len(reversed)-1-i] = line
+	}
+	return lines
+}
+
+// Hunks groups the changes of lines into hunks with up to context unchanged
+// lines before and after each change. Changes closer than twice the context
+// share a hunk. There are no hunks when nothing changed.
+func Hunks(lines []Line, context int) []Hunk {
+	if context < 0 {
+		context = 0
+	}
+
+	var hunks []Hunk
+	oldLine, newLine := 1, 1
+	start, end := -1, -1 // current hunk as a range of lines
+	oldStart, newStart := 0, 0
+
+	flush := func() {
+		if start < 0 {
+			return
+		}
+		hunk := Hunk{OldStart: oldStart, NewStart: newStart, Lines: lines[start:end]}
+		for _, line := range hunk.Lines {
+			if line.Op != Insert {
+				hunk.OldLines++
+			}
+			if line.Op != Delete {
+				hunk.NewLines++
+			}
+		}
+		hunks = append(hunks, hunk)
+		start = -1
+	}
+
+	for i, line := range lines {
+		if line.Op != Equal {
+			if start >= 0 && i > end+context {
+				flush()
+			}
+			if start < 0 {
+				// Open a hunk including the preceding context lines
+				start = max(0, i-context)
+				oldStart, newStart = oldLine-(i-start), newLine-(i-start)
+			}
+			end = min(len(lines), i+1+context)
+		}
+
+		if line.Op != Insert {
+			oldLine++
+		}
+		if line.Op != Delete {
+			newLine++
+		}
+	}
+	flush()
+	return hunks
+}
+
+// Unified formats the changes from oldName to newName in unified diff format
+func Unified(oldName, newName string, lines []Line, context int) string {
+	hunks := Hunks(lines, context)
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldName, newName)
+	for _, hunk := range hunks {
+		b.WriteString(hunk.Header())
+		b.WriteByte('\n')
+		for _, line := range hunk.Lines {
+			b.WriteString(prefixes[line.Op])
+			b.WriteString(line.Text)
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// prefixes mark the lines of a unified diff
+var prefixes = map[Op]string{Equal: " ", Delete: "-", Insert: "+"}
+
+// Header returns the "@@ -l,s +l,s @@" line of the hunk. An empty range
+// starts at the line before it, as in diff(1).
+func (h Hunk) Header() string {
+	oldStart, newStart := h.OldStart, h.NewStart
+	if h.OldLines == 0 {
+		oldStart--
+	}
+	if h.NewLines == 0 {
+		newStart--
+	}
+	return fmt.Sprintf("@@ -%d,%d +%d,%d @@", oldStart, h.OldLines, newStart, h.NewLines)
+}
+
+// SideBySide formats the hunks of lines in two columns of width runes each.
+// The gutter between them marks changed lines with |, deleted lines with <
+// and inserted lines with >.
+func SideBySide(lines []Line, context, width int) string {
+	var b strings.Builder
+	for _, hunk := range Hunks(lines, context) {
+		b.WriteString(hunk.Header())
+		b.WriteByte('\n')
+
+		for i := 0; i < len(hunk.Lines); {
+			if hunk.Lines[i].Op == Equal {
+				writeRow(&b, hunk.Lines[i].Text, " ", hunk.Lines[i].Text, width)
+				i++
+				continue
+			}
+
+			// Pair the deleted and inserted lines of a change block
+			var deleted, inserted []string
+			for ; i < len(hunk.Lines) && hunk.Lines[i].Op != Equal; i++ {
+				if hunk.Lines[i].Op == Delete {
+					deleted = append(deleted, hunk.Lines[i].Text)
+				} else {
+					inserted = append(inserted, hunk.Lines[i].Text)
+				}
+			}
+			for j := 0; j < max(len(deleted), len(inserted)); j++ {
+				switch {
+				case j >= len(deleted):
+					writeRow(&b, "", ">", inserted[j], width)
+				case j >= len(inserted):
+					writeRow(&b, deleted[j], "<", "", width)
+				default:
+					writeRow(&b, deleted[j], "|", inserted[j], width)
+				}
+			}
+		}
+	}
+	return b.String()
+}
+
+// writeRow writes one side-by-side row, fitting both texts to width
+func writeRow(b *strings.Builder, left, marker, right string, width int) {
+	left = fit(left, width)
+	row := left + strings.Repeat(" ", width-utf8.RuneCountInString(left)) +
+		" " + marker + " " + fit(right, width)
+	b.WriteString(strings.TrimRight(row, " "))
+	b.WriteByte('\n')
+}
+
+// fit expands tabs and truncates text to width runes, marking the cut with
+// an ellipsis
+func fit(text string, width int) string {
+	text = strings.ReplaceAll(text, "\t", "    ")
+	if utf8.RuneCountInString(text) <= width {
+		return text
+	}
+	if width <= 1 {
+		return string([]rune(text)[:max(width, 0)])
+	}
+	return string([]rune(text)[:width-1]) + "…"
+}
@@ -0,0 +1,103 @@
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// apply rebuilds both texts from a diff
+func apply(lines []Line) (old, new []string) {
+	for _, line := range lines {
+		if line.Op != Insert {
+			old = append(old, line.Text)
+		}
+		if line.Op != Delete {
+			new = append(new, line.Text)
+		}
+	}
+	return old, new
+}
+
+func TestLines_ShortestEdit(t *testing.T) {
+	tests := map[string]struct {
+		a, b  string
+		edits int
+	}{
+		"identical":   {"a\nb\nc", "a\nb\nc", 0},
+		"both empty":  {"", "", 0},
+		"from empty":  {"", "a\nb", 2},
+		"to empty":    {"a\nb", "", 2},
+		"replace":     {"a\nb\nc", "a\nx\nc", 2},
+		"insert":      {"a\nc", "a\nb\nc", 1},
+		"classic":     {"a\nb\nc\na\nb\nb\na", "c\nb\na\nb\na\nc", 5},
+		"reorder":     {"1\n2\n3\n4", "4\n1\n2\n3", 2},
+		"all changed": {"a\nb", "c\nd\ne", 5},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			a, b := SplitLines(tt.a), SplitLines(tt.b)
+			lines := Lines(a, b)
+
+			old, new := apply(lines)
+			assert.Equal(t, a, old)
+			assert.Equal(t, b, new)
+
+			edits := 0
+			for _, line := range lines {
+				if line.Op != Equal {
+					edits++
+				}
+			}
+			assert.Equal(t, tt.edits, edits)
+		})
+	}
+}
+
+func TestUnified(t *testing.T) {
+	a := SplitLines("1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n")
+	b := SplitLines("1\n2\nthree\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n")
+
+	assert.Equal(t, strings.Join([]string{
+		"--- old",
+		"+++ new",
+		"@@ -1,6 +1,6 @@",
+		" 1",
+		" 2",
+		"-3",
+		"+three",
+		" 4",
+		" 5",
+		" 6",
+		"@@ -10,3 +10,4 @@",
+		" 10",
+		" 11",
+		" 12",
+		"+13",
+		"",
+	}, "\n"), Unified("old", "new", Lines(a, b), 3))
+
+	// Changes closer than twice the context share a hunk
+	hunks := Hunks(Lines(a, b), 5)
+	assert.Len(t, hunks, 1)
+
+	assert.Empty(t, Unified("old", "new", Lines(a, a), 3))
+	assert.Equal(t, "@@ -0,0 +1,2 @@", Hunks(Lines(nil, []string{"x", "y"}), 3)[0].Header())
+}
+
+func TestSideBySide(t *testing.T) {
+	a := []string{"same", "old line", "gone"}
+	b := []string{"same", "new line", "added", "more"}
+
+	assert.Equal(t, strings.Join([]string{
+		"@@ -1,3 +1,4 @@",
+		"same       same",
+		"old line | new line",
+		"gone     | added",
+		"         > more",
+		"",
+	}, "\n"), SideBySide(Lines(a, b), 3, 8))
+
+	assert.Equal(t, "a very… | x\n", SideBySide([]Line{{Op: Delete, Text: "a very long line"}, {Op: Insert, Text: "x"}}, 0, 7)[len("@@ -1,1 +1,1 @@\n"):])
+}
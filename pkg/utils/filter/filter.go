@@ -0,0 +1,371 @@
+// Package filter implements the small expression language behind the
+// --filter flag of list commands, for example:
+//
+//	embedded==true && embedded_chunks>10
+//	status==failed || title!="Untitled"
+//
+// The left side of a comparison names a JSON field of the item (nested
+// fields use dots, e.g. asset.url); the right side is a literal. Supported
+// operators are ==, !=, >, <, >=, <=, && and ||, with && binding tighter
+// than ||. Parentheses group sub-expressions.
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Filter is a parsed filter expression
+type Filter struct {
+	source string
+	root   node
+}
+
+// Parse compiles a filter expression
+func Parse(expr string) (*Filter, error) {
+	tokens, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, syntaxError(tok, "expected && or ||")
+	}
+
+	return &Filter{source: expr, root: root}, nil
+}
+
+// String returns the original expression
+func (f *Filter) String() string {
+	return f.source
+}
+
+// Match reports whether item satisfies the filter. The item is compared by
+// its JSON representation, so field names are the json tag names.
+func (f *Filter) Match(item any) (bool, error) {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return false, fmt.Errorf("failed to encode item for filtering: %w", err)
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return false, fmt.Errorf("filter requires object items: %w", err)
+	}
+
+	return f.root.eval(fields), nil
+}
+
+// Apply returns the items that satisfy the filter, preserving their order
+func Apply[T any](f *Filter, items []T) ([]T, error) {
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		ok, err := f.Match(item)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+// SyntaxError describes a parse failure at a specific token
+type SyntaxError struct {
+	Pos   int    // byte offset of the offending token
+	Token string // offending token text, empty at end of input
+	Msg   string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Token == "" {
+		return fmt.Sprintf("%s at end of expression", e.Msg)
+	}
+	return fmt.Sprintf("%s at position %d near %q", e.Msg, e.Pos+1, e.Token)
+}
+
+func syntaxError(tok token, msg string) error {
+	return &SyntaxError{Pos: tok.pos, Token: tok.text, Msg: msg}
+}
+
+// Tokens
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOp
+	tokenAnd
+	tokenOr
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// isWordChar reports whether r may appear in a field name or bare literal
+func isWordChar(r byte) bool {
+	return r == '_' || r == '.' || r == '-' || r == ':' || r == '+' || r == '/' ||
+		(r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+func lex(src string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{tokenLParen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{tokenRParen, ")", i})
+			i++
+		case strings.HasPrefix(src[i:], "&&"):
+			tokens = append(tokens, token{tokenAnd, "&&", i})
+			i += 2
+		case strings.HasPrefix(src[i:], "||"):
+			tokens = append(tokens, token{tokenOr, "||", i})
+			i += 2
+		case strings.HasPrefix(src[i:], "=="), strings.HasPrefix(src[i:], "!="),
+			strings.HasPrefix(src[i:], ">="), strings.HasPrefix(src[i:], "<="):
+			tokens = append(tokens, token{tokenOp, src[i : i+2], i})
+			i += 2
+		case c == '>' || c == '<':
+			tokens = append(tokens, token{tokenOp, src[i : i+1], i})
+			i++
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(src[i+1:], c)
+			if end < 0 {
+				return nil, &SyntaxError{Pos: i, Token: src[i:], Msg: "unterminated string"}
+			}
+			tokens = append(tokens, token{tokenString, src[i+1 : i+1+end], i})
+			i += end + 2
+		case isWordChar(c):
+			start := i
+			for i < len(src) && isWordChar(src[i]) {
+				i++
+			}
+			tokens = append(tokens, token{tokenWord, src[start:i], start})
+		default:
+			return nil, &SyntaxError{Pos: i, Token: string(c), Msg: "unexpected character"}
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(src)}), nil
+}
+
+// Parser
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// parseOr parses: and ( "||" and )*
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left, right}
+	}
+	return left, nil
+}
+
+// parseAnd parses: term ( "&&" term )*
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left, right}
+	}
+	return left, nil
+}
+
+// parseTerm parses: "(" or ")" | field op literal
+func (p *parser) parseTerm() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenLParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, syntaxError(closing, "expected )")
+		}
+		return inner, nil
+	case tokenWord:
+	default:
+		return nil, syntaxError(tok, "expected field name")
+	}
+
+	op := p.next()
+	if op.kind != tokenOp {
+		return nil, syntaxError(op, "expected comparison operator (==, !=, >, <, >=, <=)")
+	}
+
+	lit := p.next()
+	var value literal
+	switch lit.kind {
+	case tokenString:
+		value = literal{text: lit.text, value: lit.text}
+	case tokenWord:
+		value = parseLiteral(lit.text)
+	default:
+		return nil, syntaxError(lit, "expected value")
+	}
+
+	return compareNode{path: strings.Split(tok.text, "."), op: op.text, lit: value}, nil
+}
+
+// Evaluation
+
+type node interface {
+	eval(fields map[string]any) bool
+}
+
+type andNode struct{ left, right node }
+
+func (n andNode) eval(fields map[string]any) bool {
+	return n.left.eval(fields) && n.right.eval(fields)
+}
+
+type orNode struct{ left, right node }
+
+func (n orNode) eval(fields map[string]any) bool {
+	return n.left.eval(fields) || n.right.eval(fields)
+}
+
+// literal is a right-hand value with its source text kept for string
+// comparisons against non-string fields
+type literal struct {
+	text  string
+	value any // string, float64, bool or nil
+}
+
+func parseLiteral(text string) literal {
+	switch text {
+	case "true":
+		return literal{text: text, value: true}
+	case "false":
+		return literal{text: text, value: false}
+	case "null":
+		return literal{text: text, value: nil}
+	}
+	if n, err := strconv.ParseFloat(text, 64); err == nil {
+		return literal{text: text, value: n}
+	}
+	return literal{text: text, value: text}
+}
+
+type compareNode struct {
+	path []string
+	op   string
+	lit  literal
+}
+
+func (n compareNode) eval(fields map[string]any) bool {
+	var current any = fields
+	for _, key := range n.path {
+		obj, ok := current.(map[string]any)
+		if !ok {
+			current = nil
+			break
+		}
+		current = obj[key]
+	}
+
+	cmp, ok := compare(current, n.lit)
+	switch n.op {
+	case "==":
+		return ok && cmp == 0
+	case "!=":
+		return !ok || cmp != 0
+	case ">":
+		return ok && cmp > 0
+	case "<":
+		return ok && cmp < 0
+	case ">=":
+		return ok && cmp >= 0
+	case "<=":
+		return ok && cmp <= 0
+	}
+	return false
+}
+
+// compare orders a field value against a literal. ok is false when the two
+// cannot be compared (for example a number against a word).
+func compare(field any, lit literal) (cmp int, ok bool) {
+	switch v := field.(type) {
+	case nil:
+		if lit.value == nil {
+			return 0, true
+		}
+		return 0, false
+	case bool:
+		b, isBool := lit.value.(bool)
+		if !isBool {
+			return 0, false
+		}
+		if v == b {
+			return 0, true
+		}
+		return 1, true
+	case float64:
+		n, isNum := lit.value.(float64)
+		if !isNum {
+			return 0, false
+		}
+		switch {
+		case v < n:
+			return -1, true
+		case v > n:
+			return 1, true
+		}
+		return 0, true
+	case string:
+		return strings.Compare(v, lit.text), true
+	}
+	return 0, false
+}
@@ -0,0 +1,111 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testAsset struct {
+	URL *string `json:"url"`
+}
+
+type testItem struct {
+	ID             string     `json:"id"`
+	Title          *string    `json:"title"`
+	Embedded       bool       `json:"embedded"`
+	EmbeddedChunks int        `json:"embedded_chunks"`
+	Status         string     `json:"status"`
+	Asset          *testAsset `json:"asset"`
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestFilter_Match(t *testing.T) {
+	item := testItem{
+		ID:             "source:1",
+		Title:          strPtr("Go Memory Model"),
+		Embedded:       true,
+		EmbeddedChunks: 12,
+		Status:         "completed",
+		Asset:          &testAsset{URL: strPtr("https://go.dev/ref/mem")},
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"embedded==true", true},
+		{"embedded!=true", false},
+		{"embedded==true && embedded_chunks>10", true},
+		{"embedded==true && embedded_chunks>12", false},
+		{"embedded_chunks>=12 && embedded_chunks<=12", true},
+		{"embedded_chunks<5 || status==completed", true},
+		{"status==failed || status==running", false},
+		{"status==failed || status==running && embedded==true", false},
+		{"(status==failed || status==completed) && embedded==true", true},
+		{`title=="Go Memory Model"`, true},
+		{"title!='Untitled'", true},
+		{"asset.url==https://go.dev/ref/mem", true},
+		{"missing==null", true},
+		{"missing!=1", true},
+		{"missing>1", false},
+		{"embedded_chunks==abc", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			f, err := Parse(tt.expr)
+			require.NoError(t, err)
+			got, err := f.Match(item)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFilter_Apply(t *testing.T) {
+	items := []testItem{
+		{ID: "a", EmbeddedChunks: 1},
+		{ID: "b", EmbeddedChunks: 20},
+		{ID: "c", EmbeddedChunks: 30},
+	}
+
+	f, err := Parse("embedded_chunks>10")
+	require.NoError(t, err)
+
+	got, err := Apply(f, items)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "b", got[0].ID)
+	assert.Equal(t, "c", got[1].ID)
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		expr  string
+		token string
+	}{
+		{"embedded=true", "="},
+		{"embedded==", ""},
+		{"embedded true", "true"},
+		{"== true", "=="},
+		{"embedded==true &&", ""},
+		{"embedded==true status==x", "status"},
+		{"(embedded==true", ""},
+		{"title=='open", "'open"},
+		{"embedded==true & x==1", "&"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := Parse(tt.expr)
+			require.Error(t, err)
+
+			var syntaxErr *SyntaxError
+			require.ErrorAs(t, err, &syntaxErr)
+			assert.Equal(t, tt.token, syntaxErr.Token)
+		})
+	}
+}
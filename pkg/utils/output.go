@@ -0,0 +1,55 @@
+package utils
+
+import "fmt"
+
+// Output settings shared by all command handlers. They are configured once
+// at startup from the global flags.
+var (
+	quietOutput        bool
+	quietSink          func(msg string, fields ...interface{})
+	relativeTimestamps bool
+)
+
+// ConfigureOutput enables or disables quiet mode. While quiet, status
+// messages are passed to sink (typically the debug logger) instead of stdout.
+func ConfigureOutput(quiet bool, sink func(msg string, fields ...interface{})) {
+	quietOutput = quiet
+	quietSink = sink
+}
+
+// ConfigureTimestamps selects whether FormatTimestamp renders recent
+// timestamps relative to now ("2h ago") instead of absolute.
+func ConfigureTimestamps(relative bool) {
+	relativeTimestamps = relative
+}
+
+// IsQuiet reports whether informational output is suppressed.
+func IsQuiet() bool {
+	return quietOutput
+}
+
+// Status prints an informational status line, like fmt.Println. It is
+// suppressed in quiet mode; rendered data and errors should use fmt directly.
+func Status(a ...interface{}) {
+	if quietOutput {
+		suppress(fmt.Sprint(a...))
+		return
+	}
+	fmt.Println(a...)
+}
+
+// Statusf prints an informational status message, like fmt.Printf. It is
+// suppressed in quiet mode.
+func Statusf(format string, a ...interface{}) {
+	if quietOutput {
+		suppress(fmt.Sprintf(format, a...))
+		return
+	}
+	fmt.Printf(format, a...)
+}
+
+func suppress(msg string) {
+	if quietSink != nil {
+		quietSink("Suppressed output", "message", msg)
+	}
+}
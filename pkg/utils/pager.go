@@ -0,0 +1,166 @@
+package utils
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// defaultPager is used when $PAGER is not set. -F exits at once when the
+// output fits on one screen, -R keeps colors and -X leaves the output on
+// screen after quitting.
+const defaultPager = "less -FRX"
+
+// pagerFlushDelay is how long output may be held back while deciding whether
+// to page it. Output produced in one burst, like a rendered list, is paged
+// when it is taller than the terminal; output that trickles in, like prompts,
+// progress or streamed answers, is passed to the terminal after this delay.
+const pagerFlushDelay = 200 * time.Millisecond
+
+// activePager is the pager installed by StartPager, nil when output is not paged
+var activePager *pager
+
+type pager struct {
+	terminal *os.File // stdout before it was replaced
+	pipe     *os.File // write end installed as os.Stdout
+	command  []string
+	height   int
+	done     chan struct{}
+}
+
+// StartPager redirects os.Stdout so that output taller than the terminal is
+// shown through $PAGER (default "less -FRX"). It does nothing when stdout is
+// not a terminal or its height is unknown. StopPager must be called before
+// the program exits, and before output that redraws, polls or prompts.
+func StartPager() {
+	if activePager != nil || !IsTerminal(os.Stdout) {
+		return
+	}
+	_, height, ok := TerminalSize(os.Stdout)
+	if !ok {
+		return
+	}
+
+	command := strings.Fields(os.Getenv("PAGER"))
+	if len(command) == 0 {
+		command = strings.Fields(defaultPager)
+	}
+	if command[0] == "cat" {
+		return
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		return
+	}
+
+	activePager = &pager{
+		terminal: os.Stdout,
+		pipe:     w,
+		command:  command,
+		height:   height,
+		done:     make(chan struct{}),
+	}
+	os.Stdout = w
+	go activePager.run(r)
+}
+
+// StopPager flushes pending output, waits until the user quits the pager if
+// one was started, and restores os.Stdout
+func StopPager() {
+	p := activePager
+	if p == nil {
+		return
+	}
+	activePager = nil
+
+	os.Stdout = p.terminal
+	p.pipe.Close()
+	<-p.done
+}
+
+// realFile returns the terminal behind f while f is the pager pipe
+func realFile(f *os.File) *os.File {
+	if p := activePager; p != nil && f == p.pipe {
+		return p.terminal
+	}
+	return f
+}
+
+// run holds back output until it is known whether it needs paging
+func (p *pager) run(r *os.File) {
+	defer close(p.done)
+
+	chunks := make(chan []byte)
+	go func() {
+		defer close(chunks)
+		defer r.Close()
+		buf := make([]byte, 32<<10)
+		for {
+			n, err := r.Read(buf)
+			if n > 0 {
+				chunks <- bytes.Clone(buf[:n])
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	var pending bytes.Buffer
+	var flush <-chan time.Time
+	for {
+		select {
+		case chunk, ok := <-chunks:
+			if !ok {
+				p.terminal.Write(pending.Bytes())
+				return
+			}
+			if pending.Len() == 0 {
+				flush = time.After(pagerFlushDelay)
+			}
+			pending.Write(chunk)
+			if bytes.Count(pending.Bytes(), []byte("\n")) >= p.height-1 {
+				p.page(pending.Bytes(), chunks)
+				return
+			}
+		case <-flush:
+			p.terminal.Write(pending.Bytes())
+			copyChunks(p.terminal, chunks)
+			return
+		}
+	}
+}
+
+// page shows head and the remaining output through the pager command,
+// falling back to the terminal when the pager cannot be started
+func (p *pager) page(head []byte, chunks <-chan []byte) {
+	cmd := exec.Command(p.command[0], p.command[1:]...)
+	cmd.Stdout = p.terminal
+	cmd.Stderr = os.Stderr
+	stdin, err := cmd.StdinPipe()
+	if err == nil {
+		err = cmd.Start()
+	}
+	if err != nil {
+		p.terminal.Write(head)
+		copyChunks(p.terminal, chunks)
+		return
+	}
+
+	stdin.Write(head)
+	copyChunks(stdin, chunks)
+	stdin.Close()
+	cmd.Wait()
+}
+
+// copyChunks writes every chunk to w. Write errors, e.g. after the user quit
+// the pager, are ignored so that the remaining output is still drained.
+func copyChunks(w io.Writer, chunks <-chan []byte) {
+	for chunk := range chunks {
+		w.Write(chunk)
+	}
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultParallelism is the default worker count for bulk operations. It
+// matches the HTTP client's MaxConnsPerHost so workers never queue for a
+// connection.
+const DefaultParallelism = 50
+
+// ForEach calls fn for every index in [0, n) using at most workers
+// concurrent goroutines and returns the error of each call by index, so
+// callers can report results in a deterministic order.
+//
+// Once ctx is done no new calls are started; the remaining indexes get
+// ctx.Err(). ForEach always waits for in-flight calls before returning.
+func ForEach(ctx context.Context, workers, n int, fn func(ctx context.Context, i int) error) []error {
+	errs := make([]error, n)
+	if workers < 1 {
+		workers = 1
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < n; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				errs[i] = fn(ctx, i)
+			}
+		}()
+	}
+
+	next := 0
+dispatch:
+	for ; next < n; next++ {
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case indexes <- next:
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	for i := next; i < n; i++ {
+		errs[i] = ctx.Err()
+	}
+	return errs
+}
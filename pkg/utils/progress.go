@@ -0,0 +1,219 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// progressRefreshInterval limits how often progress lines are redrawn.
+const progressRefreshInterval = 200 * time.Millisecond
+
+// IsTerminal reports whether the given file is attached to a terminal. While
+// output is paged, os.Stdout reports the terminal it was redirected from.
+func IsTerminal(f *os.File) bool {
+	info, err := realFile(f).Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// progress holds the shared state for progress readers and writers.
+// Output is suppressed when stderr is not a terminal or in quiet mode.
+type progress struct {
+	label     string
+	total     int64
+	done      int64
+	out       io.Writer
+	enabled   bool
+	started   time.Time
+	lastPrint time.Time
+}
+
+func newProgress(total int64, label string) progress {
+	return progress{
+		label:   label,
+		total:   total,
+		out:     os.Stderr,
+		enabled: IsTerminal(os.Stderr) && !IsQuiet(),
+		started: time.Now(),
+	}
+}
+
+func (p *progress) add(n int, final bool) {
+	p.done += int64(n)
+	if final || time.Since(p.lastPrint) >= progressRefreshInterval {
+		p.print()
+	}
+}
+
+// Finish prints the final progress line and terminates it with a newline.
+func (p *progress) Finish() {
+	if !p.enabled {
+		return
+	}
+	p.print()
+	fmt.Fprintln(p.out)
+}
+
+// Transferred returns the number of bytes transferred so far.
+func (p *progress) Transferred() int64 {
+	return p.done
+}
+
+func (p *progress) print() {
+	if !p.enabled {
+		return
+	}
+	p.lastPrint = time.Now()
+
+	speed := ""
+	if elapsed := time.Since(p.started).Seconds(); elapsed > 0 {
+		speed = fmt.Sprintf(" %s/s", FormatBytes(int64(float64(p.done)/elapsed)))
+	}
+
+	if p.total > 0 {
+		percent := float64(p.done) / float64(p.total) * 100
+		fmt.Fprintf(p.out, "\r%s: %5.1f%% (%s / %s)%s ", p.label, percent, FormatBytes(p.done), FormatBytes(p.total), speed)
+		return
+	}
+	fmt.Fprintf(p.out, "\r%s: %s%s ", p.label, FormatBytes(p.done), speed)
+}
+
+// ProgressReader wraps an io.Reader and prints transfer progress to stderr.
+// It is used for uploads where the total size is known in advance.
+type ProgressReader struct {
+	progress
+	reader io.Reader
+}
+
+// NewProgressReader creates a progress reader reporting against total bytes.
+func NewProgressReader(reader io.Reader, total int64, label string) *ProgressReader {
+	return &ProgressReader{
+		progress: newProgress(total, label),
+		reader:   reader,
+	}
+}
+
+// Read implements io.Reader and updates the progress display.
+func (p *ProgressReader) Read(buf []byte) (int, error) {
+	n, err := p.reader.Read(buf)
+	p.add(n, err == io.EOF)
+	return n, err
+}
+
+// ProgressWriter wraps a destination io.Writer and prints transfer progress
+// to stderr. A total of zero or less means the content length is unknown, in
+// which case only the transferred bytes are shown.
+type ProgressWriter struct {
+	progress
+	writer io.Writer
+}
+
+// NewProgressWriter creates a progress writer reporting against total bytes.
+func NewProgressWriter(writer io.Writer, total int64, label string) *ProgressWriter {
+	return &ProgressWriter{
+		progress: newProgress(total, label),
+		writer:   writer,
+	}
+}
+
+// Write implements io.Writer and updates the progress display.
+func (p *ProgressWriter) Write(buf []byte) (int, error) {
+	n, err := p.writer.Write(buf)
+	p.add(n, false)
+	return n, err
+}
+
+// FormatBytes formats a byte count in human readable units (B, KB, MB, GB).
+func FormatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit && exp < 2; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMG"[exp])
+}
+
+// spinnerFrames are the animation frames of a Spinner.
+var spinnerFrames = []string{"|", "/", "-", "\\"}
+
+// Spinner shows an animated activity indicator with a label on stderr while
+// work without a known size is in progress. Like the progress readers it
+// stays silent when not attached to a terminal or in quiet mode.
+type Spinner struct {
+	mu      sync.Mutex
+	label   string
+	width   int // longest label shown, so shorter labels overwrite it fully
+	out     io.Writer
+	enabled bool
+	stop    chan struct{}
+	done    chan struct{}
+}
+
+// NewSpinner creates and starts a spinner with the given label.
+func NewSpinner(label string) *Spinner {
+	s := &Spinner{
+		label:   label,
+		out:     os.Stderr,
+		enabled: IsTerminal(os.Stderr) && !IsQuiet(),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	if !s.enabled {
+		close(s.done)
+		return s
+	}
+
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		for frame := 0; ; frame++ {
+			s.mu.Lock()
+			s.width = max(s.width, len(s.label))
+			fmt.Fprintf(s.out, "\r%s %-*s", spinnerFrames[frame%len(spinnerFrames)], s.width, s.label)
+			s.mu.Unlock()
+
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return s
+}
+
+// SetLabel replaces the text shown next to the spinner.
+func (s *Spinner) SetLabel(label string) {
+	s.mu.Lock()
+	s.label = label
+	s.mu.Unlock()
+}
+
+// Stop halts the animation and clears the spinner line.
+func (s *Spinner) Stop() {
+	if !s.enabled {
+		return
+	}
+	select {
+	case <-s.stop:
+		return
+	default:
+		close(s.stop)
+	}
+	<-s.done
+
+	s.mu.Lock()
+	fmt.Fprintf(s.out, "\r%s\r", strings.Repeat(" ", max(s.width, len(s.label))+2))
+	s.mu.Unlock()
+}
@@ -0,0 +1,81 @@
+package render
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// writeCSV writes items as RFC 4180 CSV. The header row lists the json
+// field names of the elements, or fields when given.
+func writeCSV(w io.Writer, items any, fields []string) error {
+	if len(fields) == 0 {
+		fields = FieldNames(reflect.TypeOf(items).Elem())
+	}
+
+	projected, err := Project(items, fields)
+	if err != nil {
+		return err
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(fields); err != nil {
+		return err
+	}
+	for _, obj := range projected.([]orderedObject) {
+		record := make([]string, len(obj.values))
+		for i, value := range obj.values {
+			if record[i], err = csvCell(value); err != nil {
+				return err
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeObjectCSV writes a single object as two-column key,value CSV
+func writeObjectCSV(w io.Writer, obj orderedObject) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"key", "value"}); err != nil {
+		return err
+	}
+	for i, key := range obj.keys {
+		cell, err := csvCell(obj.values[i])
+		if err != nil {
+			return err
+		}
+		if err := cw.Write([]string{key, cell}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvCell renders a value the way it appears in JSON output, without the
+// quotes around strings. Null becomes an empty cell; arrays and objects
+// are kept as JSON text.
+func csvCell(value any) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode CSV value: %w", err)
+	}
+
+	switch {
+	case string(data) == "null":
+		return "", nil
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+	return string(data), nil
+}
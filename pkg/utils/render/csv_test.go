@@ -0,0 +1,56 @@
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type csvItem struct {
+	ID     string   `json:"id"`
+	Title  *string  `json:"title"`
+	Chunks int      `json:"chunks"`
+	Topics []string `json:"topics"`
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestListCSV(t *testing.T) {
+	items := []*csvItem{
+		{ID: "source:1", Title: strPtr("Hello, \"world\""), Chunks: 3, Topics: []string{"go"}},
+		{ID: "source:2", Title: nil, Chunks: 0},
+		{ID: "source:3", Title: strPtr("multi\nline")},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, List(&buf, Options{Format: FormatCSV}, items, nil))
+	assert.Equal(t, "id,title,chunks,topics\n"+
+		"source:1,\"Hello, \"\"world\"\"\",3,\"[\"\"go\"\"]\"\n"+
+		"source:2,,0,\n"+
+		"source:3,\"multi\nline\",0,\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, List(&buf, Options{Format: FormatCSV, Fields: []string{"chunks", "id"}}, items, nil))
+	assert.Equal(t, "chunks,id\n3,source:1\n0,source:2\n0,source:3\n", buf.String())
+
+	var unknown *UnknownFieldError
+	assert.ErrorAs(t, List(&buf, Options{Format: FormatCSV, Fields: []string{"nope"}}, items, nil), &unknown)
+}
+
+func TestListCSV_Empty(t *testing.T) {
+	var items []csvItem
+
+	var buf bytes.Buffer
+	require.NoError(t, List(&buf, Options{Format: FormatCSV}, items, nil))
+	assert.Equal(t, "id,title,chunks,topics\n", buf.String())
+}
+
+func TestObjectCSV(t *testing.T) {
+	item := &csvItem{ID: "source:1", Title: strPtr("a, b"), Chunks: 2}
+
+	var buf bytes.Buffer
+	require.NoError(t, Object(&buf, Options{Format: FormatCSV}, item))
+	assert.Equal(t, "key,value\nid,source:1\ntitle,\"a, b\"\nchunks,2\ntopics,\n", buf.String())
+}
@@ -0,0 +1,44 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// JSONLWriter writes list items as newline-delimited JSON, one compact object
+// per line. Each call writes its items straight away, so a caller paging
+// through a long list can emit every page as soon as it is retrieved.
+type JSONLWriter struct {
+	w      io.Writer
+	fields []string
+}
+
+// NewJSONLWriter returns a writer of items projected to fields
+func NewJSONLWriter(w io.Writer, fields []string) *JSONLWriter {
+	return &JSONLWriter{w: w, fields: fields}
+}
+
+// Write writes every element of the slice items on a line of its own
+func (j *JSONLWriter) Write(items any) error {
+	data, err := Project(items, j.fields)
+	if err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return fmt.Errorf("cannot write %T as JSON lines: not a list", items)
+	}
+	for i := 0; i < v.Len(); i++ {
+		line, err := json.Marshal(v.Index(i).Interface())
+		if err != nil {
+			return fmt.Errorf("failed to encode JSON lines output: %w", err)
+		}
+		if _, err := j.w.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListJSONL(t *testing.T) {
+	items := []*csvItem{
+		{ID: "source:1", Title: strPtr("first"), Chunks: 3},
+		{ID: "source:2"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, List(&buf, Options{Format: FormatJSONL, Fields: []string{"id", "title"}}, items, nil))
+	assert.Equal(t, "{\"id\":\"source:1\",\"title\":\"first\"}\n{\"id\":\"source:2\",\"title\":null}\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, List(&buf, Options{Format: FormatJSONL}, []*csvItem(nil), nil))
+	assert.Empty(t, buf.String(), "an empty list writes no lines")
+}
+
+func TestJSONLWriter_WritesEachBatch(t *testing.T) {
+	var buf bytes.Buffer
+	writer := NewJSONLWriter(&buf, []string{"id"})
+
+	require.NoError(t, writer.Write([]*csvItem{{ID: "source:1"}}))
+	assert.Equal(t, "{\"id\":\"source:1\"}\n", buf.String())
+
+	require.NoError(t, writer.Write([]*csvItem{{ID: "source:2"}, {ID: "source:3"}}))
+	assert.Equal(t, "{\"id\":\"source:1\"}\n{\"id\":\"source:2\"}\n{\"id\":\"source:3\"}\n", buf.String())
+
+	var unknown *UnknownFieldError
+	assert.ErrorAs(t, NewJSONLWriter(&buf, []string{"nope"}).Write([]*csvItem{{ID: "x"}}), &unknown)
+}
+
+func TestObjectJSONL_OneLine(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Object(&buf, Options{Format: FormatJSONL}, &csvItem{ID: "source:1", Chunks: 2}))
+	assert.Equal(t, 1, bytes.Count(buf.Bytes(), []byte("\n")))
+	assert.Contains(t, buf.String(), "\"id\":\"source:1\"")
+}
@@ -0,0 +1,141 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// UnknownFieldError reports a requested field that the items do not have
+type UnknownFieldError struct {
+	Field     string
+	Available []string
+}
+
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("unknown field %q (available: %s)", e.Field, strings.Join(e.Available, ", "))
+}
+
+// Project reduces every element of the slice items to the requested fields,
+// addressed by their json tag names. Each element becomes an object whose
+// keys keep the requested order. With no fields, items is returned as is.
+func Project(items any, fields []string) (any, error) {
+	if len(fields) == 0 {
+		return items, nil
+	}
+
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, fmt.Errorf("cannot project %T: not a list", items)
+	}
+
+	elemType := v.Type().Elem()
+	for elemType.Kind() == reflect.Pointer {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot project %T: elements are not objects", items)
+	}
+
+	index := jsonFieldIndex(elemType)
+	for _, field := range fields {
+		if _, ok := index[field]; !ok {
+			return nil, &UnknownFieldError{Field: field, Available: FieldNames(elemType)}
+		}
+	}
+
+	result := make([]orderedObject, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		elem := v.Index(i)
+		for elem.Kind() == reflect.Pointer {
+			if elem.IsNil() {
+				break
+			}
+			elem = elem.Elem()
+		}
+
+		obj := orderedObject{keys: fields, values: make([]any, len(fields))}
+		if elem.Kind() == reflect.Struct {
+			for j, field := range fields {
+				obj.values[j] = elem.FieldByIndex(index[field]).Interface()
+			}
+		}
+		result = append(result, obj)
+	}
+	return result, nil
+}
+
+// FieldNames lists the json field names of a struct type in declaration
+// order, including those of embedded structs
+func FieldNames(t reflect.Type) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	var names []string
+	for _, field := range reflect.VisibleFields(t) {
+		if name, ok := jsonName(field); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// jsonFieldIndex maps json field names to struct field indexes
+func jsonFieldIndex(t reflect.Type) map[string][]int {
+	index := make(map[string][]int)
+	for _, field := range reflect.VisibleFields(t) {
+		if name, ok := jsonName(field); ok {
+			index[name] = field.Index
+		}
+	}
+	return index
+}
+
+// jsonName returns the name a field is encoded under, and false for fields
+// encoding/json skips (unexported, "-" or embedded structs themselves)
+func jsonName(field reflect.StructField) (string, bool) {
+	if !field.IsExported() || field.Anonymous {
+		return "", false
+	}
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
+
+// orderedObject is a JSON object whose keys are encoded in a fixed order
+type orderedObject struct {
+	keys   []string
+	values []any
+}
+
+// MarshalJSON implements json.Marshaler
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(o.values[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
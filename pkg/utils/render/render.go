@@ -0,0 +1,141 @@
+// Package render writes list results either as an aligned table or as
+// structured data, honouring the global --output format and the --fields
+// selection of list commands.
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// Output formats understood by List
+const (
+	FormatTable    = "table"
+	FormatWide     = "wide" // table with additional columns
+	FormatJSON     = "json"
+	FormatJSONL    = "jsonl" // one JSON object per line
+	FormatYAML     = "yaml"
+	FormatCSV      = "csv"
+	FormatTemplate = "template"
+)
+
+// IsStructured reports whether format is a machine-readable or user
+// defined format that List and Object render themselves
+func IsStructured(format string) bool {
+	return format == FormatJSON || format == FormatJSONL || format == FormatYAML || format == FormatCSV || format == FormatTemplate
+}
+
+// Options controls how a list is rendered
+type Options struct {
+	Format   string   // output format, defaults to table
+	Fields   []string // fields (structured output) or columns (table) to keep
+	Template string   // Go text/template executed per item for FormatTemplate
+	Width    int      // terminal width tables are fitted to; 0 keeps full values
+	NoHeader bool     // omit the header row of tables
+}
+
+// ParseFields splits a comma separated --fields value, dropping empty entries
+func ParseFields(value string) []string {
+	var fields []string
+	for _, field := range strings.Split(value, ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// List renders items according to opts. Structured formats encode items
+// directly (projected to opts.Fields); the table format writes table with
+// only the selected columns.
+func List(w io.Writer, opts Options, items any, table *Table) error {
+	if v := reflect.ValueOf(items); v.Kind() == reflect.Slice && v.IsNil() {
+		items = reflect.MakeSlice(v.Type(), 0, 0).Interface()
+	}
+
+	switch opts.Format {
+	case FormatTemplate:
+		return writeTemplate(w, opts.Template, items)
+	case FormatCSV:
+		return writeCSV(w, items, opts.Fields)
+	case FormatJSONL:
+		return NewJSONLWriter(w, opts.Fields).Write(items)
+	case FormatYAML:
+		data, err := Project(items, opts.Fields)
+		if err != nil {
+			return err
+		}
+		return writeYAML(w, data)
+	case FormatJSON:
+		data, err := Project(items, opts.Fields)
+		if err != nil {
+			return err
+		}
+		out, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode JSON output: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(out))
+		return err
+	default:
+		if err := table.Select(opts.Fields); err != nil {
+			return err
+		}
+		if opts.NoHeader {
+			table.OmitHeader()
+		}
+		table.Fit(opts.Width)
+		return table.Write(w)
+	}
+}
+
+// Object renders a single item in a structured format: a JSON or YAML
+// object (on one line for JSON lines), a key,value CSV with one row per field, or the executed template. Callers print their own
+// human-readable view when IsStructured(opts.Format) is false.
+func Object(w io.Writer, opts Options, item any) error {
+	if opts.Format == FormatTemplate {
+		return writeTemplate(w, opts.Template, item)
+	}
+
+	fields := opts.Fields
+	if len(fields) == 0 {
+		fields = FieldNames(reflect.TypeOf(item))
+	}
+
+	slice := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(item)), 1, 1)
+	slice.Index(0).Set(reflect.ValueOf(item))
+	projected, err := Project(slice.Interface(), fields)
+	if err != nil {
+		return err
+	}
+	obj := projected.([]orderedObject)[0]
+
+	if opts.Format == FormatCSV {
+		return writeObjectCSV(w, obj)
+	}
+	if opts.Format == FormatYAML {
+		if len(opts.Fields) == 0 {
+			return writeYAML(w, item)
+		}
+		return writeYAML(w, obj)
+	}
+
+	var value any = item
+	if len(opts.Fields) > 0 {
+		value = obj
+	}
+	var out []byte
+	if opts.Format == FormatJSONL {
+		out, err = json.Marshal(value)
+	} else {
+		out, err = json.MarshalIndent(value, "", "  ")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON output: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
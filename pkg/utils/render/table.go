@@ -0,0 +1,225 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"unicode/utf8"
+)
+
+// columnGap is the padding Write puts between columns
+const columnGap = 2
+
+// minColumnWidth is the narrowest Fit truncates a column to
+const minColumnWidth = 5
+
+// Table collects rows for aligned tabular output
+type Table struct {
+	headers    []string
+	rows       [][]string
+	omitHeader bool
+	decorate   func(line string) string
+}
+
+// NewTable creates a table with the given column headers
+func NewTable(headers ...string) *Table {
+	return &Table{headers: headers}
+}
+
+// AddRow appends a row; cells are matched to headers by position
+func (t *Table) AddRow(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// OmitHeader makes Write print the rows without the header row
+func (t *Table) OmitHeader() {
+	t.omitHeader = true
+}
+
+// DecorateRows makes Write pass every aligned row line, but not the header,
+// through fn. Decorations are applied after alignment, so fn may add escape
+// sequences such as colors but must not change the visible text.
+func (t *Table) DecorateRows(fn func(line string) string) {
+	t.decorate = fn
+}
+
+// Select keeps only the named columns, in the given order. Names match
+// headers case-insensitively, with spaces and dashes treated as
+// underscores. An empty selection keeps every column.
+func (t *Table) Select(columns []string) error {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	index := make(map[string]int, len(t.headers))
+	for i, header := range t.headers {
+		index[columnKey(header)] = i
+	}
+
+	picked := make([]int, 0, len(columns))
+	for _, column := range columns {
+		i, ok := index[columnKey(column)]
+		if !ok {
+			return &UnknownFieldError{Field: column, Available: t.columnNames()}
+		}
+		picked = append(picked, i)
+	}
+
+	headers := make([]string, len(picked))
+	for j, i := range picked {
+		headers[j] = t.headers[i]
+	}
+	for r, row := range t.rows {
+		cells := make([]string, len(picked))
+		for j, i := range picked {
+			if i < len(row) {
+				cells[j] = row[i]
+			}
+		}
+		t.rows[r] = cells
+	}
+	t.headers = headers
+	return nil
+}
+
+// Fit truncates cells so that the written table is at most width columns
+// wide. Columns that fit into an equal share of the width keep their values;
+// the rest of the width is divided among the wider columns in proportion to
+// their content. A width of zero or less leaves the table unchanged.
+func (t *Table) Fit(width int) {
+	n := len(t.headers)
+	if width <= 0 || n == 0 {
+		return
+	}
+
+	natural := t.columnWidths()
+	budget := width - columnGap*(n-1)
+	total := 0
+	for _, w := range natural {
+		total += w
+	}
+	if total <= budget {
+		return
+	}
+
+	limits := make([]int, n)
+	wide := make([]int, n)
+	for i := range wide {
+		wide[i] = i
+	}
+	for len(wide) > 0 {
+		fair := budget / len(wide)
+		var rest []int
+		for _, i := range wide {
+			if natural[i] <= fair {
+				limits[i] = natural[i]
+				budget -= natural[i]
+			} else {
+				rest = append(rest, i)
+			}
+		}
+		if len(rest) == len(wide) {
+			break
+		}
+		wide = rest
+	}
+
+	wideTotal := 0
+	for _, i := range wide {
+		wideTotal += natural[i]
+	}
+	for _, i := range wide {
+		limits[i] = max(budget*natural[i]/wideTotal, minColumnWidth)
+		if !t.omitHeader {
+			limits[i] = max(limits[i], utf8.RuneCountInString(t.headers[i]))
+		}
+	}
+
+	for _, row := range t.rows {
+		for i := range row {
+			if i < n {
+				row[i] = truncateCell(row[i], limits[i])
+			}
+		}
+	}
+}
+
+// Write prints the table with tabwriter alignment
+func (t *Table) Write(w io.Writer) error {
+	if t.decorate == nil {
+		return t.write(w)
+	}
+
+	var buf bytes.Buffer
+	if err := t.write(&buf); err != nil {
+		return err
+	}
+	lines := strings.SplitAfter(buf.String(), "\n")
+	for i, line := range lines {
+		if i == 0 && !t.omitHeader {
+			continue
+		}
+		if text, ok := strings.CutSuffix(line, "\n"); ok {
+			lines[i] = t.decorate(text) + "\n"
+		}
+	}
+	_, err := io.WriteString(w, strings.Join(lines, ""))
+	return err
+}
+
+func (t *Table) write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, columnGap, ' ', 0)
+	if !t.omitHeader {
+		fmt.Fprintln(tw, strings.Join(t.headers, "\t"))
+	}
+	for _, row := range t.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// columnWidths returns the widest header or cell of every column in runes
+func (t *Table) columnWidths() []int {
+	widths := make([]int, len(t.headers))
+	for i, header := range t.headers {
+		if !t.omitHeader {
+			widths[i] = utf8.RuneCountInString(header)
+		}
+	}
+	for _, row := range t.rows {
+		for i, cell := range row {
+			if i < len(widths) {
+				widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+			}
+		}
+	}
+	return widths
+}
+
+// truncateCell shortens s to at most width runes, marking the cut with "..."
+func truncateCell(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	if width <= 3 {
+		return string(runes[:width])
+	}
+	return string(runes[:width-3]) + "..."
+}
+
+func (t *Table) columnNames() []string {
+	names := make([]string, len(t.headers))
+	for i, header := range t.headers {
+		names[i] = columnKey(header)
+	}
+	return names
+}
+
+// columnKey normalizes a header or requested column name for matching
+func columnKey(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+}
@@ -0,0 +1,75 @@
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newFitTable() *Table {
+	table := NewTable("ID", "TITLE", "STATUS")
+	table.AddRow("source:1", strings.Repeat("a long title ", 8), "completed")
+	table.AddRow("source:2", "short", "failed")
+	return table
+}
+
+func TestTableFit_TruncatesWideColumnsOnly(t *testing.T) {
+	table := newFitTable()
+	table.Fit(50)
+
+	var buf bytes.Buffer
+	require.NoError(t, table.Write(&buf))
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	for _, line := range lines {
+		assert.LessOrEqual(t, utf8.RuneCountInString(strings.TrimRight(line, " ")), 50, line)
+	}
+	assert.Contains(t, lines[1], "source:1")
+	assert.Contains(t, lines[1], "completed")
+	assert.Contains(t, lines[1], "...")
+	assert.Contains(t, lines[2], "short")
+}
+
+func TestTableFit_KeepsValuesThatFit(t *testing.T) {
+	table := newFitTable()
+	table.Fit(0)
+	assert.Equal(t, strings.Repeat("a long title ", 8), table.rows[0][1])
+
+	table.Fit(500)
+	assert.Equal(t, strings.Repeat("a long title ", 8), table.rows[0][1])
+}
+
+func TestTruncateCell_CountsRunes(t *testing.T) {
+	assert.Equal(t, "Grü...", truncateCell("Grüße aus Köln", 6))
+	assert.Equal(t, "Grüße", truncateCell("Grüße", 5))
+}
+
+func TestListTable_NoHeader(t *testing.T) {
+	table := NewTable("ID", "TITLE")
+	table.AddRow("source:1", "First")
+
+	var buf bytes.Buffer
+	require.NoError(t, List(&buf, Options{Format: FormatTable, NoHeader: true}, []string{"x"}, table))
+	assert.Equal(t, "source:1  First\n", buf.String())
+}
+
+func TestTableWrite_DecorateRowsKeepsAlignment(t *testing.T) {
+	table := newFitTable()
+	table.DecorateRows(func(line string) string {
+		return strings.ReplaceAll(line, "short", "[short]")
+	})
+
+	var buf bytes.Buffer
+	require.NoError(t, table.Write(&buf))
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "ID"))
+	assert.Contains(t, lines[2], "[short]")
+	assert.Equal(t, strings.Index(lines[0], "STATUS")+2, strings.Index(lines[2], "failed"))
+}
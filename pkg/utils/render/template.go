@@ -0,0 +1,100 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"text/template"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/utils"
+)
+
+// TemplateError reports a template that failed to parse or execute
+type TemplateError struct {
+	Err error
+}
+
+func (e *TemplateError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TemplateError) Unwrap() error {
+	return e.Err
+}
+
+// templateFuncs are the helpers available to --template, e.g.
+//
+//	{{.ID}}  {{.Title | truncate 40}}  {{.Created | formatTime}}
+var templateFuncs = template.FuncMap{
+	"truncate": func(maxLen int, value any) string {
+		s := templateString(value)
+		if maxLen < 4 {
+			if len(s) > maxLen && maxLen >= 0 {
+				return s[:maxLen]
+			}
+			return s
+		}
+		return utils.TruncateString(s, maxLen)
+	},
+	"formatTime": func(value any) string {
+		return utils.FormatTimestamp(templateString(value))
+	},
+}
+
+// templateString converts a template value to a string, dereferencing
+// pointers; nil becomes the empty string
+func templateString(value any) string {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return ""
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// parseTemplate compiles a user supplied template with the helper functions
+func parseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("output").Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, &TemplateError{Err: err}
+	}
+	return tmpl, nil
+}
+
+// writeTemplate executes the template once per element of items (or once
+// for a single object), ending each result with a newline
+func writeTemplate(w io.Writer, text string, items any) error {
+	tmpl, err := parseTemplate(text)
+	if err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return executeTemplate(w, tmpl, items)
+	}
+	for i := 0; i < v.Len(); i++ {
+		if err := executeTemplate(w, tmpl, v.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func executeTemplate(w io.Writer, tmpl *template.Template, item any) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, item); err != nil {
+		return &TemplateError{Err: err}
+	}
+	if buf.Len() == 0 || buf.Bytes()[buf.Len()-1] != '\n' {
+		buf.WriteByte('\n')
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
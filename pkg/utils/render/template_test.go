@@ -0,0 +1,38 @@
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTemplate(t *testing.T) {
+	items := []*csvItem{
+		{ID: "source:1", Title: strPtr("A rather long source title")},
+		{ID: "source:2"},
+	}
+
+	var buf bytes.Buffer
+	opts := Options{Format: FormatTemplate, Template: `{{.ID}} {{.Title | truncate 10}}`}
+	require.NoError(t, List(&buf, opts, items, nil))
+	assert.Equal(t, "source:1 A rathe...\nsource:2 \n", buf.String())
+}
+
+func TestObjectTemplate_FormatTime(t *testing.T) {
+	item := struct{ Created string }{Created: "2024-03-01T10:20:30Z"}
+
+	var buf bytes.Buffer
+	require.NoError(t, Object(&buf, Options{Format: FormatTemplate, Template: "{{formatTime .Created}}\n"}, item))
+	assert.Equal(t, "2024-03-01 10:20:30\n", buf.String())
+}
+
+func TestTemplateErrors(t *testing.T) {
+	items := []csvItem{{ID: "source:1"}}
+
+	var tmplErr *TemplateError
+	var buf bytes.Buffer
+	assert.ErrorAs(t, List(&buf, Options{Format: FormatTemplate, Template: "{{.ID"}, items, nil), &tmplErr)
+	assert.ErrorAs(t, List(&buf, Options{Format: FormatTemplate, Template: "{{.Missing}}"}, items, nil), &tmplErr)
+}
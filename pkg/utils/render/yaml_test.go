@@ -0,0 +1,61 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestObjectYAML_RoundTripsSource(t *testing.T) {
+	status := models.SourceStatusCompleted
+	source := &models.Source{
+		ID:             strPtr("source:abc"),
+		Title:          strPtr("123"),
+		Topics:         []string{"go", "yes"},
+		FullText:       strPtr("line one\nline two: with colon"),
+		Embedded:       true,
+		EmbeddedChunks: 4,
+		Created:        "2024-05-01T10:00:00Z",
+		Status:         &status,
+		ProcessingInfo: map[string]any{"step": "done"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Object(&buf, Options{Format: FormatYAML}, source))
+	out := buf.String()
+
+	assert.Contains(t, out, "id: source:abc\n")
+	assert.Contains(t, out, "embedded_chunks: 4\n")
+	assert.NotContains(t, out, "asset:", "nil pointers are omitted")
+	assert.NotContains(t, out, "command_id:")
+
+	// Decode the YAML and map it back through the JSON field names
+	var decoded map[string]any
+	require.NoError(t, yaml.Unmarshal(buf.Bytes(), &decoded))
+	data, err := json.Marshal(decoded)
+	require.NoError(t, err)
+	var back models.Source
+	require.NoError(t, json.Unmarshal(data, &back))
+
+	assert.Equal(t, source, &back)
+}
+
+func TestListYAML_Fields(t *testing.T) {
+	items := []*csvItem{
+		{ID: "source:1", Title: strPtr("first"), Chunks: 3},
+		{ID: "source:2"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, List(&buf, Options{Format: FormatYAML, Fields: []string{"id", "title"}}, items, nil))
+	assert.Equal(t, "- id: source:1\n  title: first\n- id: source:2\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, List(&buf, Options{Format: FormatYAML}, []*csvItem(nil), nil))
+	assert.Equal(t, "[]\n", buf.String())
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // TruncateString truncates a string to the specified maximum length,
@@ -35,12 +36,19 @@ func ParseCommaSeparated(input string) []string {
 }
 
 // FormatTimestamp formats a timestamp string for display.
-// If the timestamp is empty, returns "N/A".
+// If the timestamp is empty, returns "N/A". With relative timestamps
+// enabled, recent times are shown as their age, e.g. "2h ago".
 func FormatTimestamp(timestamp string) string {
 	if timestamp == "" {
 		return "N/A"
 	}
 
+	if relativeTimestamps {
+		if t, ok := ParseTimestamp(timestamp); ok {
+			return FormatRelativeTime(t, time.Now())
+		}
+	}
+
 	// Try to parse as ISO 8601 format
 	if t, err := time.Parse(time.RFC3339, timestamp); err == nil {
 		return t.Format("2006-01-02 15:04:05")
@@ -63,6 +71,12 @@ func FormatTimestamp(timestamp string) string {
 	return timestamp
 }
 
+// EstimateTokens gives a rough token count for text, using the common
+// heuristic of about four characters per token
+func EstimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + 3) / 4
+}
+
 // SafeDereferenceString safely dereferences a string pointer.
 // If the pointer is nil, returns an empty string.
 func SafeDereferenceString(s *string) string {
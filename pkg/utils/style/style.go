@@ -0,0 +1,63 @@
+// Package style centralizes the decoration of CLI output. By default status
+// messages carry emoji prefixes; with --no-color or the NO_COLOR environment
+// variable they fall back to plain ASCII labels suitable for logs and CI.
+package style
+
+import "os"
+
+// plain disables emoji (and any future color) decoration
+var plain = os.Getenv("NO_COLOR") != ""
+
+// Configure sets plain output mode. The NO_COLOR convention is always
+// honoured, see https://no-color.org.
+func Configure(noColor bool) {
+	plain = noColor || os.Getenv("NO_COLOR") != ""
+}
+
+// IsPlain reports whether output decoration is disabled.
+func IsPlain() bool {
+	return plain
+}
+
+// OK prefixes a success message.
+func OK(msg string) string {
+	return pick("✅ ", "OK: ") + msg
+}
+
+// Error prefixes a failure message.
+func Error(msg string) string {
+	return pick("❌ ", "ERROR: ") + msg
+}
+
+// Warn prefixes a warning message.
+func Warn(msg string) string {
+	return pick("⚠️  ", "WARNING: ") + msg
+}
+
+// Icon prefixes a message with a decorative emoji that is dropped in plain
+// mode.
+func Icon(emoji, msg string) string {
+	return pick(emoji+" ", "") + msg
+}
+
+// Symbol returns a standalone emoji, such as a status marker in a table
+// cell, or its text replacement in plain mode.
+func Symbol(emoji, text string) string {
+	return pick(emoji, text)
+}
+
+// Highlight marks text, such as a search match, in bold yellow. In plain
+// mode text is returned unchanged.
+func Highlight(text string) string {
+	if plain {
+		return text
+	}
+	return "\x1b[1;33m" + text + "\x1b[0m"
+}
+
+func pick(fancy, ascii string) string {
+	if plain {
+		return ascii
+	}
+	return fancy
+}
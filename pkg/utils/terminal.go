@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"os"
+	"strconv"
+)
+
+// TerminalSize returns the number of columns and rows of the terminal f is
+// attached to. When the size cannot be queried it falls back to the COLUMNS
+// and LINES environment variables; ok is false when neither is available.
+func TerminalSize(f *os.File) (width, height int, ok bool) {
+	if IsTerminal(f) {
+		if width, height, ok = terminalSize(realFile(f)); ok {
+			return width, height, true
+		}
+	}
+
+	width, errW := strconv.Atoi(os.Getenv("COLUMNS"))
+	height, errH := strconv.Atoi(os.Getenv("LINES"))
+	if errW != nil || errH != nil || width <= 0 || height <= 0 {
+		return 0, 0, false
+	}
+	return width, height, true
+}
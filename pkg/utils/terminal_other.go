@@ -0,0 +1,11 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd || dragonfly)
+
+package utils
+
+import "os"
+
+// terminalSize is not implemented on this platform; TerminalSize falls back
+// to the COLUMNS and LINES environment variables
+func terminalSize(f *os.File) (width, height int, ok bool) {
+	return 0, 0, false
+}
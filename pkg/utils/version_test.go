@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := map[string]struct {
+		a, b   string
+		want   int
+		wantOK bool
+	}{
+		"equal":                    {"1.2.3", "1.2.3", 0, true},
+		"older patch":              {"1.2.3", "1.2.4", -1, true},
+		"newer minor":              {"1.10.0", "1.9.9", 1, true},
+		"missing component":        {"1.2", "1.2.0", 0, true},
+		"shorter is older":         {"1.2", "1.2.1", -1, true},
+		"v prefix":                 {"v1.2.3", "1.2.3", 0, true},
+		"v prefix on both":         {"v2.0.0", "v1.9.0", 1, true},
+		"prerelease ignored":       {"1.2.0-rc1", "1.2.0", 0, true},
+		"build metadata ignored":   {"1.2.0+abc123", "1.2.0", 0, true},
+		"prerelease older":         {"v1.1.0-beta.2", "1.2.0", -1, true},
+		"surrounding whitespace":   {" 1.2.3\n", "1.2.3", 0, true},
+		"dev build":                {"dev", "1.2.3", 0, false},
+		"empty":                    {"", "1.2.3", 0, false},
+		"bare v":                   {"v", "1.2.3", 0, false},
+		"non numeric component":    {"1.x", "1.2.3", 0, false},
+		"empty component":          {"1..3", "1.2.3", 0, false},
+		"negative component":       {"1.-2.3", "1.2.3", 0, false},
+		"malformed second version": {"1.2.3", "abc123", 0, false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			cmp, ok := CompareVersions(tt.a, tt.b)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, cmp)
+		})
+	}
+}
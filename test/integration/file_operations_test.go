@@ -14,7 +14,6 @@ import (
 
 	"github.com/denkhaus/open-notebook-cli/pkg/di"
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
-	"github.com/denkhaus/open-notebook-cli/pkg/services"
 )
 
 // TestFileOperationsTests tests file-related operations against the real API
@@ -65,18 +64,16 @@ func testTextSourceCreation(t *testing.T) {
 	httpClient := di.GetHTTPClient(injector)
 	
 	// Configure HTTP client to not retry server errors for this test
-	if retryableClient, ok := httpClient.(interface{ SetRetryConfig(services.RetryConfig) }); ok {
-		config := services.DefaultRetryConfig()
-		// Remove HTTP 500 from retryable statuses for this test
-		var filteredStatuses []int
-		for _, status := range config.RetryableStatus {
-			if status != 500 {
-				filteredStatuses = append(filteredStatuses, status)
-			}
+	config := httpClient.GetRetryConfig()
+	// Remove HTTP 500 from retryable statuses for this test
+	var filteredStatuses []int
+	for _, status := range config.RetryableStatus {
+		if status != 500 {
+			filteredStatuses = append(filteredStatuses, status)
 		}
-		config.RetryableStatus = filteredStatuses
-		retryableClient.SetRetryConfig(config)
 	}
+	config.RetryableStatus = filteredStatuses
+	httpClient.SetRetryConfig(config)
 	
 	// Try to authenticate first
 	auth := di.GetAuth(injector)
@@ -181,18 +178,16 @@ func testErrorHandling(t *testing.T) {
 	httpClient := di.GetHTTPClient(injector)
 	
 	// Configure HTTP client to not retry server errors for this test
-	if retryableClient, ok := httpClient.(interface{ SetRetryConfig(services.RetryConfig) }); ok {
-		config := services.DefaultRetryConfig()
-		// Remove HTTP 500 from retryable statuses for this test
-		var filteredStatuses []int
-		for _, status := range config.RetryableStatus {
-			if status != 500 {
-				filteredStatuses = append(filteredStatuses, status)
-			}
+	config := httpClient.GetRetryConfig()
+	// Remove HTTP 500 from retryable statuses for this test
+	var filteredStatuses []int
+	for _, status := range config.RetryableStatus {
+		if status != 500 {
+			filteredStatuses = append(filteredStatuses, status)
 		}
-		config.RetryableStatus = filteredStatuses
-		retryableClient.SetRetryConfig(config)
 	}
+	config.RetryableStatus = filteredStatuses
+	httpClient.SetRetryConfig(config)
 
 	t.Run("Invalid source creation - missing content", func(t *testing.T) {
 		sourceCreate := &models.SourceCreate{
@@ -13,7 +13,6 @@ import (
 
 	"github.com/denkhaus/open-notebook-cli/pkg/di"
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
-	"github.com/denkhaus/open-notebook-cli/pkg/services"
 )
 
 // TestNotesAndSearchOperations tests notes and search functionality against the real API
@@ -49,18 +48,16 @@ func testNotesOperations(t *testing.T) {
 	httpClient := di.GetHTTPClient(injector)
 
 	// Configure HTTP client to not retry server errors for tests
-	if retryableClient, ok := httpClient.(interface{ SetRetryConfig(services.RetryConfig) }); ok {
-		config := services.DefaultRetryConfig()
-		// Remove HTTP 500 from retryable statuses for this test
-		var filteredStatuses []int
-		for _, status := range config.RetryableStatus {
-			if status != 500 {
-				filteredStatuses = append(filteredStatuses, status)
-			}
+	config := httpClient.GetRetryConfig()
+	// Remove HTTP 500 from retryable statuses for this test
+	var filteredStatuses []int
+	for _, status := range config.RetryableStatus {
+		if status != 500 {
+			filteredStatuses = append(filteredStatuses, status)
 		}
-		config.RetryableStatus = filteredStatuses
-		retryableClient.SetRetryConfig(config)
 	}
+	config.RetryableStatus = filteredStatuses
+	httpClient.SetRetryConfig(config)
 
 	// Try to authenticate first
 	auth := di.GetAuth(injector)
@@ -168,9 +165,8 @@ func testNotesOperations(t *testing.T) {
 
 		t.Run("Update note", func(t *testing.T) {
 			updatedContent := "This is an updated test note with modified content."
-			noteUpdate := map[string]interface{}{
-				"content": updatedContent,
-			}
+			// Only content is set; the title must be left untouched
+			noteUpdate := &models.NoteUpdate{Content: &updatedContent}
 
 			start := time.Now()
 			resp, err := httpClient.Put(context.Background(), "/notes/"+testNoteID, noteUpdate)
@@ -255,18 +251,16 @@ func testSearchOperations(t *testing.T) {
 	httpClient := di.GetHTTPClient(injector)
 
 	// Configure HTTP client to not retry server errors for some tests
-	if retryableClient, ok := httpClient.(interface{ SetRetryConfig(services.RetryConfig) }); ok {
-		config := services.DefaultRetryConfig()
-		// Remove HTTP 500 from retryable statuses for this test
-		var filteredStatuses []int
-		for _, status := range config.RetryableStatus {
-			if status != 500 {
-				filteredStatuses = append(filteredStatuses, status)
-			}
+	config := httpClient.GetRetryConfig()
+	// Remove HTTP 500 from retryable statuses for this test
+	var filteredStatuses []int
+	for _, status := range config.RetryableStatus {
+		if status != 500 {
+			filteredStatuses = append(filteredStatuses, status)
 		}
-		config.RetryableStatus = filteredStatuses
-		retryableClient.SetRetryConfig(config)
 	}
+	config.RetryableStatus = filteredStatuses
+	httpClient.SetRetryConfig(config)
 
 	// Try to authenticate first
 	auth := di.GetAuth(injector)
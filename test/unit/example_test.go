@@ -2,11 +2,16 @@ package unit
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/denkhaus/open-notebook-cli/pkg/mocks"
 	"github.com/denkhaus/open-notebook-cli/pkg/models"
+	"github.com/denkhaus/open-notebook-cli/pkg/services"
+	"github.com/denkhaus/open-notebook-cli/pkg/shared"
+	"github.com/samber/do/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -134,4 +139,64 @@ func TestCallVerification(t *testing.T) {
 	calls := repo.GetCalls("List")
 	assert.Len(t, calls, 1)
 	assert.Equal(t, ctx, calls[0].Args[0])
-}
\ No newline at end of file
+}
+
+// TestHTTPClient_MockUsage demonstrates how to drive a repository through the
+// mock HTTP client instead of an httptest server
+func TestHTTPClient_MockUsage(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(client *mocks.MockHTTPClient)
+		wantErr bool
+		wantLen int
+	}{
+		{
+			name: "canned response",
+			setup: func(client *mocks.MockHTTPClient) {
+				client.SetJSONResponse(http.MethodGet, "/notebooks", `[{"id": "notebook:a", "name": "A"}]`)
+			},
+			wantLen: 1,
+		},
+		{
+			name: "API error",
+			setup: func(client *mocks.MockHTTPClient) {
+				client.SetResponse(http.MethodGet, "/notebooks", &models.Response{
+					StatusCode: http.StatusInternalServerError,
+					Body:       []byte(`{"detail": "boom"}`),
+				})
+			},
+			wantErr: true,
+		},
+		{
+			name: "network error",
+			setup: func(client *mocks.MockHTTPClient) {
+				client.SetEndpointError(http.MethodGet, "/notebooks", errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := mocks.NewMockHTTPClient()
+			tt.setup(client)
+
+			injector := do.New()
+			do.ProvideValue[shared.HTTPClient](injector, client)
+			repo, err := services.NewNotebookRepository(injector)
+			require.NoError(t, err)
+
+			notebooks, err := repo.List(context.Background())
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Len(t, notebooks, tt.wantLen)
+			}
+
+			// Every request is recorded, in order and per method
+			assert.Equal(t, []mocks.RecordedRequest{{Method: http.MethodGet, Endpoint: "/notebooks"}}, client.Requests())
+			assert.Equal(t, 1, client.CallCount("Get"))
+		})
+	}
+}